@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+	"github.com/mcao2/readwise-triage/internal/triage"
+	"github.com/mcao2/readwise-triage/internal/ui"
+)
+
+// llmPingTimeout bounds the LLM reachability check.
+const llmPingTimeout = 20 * time.Second
+
+// checkStatus is the outcome of one doctor check. Only failures make the
+// command exit non-zero; warnings cover optional features.
+type checkStatus int
+
+const (
+	checkPass checkStatus = iota
+	checkWarn
+	checkFail
+)
+
+// check is one line of the doctor checklist.
+type check struct {
+	name   string
+	status checkStatus
+	detail string
+}
+
+func (c check) String() string {
+	mark := map[checkStatus]string{checkPass: "✓", checkWarn: "!", checkFail: "✗"}[c.status]
+	return fmt.Sprintf("%s %s: %s", mark, c.name, c.detail)
+}
+
+// newReadwiseClient creates the client used to verify the token. It is a
+// variable so tests can stub the HTTP transport.
+var newReadwiseClient = func(token string) (*readwise.Client, error) {
+	return readwise.NewClient(token)
+}
+
+// runDoctor prints a diagnostics checklist and reports whether every
+// critical check passed.
+func runDoctor(w io.Writer) bool {
+	cfgCheck, cfg := checkConfig()
+	checks := []check{
+		cfgCheck,
+		checkToken(cfg.ReadwiseToken),
+		checkLLM(cfg.GetLLMConfig()),
+		checkStore(),
+		checkTheme(cfg),
+	}
+
+	ok := true
+	for _, c := range checks {
+		fmt.Fprintln(w, c)
+		if c.status == checkFail {
+			ok = false
+		}
+	}
+	return ok
+}
+
+// checkConfig loads the config file, falling back to defaults on error so
+// the remaining checks still run.
+func checkConfig() (check, *config.Config) {
+	c := check{name: "Config"}
+	path, err := config.ConfigFilePath()
+	if err != nil {
+		c.status, c.detail = checkFail, err.Error()
+		return c, &config.Config{}
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		c.status, c.detail = checkFail, fmt.Sprintf("%s: %v", path, err)
+		return c, &config.Config{}
+	}
+	if _, err := os.Stat(path); err != nil {
+		c.status, c.detail = checkWarn, path+" not found, using defaults (run readwise-triage init)"
+		return c, cfg
+	}
+	c.detail = path
+	if cfg.Profile != "" {
+		c.detail += fmt.Sprintf(" (profile %s)", cfg.Profile)
+	}
+	return c, cfg
+}
+
+// checkToken reports whether a Readwise token is set and accepted.
+func checkToken(token string) check {
+	c := check{name: "Readwise token"}
+	if token == "" {
+		c.status, c.detail = checkFail, "not set (READWISE_TOKEN or readwise_token in the config)"
+		return c
+	}
+	client, err := newReadwiseClient(token)
+	if err != nil {
+		c.status, c.detail = checkFail, err.Error()
+		return c
+	}
+	valid, err := client.VerifyToken()
+	switch {
+	case err != nil:
+		c.status, c.detail = checkFail, fmt.Sprintf("present, could not verify: %v", err)
+	case !valid:
+		c.status, c.detail = checkFail, "present but rejected by Readwise"
+	default:
+		c.detail = "present and valid"
+	}
+	return c
+}
+
+// checkLLM pings the configured LLM provider. The LLM is optional, so
+// problems are warnings.
+func checkLLM(llm config.LLMConfig) check {
+	c := check{name: "LLM"}
+	client, err := triage.NewLLMClient(
+		llm.Provider,
+		llm.APIKey,
+		triage.WithLLMBaseURL(llm.BaseURL),
+		triage.WithLLMModel(llm.Model),
+		triage.WithLLMAPIFormat(llm.APIFormat),
+		triage.WithLLMHeaders(llm.Headers),
+	)
+	if err != nil {
+		c.status, c.detail = checkWarn, fmt.Sprintf("not usable, auto-triage (T) unavailable: %v", err)
+		return c
+	}
+
+	provider := llm.Provider
+	if provider == "" {
+		provider = "openai"
+	}
+	if llm.Model != "" {
+		provider += " (" + llm.Model + ")"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), llmPingTimeout)
+	defer cancel()
+	if err := client.Ping(ctx); err != nil {
+		if advice := triage.ErrorAdvice(err); advice != "" {
+			c.status, c.detail = checkWarn, fmt.Sprintf("%s: %s (%v)", provider, advice, err)
+			return c
+		}
+		c.status, c.detail = checkWarn, fmt.Sprintf("%s unreachable: %v", provider, err)
+		return c
+	}
+	c.detail = provider + " reachable"
+	return c
+}
+
+// checkStore opens the triage database and counts its entries.
+func checkStore() check {
+	c := check{name: "Triage DB"}
+	store, err := config.LoadTriageStore()
+	if err != nil {
+		c.status, c.detail = checkFail, err.Error()
+		return c
+	}
+	defer store.Close()
+
+	n, err := store.Count()
+	if err != nil {
+		c.status, c.detail = checkFail, fmt.Sprintf("%s: %v", config.TriageDBPath(), err)
+		return c
+	}
+	c.detail = fmt.Sprintf("%s (%d entries)", config.TriageDBPath(), n)
+	return c
+}
+
+// checkTheme reports the theme in use, warning about unknown names.
+func checkTheme(cfg *config.Config) check {
+	c := check{name: "Theme"}
+	name := cfg.Theme
+	if name == "" {
+		name = "default"
+	}
+	if _, ok := ui.Themes[cfg.ThemeOverride]; ok {
+		c.detail = fmt.Sprintf("%s (READWISE_TRIAGE_THEME, saved: %s)", cfg.ThemeOverride, name)
+		return c
+	}
+	if _, ok := ui.Themes[name]; !ok {
+		c.status, c.detail = checkWarn, fmt.Sprintf("unknown theme %q, using default", name)
+		return c
+	}
+	c.detail = name
+	return c
+}
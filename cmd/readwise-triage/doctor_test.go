@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// statusHTTPClient answers every request with a fixed status or error.
+type statusHTTPClient struct {
+	status int
+	err    error
+}
+
+func (c statusHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &http.Response{StatusCode: c.status, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func stubReadwise(t *testing.T, client statusHTTPClient) {
+	t.Helper()
+	orig := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithHTTPClient(client))
+	}
+	t.Cleanup(func() { newReadwiseClient = orig })
+}
+
+func TestCheckToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		client statusHTTPClient
+		status checkStatus
+		detail string
+	}{
+		{"valid", "good", statusHTTPClient{status: http.StatusNoContent}, checkPass, "present and valid"},
+		{"rejected", "bad", statusHTTPClient{status: http.StatusUnauthorized}, checkFail, "rejected"},
+		{"network error", "good", statusHTTPClient{err: fmt.Errorf("no route to host")}, checkFail, "no route to host"},
+		{"missing", "", statusHTTPClient{status: http.StatusNoContent}, checkFail, "not set"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubReadwise(t, tt.client)
+			got := checkToken(tt.token)
+			if got.status != tt.status || !strings.Contains(got.detail, tt.detail) {
+				t.Errorf("checkToken(%q) = %+v, want status %v with %q", tt.token, got, tt.status, tt.detail)
+			}
+		})
+	}
+}
+
+func TestCheckLLM(t *testing.T) {
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"choices":[{"message":{"role":"assistant","content":"pong"}}]}`)
+	}))
+	defer ok.Close()
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"message":"Incorrect API key"}}`)
+	}))
+	defer down.Close()
+
+	tests := []struct {
+		name   string
+		llm    config.LLMConfig
+		status checkStatus
+		detail string
+	}{
+		{"reachable", config.LLMConfig{Provider: "openai", APIKey: "k", BaseURL: ok.URL, Model: "gpt-test"}, checkPass, "openai (gpt-test) reachable"},
+		{"bad key", config.LLMConfig{Provider: "openai", APIKey: "k", BaseURL: down.URL}, checkWarn, "Incorrect API key"},
+		{"not configured", config.LLMConfig{}, checkWarn, "api_key is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkLLM(tt.llm)
+			if got.status != tt.status || !strings.Contains(got.detail, tt.detail) {
+				t.Errorf("checkLLM() = %+v, want status %v with %q", got, tt.status, tt.detail)
+			}
+		})
+	}
+}
+
+func TestCheckStore(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(dir, "config.yaml"))
+
+	store, err := config.LoadTriageStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.SetItem("a", "archive", "", "manual", nil, nil)
+	store.Close()
+
+	got := checkStore()
+	if got.status != checkPass || !strings.Contains(got.detail, filepath.Join(dir, "triage.db")+" (1 entries)") {
+		t.Errorf("checkStore() = %+v", got)
+	}
+
+	// A corrupt database is a critical failure naming the file
+	if err := os.WriteFile(filepath.Join(dir, "triage.db"), []byte(strings.Repeat("junk", 500)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(filepath.Join(dir, "triage.db-wal"))
+	os.Remove(filepath.Join(dir, "triage.db-shm"))
+	got = checkStore()
+	if got.status != checkFail || !strings.Contains(got.detail, "triage.db") {
+		t.Errorf("checkStore() on corrupt db = %+v", got)
+	}
+}
+
+func TestCheckTheme(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.Config
+		status checkStatus
+		detail string
+	}{
+		{"unset", config.Config{}, checkPass, "default"},
+		{"known", config.Config{Theme: "nord"}, checkPass, "nord"},
+		{"override", config.Config{Theme: "nord", ThemeOverride: "dracula"}, checkPass, "dracula (READWISE_TRIAGE_THEME, saved: nord)"},
+		{"unknown", config.Config{Theme: "neon"}, checkWarn, `unknown theme "neon"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkTheme(&tt.cfg)
+			if got.status != tt.status || !strings.Contains(got.detail, tt.detail) {
+				t.Errorf("checkTheme() = %+v, want status %v with %q", got, tt.status, tt.detail)
+			}
+		})
+	}
+}
+
+func TestRunDoctor(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(dir, "config.yaml"))
+	t.Setenv("READWISE_TOKEN", "")
+	t.Setenv("LLM_API_KEY", "")
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("readwise_token: tok\ntheme: nord\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	stubReadwise(t, statusHTTPClient{status: http.StatusNoContent})
+	var out bytes.Buffer
+	if !runDoctor(&out) {
+		t.Errorf("expected doctor to pass without an LLM, got:\n%s", out.String())
+	}
+	for _, want := range []string{"✓ Config: ", "✓ Readwise token: present and valid", "! LLM: ", "✓ Triage DB: ", "✓ Theme: nord"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in output:\n%s", want, out.String())
+		}
+	}
+
+	// An invalid token is critical
+	stubReadwise(t, statusHTTPClient{status: http.StatusUnauthorized})
+	out.Reset()
+	if runDoctor(&out) {
+		t.Errorf("expected doctor to fail with a rejected token, got:\n%s", out.String())
+	}
+}
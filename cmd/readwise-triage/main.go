@@ -1,16 +1,245 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"path/filepath"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
 	"github.com/mcao2/readwise-triage/internal/ui"
 )
 
+// options holds the parsed command line.
+type options struct {
+	profile     string
+	demo        bool
+	configPath  string
+	logPath     string
+	backupPath  string // write the triage database as JSON to this file and exit
+	restorePath string // load a backup into the triage database and exit
+	command     string // "" to run the TUI, "init", or "doctor"
+}
+
+func parseArgs(args []string) (options, error) {
+	var opts options
+	fs := flag.NewFlagSet("readwise-triage", flag.ContinueOnError)
+	fs.StringVar(&opts.profile, "profile", "", "config profile to use (overrides READWISE_PROFILE)")
+	fs.BoolVar(&opts.demo, "demo", false, "explore the UI with sample items; nothing is read from or sent to Readwise")
+	fs.StringVar(&opts.configPath, "config", "", "config file to use (overrides READWISE_TRIAGE_CONFIG)")
+	fs.StringVar(&opts.logPath, "log", "", "append Readwise and LLM request logs to this file (overrides READWISE_TRIAGE_LOG)")
+	fs.StringVar(&opts.backupPath, "backup", "", "write all triage decisions, with LLM reports, to this JSON file and exit")
+	fs.StringVar(&opts.restorePath, "restore", "", "load triage decisions from a --backup file and exit")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: readwise-triage [flags] [init|doctor]\n\n  init\twrite a starter config.yaml and print its path\n  doctor\tcheck the config, token, LLM, and triage database\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+
+	if rest := fs.Args(); len(rest) > 0 {
+		if rest[0] != "init" && rest[0] != "doctor" {
+			return opts, fmt.Errorf("unknown command %q", rest[0])
+		}
+		opts.command = rest[0]
+		// Allow flags after the subcommand too: readwise-triage init --config x
+		if err := fs.Parse(rest[1:]); err != nil {
+			return opts, err
+		}
+		if len(fs.Args()) > 0 {
+			return opts, fmt.Errorf("unexpected arguments: %v", fs.Args())
+		}
+	}
+	if opts.backupPath != "" && opts.restorePath != "" {
+		return opts, fmt.Errorf("--backup and --restore cannot be used together")
+	}
+	return opts, nil
+}
+
+// applyOptions points config loading at the chosen file and profile. It must
+// run before anything reads the config.
+func applyOptions(opts options) error {
+	if opts.configPath != "" {
+		path, err := filepath.Abs(opts.configPath)
+		if err != nil {
+			return fmt.Errorf("resolve config path: %w", err)
+		}
+		if err := os.Setenv("READWISE_TRIAGE_CONFIG", path); err != nil {
+			return fmt.Errorf("set config path: %w", err)
+		}
+	}
+	config.SetProfile(opts.profile)
+	return nil
+}
+
+// openLog appends structured debug logs to the file given by --log or
+// READWISE_TRIAGE_LOG and hands the logger to the UI. It returns a nil file
+// when logging is off, which is the default so the TUI stays clean.
+func openLog(opts options) (*os.File, error) {
+	path := opts.logPath
+	if path == "" {
+		path = os.Getenv("READWISE_TRIAGE_LOG")
+	}
+	if path == "" {
+		return nil, nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open log file: %w", err)
+	}
+	ui.SetLogger(slog.New(slog.NewTextHandler(f, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	return f, nil
+}
+
+// runInit writes the example config unless one already exists.
+func runInit(w io.Writer) error {
+	path, err := config.ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		fmt.Fprintf(w, "Config already exists at %s\n", path)
+		return nil
+	}
+	if err := config.SaveExampleConfig(); err != nil {
+		return fmt.Errorf("write example config: %w", err)
+	}
+	fmt.Fprintf(w, "Wrote example config to %s\n", path)
+	return nil
+}
+
+// runBackup writes the active profile's triage database to path.
+func runBackup(path string, w io.Writer) error {
+	store, err := config.LoadTriageStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("create backup: %w", err)
+	}
+	if err := store.Backup(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write backup: %w", err)
+	}
+	n, err := store.Count()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Backed up %d entries from %s to %s\n", n, config.TriageDBPath(), path)
+	return nil
+}
+
+// runRestore loads a backup into the active profile's triage database.
+// Entries already in the database are overwritten by the backup's.
+func runRestore(path string, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open backup: %w", err)
+	}
+	defer f.Close()
+
+	store, err := config.LoadTriageStore()
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	n, err := store.Restore(f)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Restored %d entries from %s into %s\n", n, path, config.TriageDBPath())
+	return nil
+}
+
+// needsSetup reports whether this is a first run: no config file and no
+// token from the environment.
+func needsSetup() bool {
+	path, err := config.ConfigFilePath()
+	if err != nil {
+		return false
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return false
+	}
+	return os.Getenv("READWISE_TOKEN") == ""
+}
+
 func main() {
+	opts, err := parseArgs(os.Args[1:])
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+
+	// Select the config file and profile before the UI loads config and the triage store
+	if err := applyOptions(opts); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if opts.command == "init" {
+		if err := runInit(os.Stdout); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if opts.backupPath != "" || opts.restorePath != "" {
+		run, path := runBackup, opts.backupPath
+		if opts.restorePath != "" {
+			run, path = runRestore, opts.restorePath
+		}
+		if err := run(path, os.Stdout); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	if opts.command == "doctor" {
+		if !runDoctor(os.Stdout) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	logFile, err := openLog(opts)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	if logFile != nil {
+		defer logFile.Close()
+	}
+
+	// Walk first-time users through the token and LLM setup
+	if !opts.demo && needsSetup() {
+		if err := ui.RunSetup(os.Stdout); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// Initialize the UI model
-	m := ui.NewModel()
+	var m *ui.Model
+	if opts.demo {
+		m = ui.NewDemoModel()
+	} else {
+		m = ui.NewModel()
+	}
 
 	// Create the Bubble Tea program with alternate screen (clears terminal)
 	p := tea.NewProgram(
@@ -24,4 +253,5 @@ func main() {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
+	m.EndSession()
 }
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/triage"
+	"github.com/mcao2/readwise-triage/internal/ui"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    options
+		wantErr bool
+	}{
+		{"no args", nil, options{}, false},
+		{"config flag", []string{"--config", "/tmp/rt/config.yaml"}, options{configPath: "/tmp/rt/config.yaml"}, false},
+		{"init", []string{"init"}, options{command: "init"}, false},
+		{"flags before init", []string{"--config", "x.yaml", "init"}, options{configPath: "x.yaml", command: "init"}, false},
+		{"flags after init", []string{"init", "--config", "x.yaml"}, options{configPath: "x.yaml", command: "init"}, false},
+		{"profile and demo", []string{"--profile", "work", "--demo"}, options{profile: "work", demo: true}, false},
+		{"log file", []string{"--log", "debug.log"}, options{logPath: "debug.log"}, false},
+		{"doctor", []string{"--profile", "work", "doctor"}, options{profile: "work", command: "doctor"}, false},
+		{"backup", []string{"--backup", "triage.json"}, options{backupPath: "triage.json"}, false},
+		{"restore with profile", []string{"--profile", "work", "--restore", "triage.json"}, options{profile: "work", restorePath: "triage.json"}, false},
+		{"backup and restore", []string{"--backup", "a.json", "--restore", "b.json"}, options{}, true},
+		{"unknown command", []string{"frobnicate"}, options{}, true},
+		{"extra args", []string{"init", "extra"}, options{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseArgs(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseArgs(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseArgs(%v) = %+v, want %+v", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyOptionsSetsConfigEnv(t *testing.T) {
+	t.Setenv("READWISE_TRIAGE_CONFIG", "")
+	dir := t.TempDir()
+
+	if err := applyOptions(options{configPath: filepath.Join(dir, "custom.yaml")}); err != nil {
+		t.Fatalf("applyOptions() error = %v", err)
+	}
+	if got := os.Getenv("READWISE_TRIAGE_CONFIG"); got != filepath.Join(dir, "custom.yaml") {
+		t.Errorf("READWISE_TRIAGE_CONFIG = %q", got)
+	}
+
+	// Relative paths are made absolute so later chdirs don't move the config
+	t.Chdir(dir)
+	if err := applyOptions(options{configPath: "rel.yaml"}); err != nil {
+		t.Fatalf("applyOptions() error = %v", err)
+	}
+	if got := os.Getenv("READWISE_TRIAGE_CONFIG"); !filepath.IsAbs(got) || filepath.Base(got) != "rel.yaml" {
+		t.Errorf("expected absolute path to rel.yaml, got %q", got)
+	}
+}
+
+func TestRunInit(t *testing.T) {
+	// A custom file name is honored, not just the directory
+	path := filepath.Join(t.TempDir(), "nested", "readwise.yaml")
+	t.Setenv("READWISE_TRIAGE_CONFIG", path)
+
+	var out bytes.Buffer
+	if err := runInit(&out); err != nil {
+		t.Fatalf("runInit() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "Wrote example config to "+path) {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected example config at %s: %v", path, err)
+	}
+	if !strings.Contains(string(data), "readwise_token") {
+		t.Error("expected the example config contents")
+	}
+
+	// A second run leaves the existing file alone
+	if err := os.WriteFile(path, []byte("theme: nord\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	if err := runInit(&out); err != nil {
+		t.Fatalf("runInit() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "already exists") {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	if data, _ := os.ReadFile(path); string(data) != "theme: nord\n" {
+		t.Errorf("existing config was overwritten: %q", data)
+	}
+}
+
+func TestOpenLog(t *testing.T) {
+	t.Cleanup(func() { ui.SetLogger(slog.New(slog.DiscardHandler)) })
+	dir := t.TempDir()
+
+	// Off by default
+	t.Setenv("READWISE_TRIAGE_LOG", "")
+	f, err := openLog(options{})
+	if err != nil || f != nil {
+		t.Fatalf("openLog() = %v, %v; want no file", f, err)
+	}
+
+	// The flag takes precedence over the environment
+	t.Setenv("READWISE_TRIAGE_LOG", filepath.Join(dir, "env.log"))
+	f, err = openLog(options{logPath: filepath.Join(dir, "flag.log")})
+	if err != nil {
+		t.Fatalf("openLog() error = %v", err)
+	}
+	f.Close()
+	if _, err := os.Stat(filepath.Join(dir, "flag.log")); err != nil {
+		t.Errorf("expected flag.log created: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "env.log")); err == nil {
+		t.Error("expected env.log not created when --log is set")
+	}
+
+	f, err = openLog(options{})
+	if err != nil {
+		t.Fatalf("openLog() error = %v", err)
+	}
+	f.Close()
+	if f.Name() != filepath.Join(dir, "env.log") {
+		t.Errorf("expected READWISE_TRIAGE_LOG used, got %s", f.Name())
+	}
+
+	if _, err := openLog(options{logPath: filepath.Join(dir, "missing", "x.log")}); err == nil {
+		t.Error("expected error for an unwritable log path")
+	}
+}
+
+func TestNeedsSetup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv("READWISE_TRIAGE_CONFIG", path)
+	t.Setenv("READWISE_TOKEN", "")
+
+	if !needsSetup() {
+		t.Error("expected setup with no config file and no token")
+	}
+
+	t.Setenv("READWISE_TOKEN", "from-env")
+	if needsSetup() {
+		t.Error("expected no setup when READWISE_TOKEN is set")
+	}
+
+	t.Setenv("READWISE_TOKEN", "")
+	if err := os.WriteFile(path, []byte("theme: nord\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if needsSetup() {
+		t.Error("expected no setup once a config file exists")
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(dir, "old", "config.yaml"))
+	store, err := config.LoadTriageStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.SetItem("doc1", "later", "high", "llm", []string{"go"}, &triage.Result{ID: "doc1", TriageDecision: triage.TriageDecision{Action: "later", Reason: "deep dive"}})
+	store.SetItem("doc2", "archive", "", "manual", nil, nil)
+	store.Close()
+
+	backup := filepath.Join(dir, "triage.json")
+	var out bytes.Buffer
+	if err := runBackup(backup, &out); err != nil {
+		t.Fatalf("runBackup() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "Backed up 2 entries") {
+		t.Errorf("unexpected output %q", out.String())
+	}
+
+	// Restore into a fresh database, as on a new machine
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(dir, "new", "config.yaml"))
+	out.Reset()
+	if err := runRestore(backup, &out); err != nil {
+		t.Fatalf("runRestore() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "Restored 2 entries") {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	restored, err := config.LoadTriageStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+	entry, ok := restored.GetItem("doc1")
+	if !ok || entry.Action != "later" || entry.Priority != "high" || entry.Report == nil || entry.Report.TriageDecision.Reason != "deep dive" {
+		t.Errorf("expected doc1 restored with its report, got %+v", entry)
+	}
+	if !restored.HasTriaged("doc2") {
+		t.Error("expected doc2 restored")
+	}
+
+	if err := runRestore(filepath.Join(dir, "missing.json"), &out); err == nil {
+		t.Error("expected an error for a missing backup file")
+	}
+}
@@ -4,29 +4,213 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // LLMConfig holds LLM provider configuration
 type LLMConfig struct {
-	Provider  string `yaml:"provider"` // "openai", "perplexity", "anthropic", "ollama", or any custom
+	Provider  string `yaml:"provider"` // "openai", "perplexity", "anthropic", "ollama", "openrouter", or any custom
 	APIKey    string `yaml:"api_key"`
 	BaseURL   string `yaml:"base_url"`   // custom endpoint; defaults per provider
 	Model     string `yaml:"model"`      // defaults per provider
 	APIFormat string `yaml:"api_format"` // "openai" (default) or "anthropic" — wire format for requests/responses
+
+	// PromptTemplate overrides the built-in auto-triage prompt. It must contain
+	// a single %s placeholder for the items JSON. PromptFile loads the template
+	// from a file instead (relative paths resolve against the config directory).
+	PromptTemplate string `yaml:"prompt_template,omitempty"`
+	PromptFile     string `yaml:"prompt_file,omitempty"`
+
+	// StructuredOutput sends a JSON schema with openai-format requests so the
+	// model must return well-formed triage results.
+	StructuredOutput bool `yaml:"structured_output,omitempty"`
+
+	// Headers are extra HTTP headers sent with every request. They override
+	// provider defaults such as OpenRouter's HTTP-Referer and X-Title; an
+	// empty value drops a default.
+	Headers map[string]string `yaml:"headers,omitempty"`
 }
 
-// Config holds application configuration
-type Config struct {
+// LoadPromptTemplate returns the custom auto-triage prompt, or "" when none is
+// configured. An inline prompt_template wins over prompt_file.
+func (l LLMConfig) LoadPromptTemplate() (string, error) {
+	if l.PromptTemplate != "" {
+		return l.PromptTemplate, nil
+	}
+	if l.PromptFile == "" {
+		return "", nil
+	}
+
+	path := l.PromptFile
+	if !filepath.IsAbs(path) {
+		dir, err := GetConfigDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve prompt_file: %w", err)
+		}
+		path = filepath.Join(dir, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read prompt_file: %w", err)
+	}
+	return string(data), nil
+}
+
+// FetchPreset is a named fetch configuration applied from the config screen.
+// Empty fields keep the current setting.
+type FetchPreset struct {
+	Location string `yaml:"location"` // new (inbox) or feed
+	Days     int    `yaml:"days"`
+	Category string `yaml:"category"` // Readwise category, e.g. article, video, rss
+	Tag      string `yaml:"tag"`
+}
+
+// Profile holds per-account overrides selected via --profile or READWISE_PROFILE.
+// Empty fields fall back to the top-level config values.
+type Profile struct {
 	ReadwiseToken string    `yaml:"readwise_token"`
 	LLM           LLMConfig `yaml:"llm"`
-	InboxDaysAgo  int       `yaml:"inbox_days_ago"`
-	FeedDaysAgo   int       `yaml:"feed_days_ago"`
 	Theme         string    `yaml:"theme"`
-	UseLLMTriage  bool      `yaml:"use_llm_triage"`
-	Location      string    `yaml:"location"`
+}
+
+// Config holds application configuration
+type Config struct {
+	ReadwiseToken         string                 `yaml:"readwise_token"`
+	LLM                   LLMConfig              `yaml:"llm"`
+	SecondaryLLM          LLMConfig              `yaml:"secondary_llm,omitempty"` // optional second provider for A/B comparison
+	InboxDaysAgo          int                    `yaml:"inbox_days_ago"`
+	FeedDaysAgo           int                    `yaml:"feed_days_ago"`
+	Theme                 string                 `yaml:"theme"`
+	UseLLMTriage          bool                   `yaml:"use_llm_triage"`
+	Category              string                 `yaml:"category,omitempty"`  // last-used category filter ("" for all), cycled with c
+	Lookbacks             map[string]int         `yaml:"lookbacks,omitempty"` // "location/category" → lookback days for category filters
+	Location              string                 `yaml:"location"`
+	Deduplicate           bool                   `yaml:"deduplicate,omitempty"`              // collapse fetched items that share a URL
+	Compact               bool                   `yaml:"compact,omitempty"`                  // one-line-per-item review list
+	CleanTitles           bool                   `yaml:"clean_titles,omitempty"`             // strip site-name suffixes from titles in the list
+	ShowAuthor            bool                   `yaml:"show_author,omitempty"`              // add an Author column to the review list
+	FetchLimit            int                    `yaml:"fetch_limit,omitempty"`              // stop fetching after this many items (0 = all)
+	FetchTag              string                 `yaml:"fetch_tag,omitempty"`                // only fetch items with this Readwise tag
+	ReviewUncertainFirst  bool                   `yaml:"review_uncertain_first,omitempty"`   // list needs_review items first after triage, then by priority
+	DefaultAction         string                 `yaml:"default_action,omitempty"`           // pre-filled action for fetched items without a stored decision
+	DefaultPriority       string                 `yaml:"default_priority,omitempty"`         // pre-filled priority for fetched items without one
+	ReviewSuggestedTags   bool                   `yaml:"review_suggested_tags,omitempty"`    // accept or reject LLM-suggested tags before they are saved
+	ExportMaxItems        int                    `yaml:"export_max_items,omitempty"`         // cap on untriaged items per e / ctrl+e export (0 = all)
+	AutoPush              bool                   `yaml:"auto_push,omitempty"`                // push each non-delete decision to Readwise as soon as it is made
+	ConfirmBeforePush     *bool                  `yaml:"confirm_before_push,omitempty"`      // ask before u pushes to Readwise; nil means true
+	StarTag               string                 `yaml:"star_tag,omitempty"`                 // tag pushed for items starred with * ("" keeps stars local)
+	NeedsReviewTag        string                 `yaml:"needs_review_tag,omitempty"`         // tag pushed for needs_review items ("" adds none)
+	AutoArchiveUnderWords int                    `yaml:"auto_archive_under_words,omitempty"` // pre-mark items shorter than this many words as archive on fetch (0 = off)
+	StaleAfterDays        int                    `yaml:"stale_after_days,omitempty"`         // flag items published longer ago than this (0 = 730, negative = never)
+	SessionSaveSeconds    int                    `yaml:"session_save_seconds,omitempty"`     // seconds between session snapshots for crash recovery (0 = 5, negative = off)
+	RefreshMode           string                 `yaml:"refresh_mode,omitempty"`             // R with unpushed notes or start-fresh edits: "ask" (default), "merge" keeps them, "replace" drops them
+	ExportRawJSON         bool                   `yaml:"export_raw_json,omitempty"`          // export the bare items JSON array without the triage prompt
+	ClipboardOSC52        bool                   `yaml:"clipboard_osc52,omitempty"`          // copy through the terminal (OSC52) when the native clipboard fails, even outside SSH
+	PromptLang            string                 `yaml:"prompt_lang,omitempty"`              // language of the built-in export and auto-triage prompts: "en" (default) or "zh"
+	ActionLocations       map[string]string      `yaml:"action_locations,omitempty"`         // action → Readwise location on update ("" leaves the item where it is)
+	Presets               map[string]FetchPreset `yaml:"presets,omitempty"`                  // named fetch configurations, picked with p
+	LastFetchAt           map[string]time.Time   `yaml:"last_fetch_at,omitempty"`            // location → last successful fetch
+	WindowWidth           int                    `yaml:"window_width,omitempty"`             // last terminal size, used for the first frame
+	WindowHeight          int                    `yaml:"window_height,omitempty"`
+	Profiles              map[string]Profile     `yaml:"profiles,omitempty"`
+
+	// Profile is the name of the active profile ("" for the top-level config).
+	Profile string `yaml:"-"`
+
+	// ThemeOverride is a session-only theme from READWISE_TRIAGE_THEME.
+	// It is never saved, so Theme keeps the persisted preference.
+	ThemeOverride string `yaml:"-"`
+
+	// WriteSecrets makes Save write the Readwise token and LLM API key,
+	// which it otherwise leaves alone so env and profile secrets stay out
+	// of the file. The setup wizard sets it for the config it creates.
+	WriteSecrets bool `yaml:"-"`
+
+	// WriteLLM makes Save write the LLM provider, base URL, model and API
+	// format, which it otherwise leaves as they are in the file. The
+	// provider picker and the setup wizard set it when they change them.
+	WriteLLM bool `yaml:"-"`
+}
+
+// PresetNames returns the configured fetch preset names in sorted order.
+func (c *Config) PresetNames() []string {
+	names := make([]string, 0, len(c.Presets))
+	for name := range c.Presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ConfirmsPush reports whether u asks for confirmation before pushing
+// updates to Readwise. It defaults to true.
+func (c *Config) ConfirmsPush() bool {
+	return c.ConfirmBeforePush == nil || *c.ConfirmBeforePush
+}
+
+// defaultStaleAfterDays is how old published content gets before it is
+// flagged as stale when stale_after_days is unset: two years.
+const defaultStaleAfterDays = 730
+
+// StaleAfter returns the published age past which items are flagged as
+// stale, or 0 when the flag is turned off.
+func (c *Config) StaleAfter() time.Duration {
+	days := c.StaleAfterDays
+	if days == 0 {
+		days = defaultStaleAfterDays
+	}
+	if days < 0 {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// defaultSessionSaveSeconds is how often the review session is snapshotted
+// when session_save_seconds is unset.
+const defaultSessionSaveSeconds = 5
+
+// SessionSaveInterval returns how often the review session is snapshotted
+// for crash recovery, or 0 when snapshots are turned off.
+func (c *Config) SessionSaveInterval() time.Duration {
+	seconds := c.SessionSaveSeconds
+	if seconds == 0 {
+		seconds = defaultSessionSaveSeconds
+	}
+	if seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// profileOverride is the profile name set via the --profile flag.
+var profileOverride string
+
+// SetProfile selects the profile that Load returns. It takes precedence
+// over the READWISE_PROFILE environment variable.
+func SetProfile(name string) {
+	profileOverride = name
+}
+
+// ActiveProfile returns the selected profile name.
+// Priority: --profile flag > $READWISE_PROFILE > "" (no profile)
+func ActiveProfile() string {
+	if profileOverride != "" {
+		return profileOverride
+	}
+	return os.Getenv("READWISE_PROFILE")
+}
+
+// overridesProvider reports whether a profile's LLM block changes which
+// provider or model is used, rather than just supplying a key.
+func (l LLMConfig) overridesProvider() bool {
+	return l.Provider != "" || l.BaseURL != "" || l.Model != "" || l.APIFormat != ""
 }
 
 // GetLLMConfig returns the effective LLM configuration.
@@ -54,6 +238,12 @@ func (c *Config) GetLLMConfig() LLMConfig {
 	return llm
 }
 
+// HasSecondaryLLM reports whether a secondary_llm block is configured, which
+// makes auto-triage run both providers and show the secondary's decisions.
+func (c *Config) HasSecondaryLLM() bool {
+	return c.SecondaryLLM.Provider != "" || c.SecondaryLLM.APIKey != ""
+}
+
 // Load loads configuration from config file and environment variables
 // Environment variables take precedence over config file values
 func Load() (*Config, error) {
@@ -67,12 +257,53 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to load config file: %w", err)
 	}
 
+	// Profile values override the top-level config file values
+	if name := ActiveProfile(); name != "" {
+		if err := cfg.applyProfile(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateActionLocations(cfg.ActionLocations); err != nil {
+		return nil, err
+	}
+	switch cfg.RefreshMode {
+	case "", "ask", "merge", "replace":
+	default:
+		return nil, fmt.Errorf("unknown refresh_mode %q (want ask, merge, or replace)", cfg.RefreshMode)
+	}
+	switch cfg.PromptLang {
+	case "", "en", "zh":
+	default:
+		return nil, fmt.Errorf("unknown prompt_lang %q (want en or zh)", cfg.PromptLang)
+	}
+
 	// Environment variables override config file
 	cfg.loadFromEnv()
 
 	return cfg, nil
 }
 
+// actionLocationActions are the pushed actions action_locations may remap.
+var actionLocationActions = []string{"read_now", "later", "archive", "delete", "needs_review"}
+
+// readwiseLocations are the locations a Readwise document can be moved to.
+var readwiseLocations = []string{"new", "later", "shortlist", "archive", "feed"}
+
+// validateActionLocations rejects unknown actions and locations in
+// action_locations. An empty location is allowed and means "stay put".
+func validateActionLocations(locations map[string]string) error {
+	for action, location := range locations {
+		if !slices.Contains(actionLocationActions, action) {
+			return fmt.Errorf("action_locations: unknown action %q (want one of %s)", action, strings.Join(actionLocationActions, ", "))
+		}
+		if location != "" && !slices.Contains(readwiseLocations, location) {
+			return fmt.Errorf("action_locations: unknown location %q for %s (want one of %s)", location, action, strings.Join(readwiseLocations, ", "))
+		}
+	}
+	return nil
+}
+
 func (c *Config) loadFromFile() error {
 	configPath := getConfigPath()
 	if configPath == "" {
@@ -101,10 +332,60 @@ func (c *Config) loadFromFile() error {
 	return nil
 }
 
+// applyProfile merges the named profile over the top-level values.
+func (c *Config) applyProfile(name string) error {
+	if strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found in config file", name)
+	}
+
+	c.Profile = name
+	if p.ReadwiseToken != "" {
+		c.ReadwiseToken = p.ReadwiseToken
+	}
+	if p.LLM.Provider != "" {
+		c.LLM.Provider = p.LLM.Provider
+	}
+	if p.LLM.APIKey != "" {
+		c.LLM.APIKey = p.LLM.APIKey
+	}
+	if p.LLM.BaseURL != "" {
+		c.LLM.BaseURL = p.LLM.BaseURL
+	}
+	if p.LLM.Model != "" {
+		c.LLM.Model = p.LLM.Model
+	}
+	if p.LLM.APIFormat != "" {
+		c.LLM.APIFormat = p.LLM.APIFormat
+	}
+	if p.LLM.PromptTemplate != "" {
+		c.LLM.PromptTemplate = p.LLM.PromptTemplate
+	}
+	if p.LLM.PromptFile != "" {
+		c.LLM.PromptFile = p.LLM.PromptFile
+	}
+	if p.LLM.StructuredOutput {
+		c.LLM.StructuredOutput = true
+	}
+	if len(p.LLM.Headers) > 0 {
+		c.LLM.Headers = p.LLM.Headers
+	}
+	if p.Theme != "" {
+		c.Theme = p.Theme
+	}
+	return nil
+}
+
 func (c *Config) loadFromEnv() {
 	if token := os.Getenv("READWISE_TOKEN"); token != "" {
 		c.ReadwiseToken = token
 	}
+	if theme := os.Getenv("READWISE_TRIAGE_THEME"); theme != "" {
+		c.ThemeOverride = theme
+	}
 	// Prefer INBOX_DAYS_AGO, fall back to legacy DEFAULT_DAYS_AGO
 	if daysStr := os.Getenv("INBOX_DAYS_AGO"); daysStr != "" {
 		if d, err := strconv.Atoi(daysStr); err == nil {
@@ -132,6 +413,15 @@ func getConfigPath() string {
 	return filepath.Join(home, ".config", "readwise-triage", "config.yaml")
 }
 
+// ConfigFilePath returns the config file that Load reads and Save writes.
+func ConfigFilePath() (string, error) {
+	configPath := getConfigPath()
+	if configPath == "" {
+		return "", fmt.Errorf("cannot determine config path")
+	}
+	return configPath, nil
+}
+
 func GetConfigDir() (string, error) {
 	configPath := getConfigPath()
 	if configPath == "" {
@@ -156,12 +446,12 @@ func EnsureConfigDir() (string, error) {
 
 // SaveExampleConfig creates an example config file
 func SaveExampleConfig() error {
-	configDir, err := EnsureConfigDir()
-	if err != nil {
+	if _, err := EnsureConfigDir(); err != nil {
 		return err
 	}
 
-	configPath := filepath.Join(configDir, "config.yaml")
+	// Write the file Load reads, which may be a custom READWISE_TRIAGE_CONFIG name
+	configPath := getConfigPath()
 
 	// Check if file already exists
 	if _, err := os.Stat(configPath); err == nil {
@@ -178,11 +468,21 @@ readwise_token: "your_token_here"
 # Supports any OpenAI-compatible API: openai, perplexity, ollama, openrouter, etc.
 # Environment variables LLM_API_KEY, LLM_PROVIDER, LLM_BASE_URL, LLM_MODEL also work.
 llm:
-  provider: "openai"       # "openai", "perplexity", "anthropic", "ollama", or custom
+  provider: "openai"       # "openai", "perplexity", "anthropic", "ollama", "openrouter", or custom
   api_key: ""              # required for cloud providers; not needed for ollama
   # base_url: ""           # override endpoint (defaults per provider)
   # model: ""              # override model (defaults per provider)
   # api_format: ""         # wire format: "openai" (default) or "anthropic"
+  # prompt_file: ""        # custom triage prompt; must contain one %s for the items JSON
+  # structured_output: false  # enforce the result JSON schema (openai format only)
+  # headers:               # extra request headers; openrouter sends HTTP-Referer and X-Title by default
+  #   X-Title: "my-triage"
+
+# Optional: A second LLM queried alongside llm for A/B comparison. Its
+# decisions are shown next to each item but never applied.
+# secondary_llm:
+#   provider: "anthropic"
+#   api_key: ""
 
 # Optional: Default number of days to fetch for inbox (default: 7)
 inbox_days_ago: 7
@@ -190,23 +490,121 @@ inbox_days_ago: 7
 # Optional: Default number of days to fetch for feed (default: 7)
 feed_days_ago: 7
 
-# Optional: Color theme (default, catppuccin, dracula, nord, gruvbox)
+# Optional: Color theme (default, catppuccin, dracula, nord, gruvbox, solarized-light)
 theme: "default"
 
 # Optional: Use LLM auto-triage by default (default: true)
 use_llm_triage: true
+
+# Optional: Collapse items saved more than once with the same URL (default: false)
+# deduplicate: true
+
+# Optional: One-line-per-item review list without the detail pane (toggle with C)
+# compact: false
+
+# Optional: Strip site-name suffixes like " | The New York Times" from list titles (toggle with S)
+# clean_titles: false
+
+# Optional: Add an Author column to the review list (filter with author:<name> either way)
+# show_author: true
+
+# Optional: After triage, list needs_review items first, then by priority (default: false)
+# review_uncertain_first: true
+
+# Optional: Only fetch items with this Readwise tag, filtered by the API
+# fetch_tag: "to-read"
+
+# Optional: After auto-triage, accept or reject each LLM-suggested tag before it is saved (default: false)
+# review_suggested_tags: true
+
+# Optional: Export at most this many untriaged items with e / ctrl+e, for clipboards that choke on large payloads (default: all)
+# export_max_items: 50
+
+# Optional: Ask for confirmation before u pushes (default: true). U always skips it.
+# confirm_before_push: false
+
+# Optional: Push each decision to Readwise as soon as it is made, one document every
+# 1.5s, instead of waiting for u (default: false). Deletes still wait for u.
+# auto_push: true
+
+# Optional: Tag pushed to Readwise for items starred with * (default: none, stars stay local)
+# star_tag: "favorite"
+
+# Optional: Flag items published more than this many days ago as stale in the detail pane (default: 730, -1 turns it off)
+# stale_after_days: 365
+
+# Optional: Pre-mark fetched items shorter than this many words as archive (saved with source "auto-short"),
+# for feed noise like tweets and short blurbs. Items with a decision or no word count are left alone (default: off)
+# auto_archive_under_words: 200
+
+# Optional: Seconds between snapshots of the review session (location, lookback, filter, focused item).
+# After a crash the next launch offers to resume it (default: 5, -1 turns it off)
+# session_save_seconds: 30
+
+# Optional: What R does with notes and start-fresh marks that haven't been pushed yet:
+# "ask" (default), "merge" re-applies them to the re-fetched items, "replace" drops them
+# refresh_mode: "merge"
+
+# Optional: Export only the items JSON array, without the triage prompt, for tools that choke on the long prefix (default: false)
+# export_raw_json: true
+
+# Optional: Copy exports through the terminal with OSC52 when no native clipboard tool works.
+# This is automatic over SSH and on Linux without a display; set it for other setups (default: false)
+# clipboard_osc52: true
+
+# Optional: Language of the built-in export and auto-triage prompts, "en" (default) or "zh".
+# A custom llm.prompt_template or prompt_file still takes precedence for auto-triage.
+# prompt_lang: "zh"
+
+# Optional: Where each action moves a document on update (read_now, later, archive, delete,
+# needs_review → new, later, shortlist, archive, feed, or "" to leave it where it is).
+# Unlisted actions keep the defaults.
+# action_locations:
+#   read_now: "shortlist"
+#   needs_review: ""
+
+# Optional: Tag added on push to needs_review items (default: none), and removed again when a tagged
+# item is later pushed with another action. By default needs_review only moves feed items to the
+# inbox; combine with action_locations.needs_review to leave them untouched ("") or move them elsewhere.
+# needs_review_tag: "needs-review"
+
+# Optional: Pre-fill fetched items that have no stored decision, so you only change the exceptions.
+# Pre-filled items still count as undecided for auto-triage (T), which replaces the default
+# default_action: "later"
+# default_priority: "low"
+
+# Optional: Only fetch the first N items, e.g. to triage a large inbox in batches (default: 0, all)
+# fetch_limit: 50
+
+# Optional: Named fetch presets, picked with p on the config screen
+# presets:
+#   videos:
+#     location: "feed"
+#     days: 3
+#     category: "video"
+#   to-read:
+#     tag: "to-read"
+
+# Optional: Named profiles for multiple Readwise accounts.
+# Select one with --profile <name> or READWISE_PROFILE=<name>.
+# profiles:
+#   work:
+#     readwise_token: "work_token_here"
+#     theme: "nord"
+#     llm:
+#       provider: "anthropic"
+#       api_key: ""
 `
 
 	return os.WriteFile(configPath, []byte(example), 0600)
 }
 
 func (c *Config) Save() error {
-	configDir, err := EnsureConfigDir()
-	if err != nil {
+	if _, err := EnsureConfigDir(); err != nil {
 		return err
 	}
 
-	configPath := filepath.Join(configDir, "config.yaml")
+	configPath := getConfigPath()
 
 	// Load existing config to preserve fields like tokens
 	existing := &Config{InboxDaysAgo: 7, FeedDaysAgo: 7}
@@ -217,10 +615,41 @@ func (c *Config) Save() error {
 	// Update only the fields we manage (not tokens from env vars)
 	existing.InboxDaysAgo = c.InboxDaysAgo
 	existing.FeedDaysAgo = c.FeedDaysAgo
-	existing.Theme = c.Theme
+	if p, ok := existing.Profiles[c.Profile]; ok && c.Profile != "" {
+		// Theme belongs to the active profile, not the shared defaults
+		p.Theme = c.Theme
+		existing.Profiles[c.Profile] = p
+	} else {
+		existing.Theme = c.Theme
+	}
+	if c.WriteLLM {
+		if p, ok := existing.Profiles[c.Profile]; ok && c.Profile != "" && p.LLM.overridesProvider() {
+			// The profile picks its own provider; keep the choice there
+			p.LLM.Provider, p.LLM.BaseURL, p.LLM.Model, p.LLM.APIFormat = c.LLM.Provider, c.LLM.BaseURL, c.LLM.Model, c.LLM.APIFormat
+			existing.Profiles[c.Profile] = p
+		} else {
+			// The API key is never written back; it may come from the env or a profile
+			existing.LLM.Provider = c.LLM.Provider
+			existing.LLM.BaseURL = c.LLM.BaseURL
+			existing.LLM.Model = c.LLM.Model
+			existing.LLM.APIFormat = c.LLM.APIFormat
+		}
+	}
 	existing.UseLLMTriage = c.UseLLMTriage
 	existing.Location = c.Location
-	// Note: We preserve existing.ReadwiseToken
+	existing.Category = c.Category
+	existing.Lookbacks = c.Lookbacks
+	existing.LastFetchAt = c.LastFetchAt
+	existing.Compact = c.Compact
+	existing.CleanTitles = c.CleanTitles
+	existing.FetchLimit = c.FetchLimit
+	existing.WindowWidth = c.WindowWidth
+	existing.WindowHeight = c.WindowHeight
+	if c.WriteSecrets {
+		existing.ReadwiseToken = c.ReadwiseToken
+		existing.LLM.APIKey = c.LLM.APIKey
+	}
+	// Otherwise we preserve existing.ReadwiseToken
 
 	data, err := yaml.Marshal(existing)
 	if err != nil {
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// MemTriageStore is an in-memory TriageStore. Nothing is written to disk, so
+// it suits demo mode and tests.
+type MemTriageStore struct {
+	mu      sync.Mutex
+	entries map[string]TriageEntry
+}
+
+var _ TriageStore = (*MemTriageStore)(nil)
+
+// NewMemTriageStore returns an empty in-memory triage store.
+func NewMemTriageStore() *MemTriageStore {
+	return &MemTriageStore{entries: make(map[string]TriageEntry)}
+}
+
+// SetItem upserts a triage entry, clearing any snooze but keeping the star
+// and reason.
+func (s *MemTriageStore) SetItem(id, action, priority, source string, tags []string, report *triage.Result) {
+	entry := TriageEntry{
+		Action:    action,
+		Priority:  priority,
+		Source:    source,
+		TriagedAt: time.Now().Format(time.RFC3339),
+	}
+	if len(tags) > 0 {
+		entry.Tags = append([]string(nil), tags...)
+	}
+	if report != nil {
+		r := *report
+		entry.Report = &r
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry.Starred = s.entries[id].Starred
+	entry.Reason = s.entries[id].Reason
+	s.entries[id] = entry
+}
+
+// SnoozeItem records a snooze for the given document until the given time.
+func (s *MemTriageStore) SnoozeItem(id string, until time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[id] = TriageEntry{
+		Action:      "snooze",
+		Source:      "snooze",
+		TriagedAt:   time.Now().Format(time.RFC3339),
+		SnoozeUntil: until.UTC().Format(time.RFC3339),
+		Starred:     s.entries[id].Starred,
+		Reason:      s.entries[id].Reason,
+	}
+}
+
+// SetStarred stars or unstars the given document, recording an entry with
+// no action if there is none. Such an entry still counts as untriaged.
+func (s *MemTriageStore) SetStarred(id string, starred bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[id]
+	if !ok {
+		entry = TriageEntry{Source: "manual", TriagedAt: time.Now().Format(time.RFC3339)}
+	}
+	entry.Starred = starred
+	s.entries[id] = entry
+}
+
+// SetReason records or clears why the given document was triaged, recording
+// an entry with no action if there is none. Such an entry still counts as
+// untriaged.
+func (s *MemTriageStore) SetReason(id, reason string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[id]
+	if !ok {
+		entry = TriageEntry{Source: "manual", TriagedAt: time.Now().Format(time.RFC3339)}
+	}
+	entry.Reason = reason
+	s.entries[id] = entry
+}
+
+// GetItem retrieves a triage entry by document ID.
+func (s *MemTriageStore) GetItem(id string) (TriageEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[id]
+	return entry, ok
+}
+
+// HasTriaged returns true if the given document ID has been triaged.
+// Expired snoozes and entries with no action don't count, matching the
+// SQLite store.
+func (s *MemTriageStore) HasTriaged(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.entries[id]
+	if !ok || entry.Action == "" {
+		return false
+	}
+	return entry.SnoozeUntil == "" || entry.Snoozed(time.Now())
+}
+
+// GetUntriagedIDs returns the subset of allIDs that have not been triaged.
+func (s *MemTriageStore) GetUntriagedIDs(allIDs []string) []string {
+	var result []string
+	for _, id := range allIDs {
+		if !s.HasTriaged(id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// Backup writes every entry as JSON, in the same format as the SQLite store.
+func (s *MemTriageStore) Backup(w io.Writer) error {
+	s.mu.Lock()
+	entries := make([]backupEntry, 0, len(s.entries))
+	for id, entry := range s.entries {
+		entries = append(entries, newBackupEntry(id, entry))
+	}
+	s.mu.Unlock()
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	return writeBackup(w, entries)
+}
+
+// Restore upserts the entries of a backup and returns how many were restored.
+func (s *MemTriageStore) Restore(r io.Reader) (int, error) {
+	entries, err := readBackup(r)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range entries {
+		s.entries[e.ID] = e.entry()
+	}
+	return len(entries), nil
+}
+
+// Save is a no-op; entries live only in memory.
+func (s *MemTriageStore) Save() error {
+	return nil
+}
+
+// Close is a no-op; entries live only in memory.
+func (s *MemTriageStore) Close() error {
+	return nil
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Session is a snapshot of an in-progress review. It is written while
+// reviewing and removed on a clean exit, so one left behind means the last
+// run ended unexpectedly and can be resumed.
+type Session struct {
+	Location string    `json:"location"`
+	Category string    `json:"category,omitempty"`
+	Lookback int       `json:"lookback"`
+	Week     int       `json:"week,omitempty"` // week window paged to with [ and ], 0 for the last N days
+	Filter   string    `json:"filter,omitempty"`
+	CursorID string    `json:"cursor_id,omitempty"`
+	SavedAt  time.Time `json:"saved_at"`
+}
+
+// sessionPath returns the session file for the active profile.
+func sessionPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if profile := ActiveProfile(); profile != "" {
+		return filepath.Join(configDir, "session-"+profile+".json"), nil
+	}
+	return filepath.Join(configDir, "session.json"), nil
+}
+
+// LoadSession reads the session left by an earlier run, or returns nil when
+// there is none.
+func LoadSession() (*Session, error) {
+	path, err := sessionPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read session: %w", err)
+	}
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parse session %s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// SaveSession writes s, replacing the file in one step so a crash mid-write
+// leaves the previous snapshot.
+func SaveSession(s Session) error {
+	configDir, err := EnsureConfigDir()
+	if err != nil {
+		return err
+	}
+	path, err := sessionPath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode session: %w", err)
+	}
+	tmp, err := os.CreateTemp(configDir, ".session-*.json")
+	if err != nil {
+		return fmt.Errorf("write session: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write session: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write session: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("write session: %w", err)
+	}
+	return nil
+}
+
+// ClearSession removes the session file. A missing file is not an error.
+func ClearSession() error {
+	path, err := sessionPath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove session: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// triageBackupVersion is the format version written by Backup. Restore
+// rejects backups from a newer version.
+const triageBackupVersion = 1
+
+// triageBackup is the JSON document written by Backup and read by Restore.
+type triageBackup struct {
+	Version   int           `json:"version"`
+	CreatedAt string        `json:"created_at"`
+	Entries   []backupEntry `json:"entries"`
+}
+
+// backupEntry is one stored decision in a backup, keyed by document ID.
+type backupEntry struct {
+	ID          string         `json:"id"`
+	Action      string         `json:"action"`
+	Priority    string         `json:"priority,omitempty"`
+	Tags        []string       `json:"tags,omitempty"`
+	Source      string         `json:"source"`
+	TriagedAt   string         `json:"triaged_at"`
+	Report      *triage.Result `json:"report,omitempty"`
+	SnoozeUntil string         `json:"snooze_until,omitempty"`
+	Starred     bool           `json:"starred,omitempty"`
+	Reason      string         `json:"reason,omitempty"`
+}
+
+func newBackupEntry(id string, e TriageEntry) backupEntry {
+	return backupEntry{
+		ID:          id,
+		Action:      e.Action,
+		Priority:    e.Priority,
+		Tags:        e.Tags,
+		Source:      e.Source,
+		TriagedAt:   e.TriagedAt,
+		Report:      e.Report,
+		SnoozeUntil: e.SnoozeUntil,
+		Starred:     e.Starred,
+		Reason:      e.Reason,
+	}
+}
+
+func (b backupEntry) entry() TriageEntry {
+	return TriageEntry{
+		Action:      b.Action,
+		Priority:    b.Priority,
+		Tags:        b.Tags,
+		Source:      b.Source,
+		TriagedAt:   b.TriagedAt,
+		Report:      b.Report,
+		SnoozeUntil: b.SnoozeUntil,
+		Starred:     b.Starred,
+		Reason:      b.Reason,
+	}
+}
+
+// writeBackup encodes entries as an indented backup document.
+func writeBackup(w io.Writer, entries []backupEntry) error {
+	if entries == nil {
+		entries = []backupEntry{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(triageBackup{
+		Version:   triageBackupVersion,
+		CreatedAt: time.Now().Format(time.RFC3339),
+		Entries:   entries,
+	})
+	if err != nil {
+		return fmt.Errorf("write backup: %w", err)
+	}
+	return nil
+}
+
+// readBackup decodes and checks a backup document. Entries without an ID
+// are rejected, and missing sources and times get the defaults SetItem uses.
+func readBackup(r io.Reader) ([]backupEntry, error) {
+	var backup triageBackup
+	if err := json.NewDecoder(r).Decode(&backup); err != nil {
+		return nil, fmt.Errorf("read backup: %w", err)
+	}
+	if backup.Version > triageBackupVersion {
+		return nil, fmt.Errorf("backup version %d is newer than supported version %d", backup.Version, triageBackupVersion)
+	}
+	now := time.Now().Format(time.RFC3339)
+	for i := range backup.Entries {
+		e := &backup.Entries[i]
+		if e.ID == "" {
+			return nil, fmt.Errorf("backup entry %d has no id", i+1)
+		}
+		if e.Source == "" {
+			e.Source = "manual"
+		}
+		if e.TriagedAt == "" {
+			e.TriagedAt = now
+		}
+	}
+	return backup.Entries, nil
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"time"
@@ -21,30 +22,103 @@ type TriageEntry struct {
 	Source    string
 	TriagedAt string
 	Report    *triage.Result // full LLM report, nil for manual entries
+
+	// SnoozeUntil is the RFC3339 (UTC) time a snooze expires, empty otherwise.
+	SnoozeUntil string
+
+	// Starred flags a standout item. It is independent of the action and
+	// survives later decisions and snoozes.
+	Starred bool
+
+	// Reason is a free-text note on why the item was triaged this way,
+	// entered by hand. Like Starred, it survives later decisions.
+	Reason string
+}
+
+// Snoozed reports whether the entry is a snooze that hasn't expired yet.
+func (e TriageEntry) Snoozed(now time.Time) bool {
+	if e.SnoozeUntil == "" {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, e.SnoozeUntil)
+	return err == nil && now.Before(until)
+}
+
+// TriageStore persists triage decisions across sessions.
+type TriageStore interface {
+	SetItem(id, action, priority, source string, tags []string, report *triage.Result)
+	SnoozeItem(id string, until time.Time)
+	SetStarred(id string, starred bool)
+	SetReason(id, reason string)
+	GetItem(id string) (TriageEntry, bool)
+	HasTriaged(id string) bool
+	GetUntriagedIDs(allIDs []string) []string
+	Backup(w io.Writer) error
+	Restore(r io.Reader) (int, error)
+	Save() error
+	Close() error
 }
 
-// TriageStore persists triage decisions in a SQLite database.
-type TriageStore struct {
+// SQLiteTriageStore persists triage decisions in a SQLite database.
+type SQLiteTriageStore struct {
 	db *sql.DB
 }
 
+var _ TriageStore = (*SQLiteTriageStore)(nil)
+
+// getTriageDBPath returns the triage database path for the active profile.
+// Each profile gets its own database so decisions don't collide across accounts.
 func getTriageDBPath() string {
 	configDir, err := EnsureConfigDir()
 	if err != nil {
 		return ""
 	}
+	if profile := ActiveProfile(); profile != "" {
+		return filepath.Join(configDir, "triage-"+profile+".db")
+	}
 	return filepath.Join(configDir, "triage.db")
 }
 
+// TriageDBPath returns the database file used by LoadTriageStore for the
+// active profile, or "" if the config directory can't be determined.
+func TriageDBPath() string {
+	return getTriageDBPath()
+}
+
+// triageBusyTimeout is how long SQLite retries when another instance holds
+// a lock on the database, before giving up with "database is locked".
+var triageBusyTimeout = 5 * time.Second
+
 // LoadTriageStore opens (or creates) the SQLite-backed triage store.
 // If a legacy triage_store.json exists, its entries are migrated automatically.
-func LoadTriageStore() (*TriageStore, error) {
+// Errors name the database path so a locked or corrupt file can be found.
+func LoadTriageStore() (*SQLiteTriageStore, error) {
 	dbPath := getTriageDBPath()
 	if dbPath == "" {
 		return nil, fmt.Errorf("cannot determine triage store path")
 	}
 
-	db, err := sql.Open("sqlite", dbPath)
+	store, err := openTriageStore(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("triage db %s: %w", dbPath, err)
+	}
+
+	// Auto-migrate from legacy JSON file. The legacy file predates profiles,
+	// so it only ever belongs to the default store.
+	if ActiveProfile() == "" {
+		if err := store.migrateFromJSON(); err != nil {
+			// Migration failure is non-fatal — log-style: we just skip.
+			_ = err
+		}
+	}
+
+	return store, nil
+}
+
+// openTriageStore opens the database at dbPath and ensures the schema exists.
+func openTriageStore(dbPath string) (*SQLiteTriageStore, error) {
+	dsn := fmt.Sprintf("%s?_pragma=busy_timeout(%d)", dbPath, triageBusyTimeout.Milliseconds())
+	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("open triage db: %w", err)
 	}
@@ -55,33 +129,118 @@ func LoadTriageStore() (*TriageStore, error) {
 		return nil, fmt.Errorf("set WAL mode: %w", err)
 	}
 
+	// The original schema; later columns are added by triageMigrations so
+	// new and old databases end up identical.
 	createSQL := `CREATE TABLE IF NOT EXISTS triage_entries (
 		id         TEXT PRIMARY KEY,
 		action     TEXT NOT NULL,
 		priority   TEXT NOT NULL DEFAULT '',
 		tags       TEXT,
 		source     TEXT NOT NULL,
-		triaged_at TEXT NOT NULL,
-		report     TEXT
+		triaged_at TEXT NOT NULL
 	)`
 	if _, err := db.Exec(createSQL); err != nil {
 		db.Close()
 		return nil, fmt.Errorf("create table: %w", err)
 	}
 
-	store := &TriageStore{db: db}
+	if err := migrateTriageDB(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteTriageStore{db: db}, nil
+}
+
+// triageMigrations are the schema changes since the original table, in
+// order. The database's PRAGMA user_version counts how many have been
+// applied. Steps must be idempotent: databases from before versioning may
+// already have some of the columns. Only ever append to this list.
+var triageMigrations = []struct {
+	name string
+	up   func(tx *sql.Tx) error
+}{
+	{"add report", func(tx *sql.Tx) error {
+		return addColumnIfMissing(tx, "triage_entries", "report", "TEXT")
+	}},
+	{"add snooze_until", func(tx *sql.Tx) error {
+		return addColumnIfMissing(tx, "triage_entries", "snooze_until", "TEXT")
+	}},
+	{"add starred", func(tx *sql.Tx) error {
+		return addColumnIfMissing(tx, "triage_entries", "starred", "INTEGER NOT NULL DEFAULT 0")
+	}},
+	{"add reason", func(tx *sql.Tx) error {
+		return addColumnIfMissing(tx, "triage_entries", "reason", "TEXT")
+	}},
+}
+
+// schemaVersion returns the number of triageMigrations applied to db.
+func schemaVersion(db *sql.DB) (int, error) {
+	var version int
+	err := db.QueryRow("PRAGMA user_version").Scan(&version)
+	return version, err
+}
 
-	// Auto-migrate from legacy JSON file.
-	if err := store.migrateFromJSON(); err != nil {
-		// Migration failure is non-fatal — log-style: we just skip.
-		_ = err
+// migrateTriageDB applies the pending triageMigrations, each in its own
+// transaction together with the version bump. A database written by a newer
+// version is left alone; its extra columns are ignored.
+func migrateTriageDB(db *sql.DB) error {
+	version, err := schemaVersion(db)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	for i := version; i < len(triageMigrations); i++ {
+		migration := triageMigrations[i]
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("migrate %s: %w", migration.name, err)
+		}
+		if err := migration.up(tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migrate %s: %w", migration.name, err)
+		}
+		// PRAGMA doesn't take bind parameters
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migrate %s: %w", migration.name, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migrate %s: %w", migration.name, err)
+		}
 	}
+	return nil
+}
 
-	return store, nil
+// addColumnIfMissing runs ALTER TABLE ADD COLUMN unless the column already exists.
+func addColumnIfMissing(tx *sql.Tx, table, column, decl string) error {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, colType string
+		var dflt sql.NullString
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &dflt, &pk); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	_, err = tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, decl))
+	return err
 }
 
 // Close closes the underlying database connection.
-func (s *TriageStore) Close() error {
+func (s *SQLiteTriageStore) Close() error {
 	if s.db != nil {
 		return s.db.Close()
 	}
@@ -89,7 +248,7 @@ func (s *TriageStore) Close() error {
 }
 
 // SetItem upserts a triage entry. report may be nil for manual entries.
-func (s *TriageStore) SetItem(id, action, priority, source string, tags []string, report *triage.Result) {
+func (s *SQLiteTriageStore) SetItem(id, action, priority, source string, tags []string, report *triage.Result) {
 	var tagsJSON *string
 	if len(tags) > 0 {
 		b, _ := json.Marshal(tags)
@@ -114,21 +273,66 @@ func (s *TriageStore) SetItem(id, action, priority, source string, tags []string
 			tags=excluded.tags,
 			source=excluded.source,
 			triaged_at=excluded.triaged_at,
-			report=excluded.report`,
+			report=excluded.report,
+			snooze_until=NULL`,
 		id, action, priority, tagsJSON, source, now, reportJSON)
 }
 
+// SnoozeItem records a snooze for the given document until the given time.
+// Snoozed items are hidden from review and never pushed to Readwise.
+func (s *SQLiteTriageStore) SnoozeItem(id string, until time.Time) {
+	now := time.Now().Format(time.RFC3339)
+	_, _ = s.db.Exec(`INSERT INTO triage_entries (id, action, priority, tags, source, triaged_at, report, snooze_until)
+		VALUES (?, 'snooze', '', NULL, 'snooze', ?, NULL, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			action=excluded.action,
+			priority=excluded.priority,
+			tags=excluded.tags,
+			source=excluded.source,
+			triaged_at=excluded.triaged_at,
+			report=excluded.report,
+			snooze_until=excluded.snooze_until`,
+		id, now, until.UTC().Format(time.RFC3339))
+}
+
+// SetStarred stars or unstars the given document. Starring an item without
+// an entry records one with no action, which still counts as untriaged.
+func (s *SQLiteTriageStore) SetStarred(id string, starred bool) {
+	now := time.Now().Format(time.RFC3339)
+	_, _ = s.db.Exec(`INSERT INTO triage_entries (id, action, priority, source, triaged_at, starred)
+		VALUES (?, '', '', 'manual', ?, ?)
+		ON CONFLICT(id) DO UPDATE SET starred=excluded.starred`,
+		id, now, starred)
+}
+
+// SetReason records why the given document was triaged the way it was, or
+// clears it when reason is empty. An item without an entry gets one with no
+// action, which still counts as untriaged.
+func (s *SQLiteTriageStore) SetReason(id, reason string) {
+	var reasonText *string
+	if reason != "" {
+		reasonText = &reason
+	}
+	now := time.Now().Format(time.RFC3339)
+	_, _ = s.db.Exec(`INSERT INTO triage_entries (id, action, priority, source, triaged_at, reason)
+		VALUES (?, '', '', 'manual', ?, ?)
+		ON CONFLICT(id) DO UPDATE SET reason=excluded.reason`,
+		id, now, reasonText)
+}
+
 // GetItem retrieves a triage entry by document ID.
-func (s *TriageStore) GetItem(id string) (TriageEntry, bool) {
+func (s *SQLiteTriageStore) GetItem(id string) (TriageEntry, bool) {
 	row := s.db.QueryRow(
-		`SELECT action, priority, tags, source, triaged_at, report FROM triage_entries WHERE id = ?`, id)
+		`SELECT action, priority, tags, source, triaged_at, report, snooze_until, starred, reason FROM triage_entries WHERE id = ?`, id)
 
 	var entry TriageEntry
-	var tagsJSON, reportJSON sql.NullString
+	var tagsJSON, reportJSON, snoozeUntil, reason sql.NullString
 
-	if err := row.Scan(&entry.Action, &entry.Priority, &tagsJSON, &entry.Source, &entry.TriagedAt, &reportJSON); err != nil {
+	if err := row.Scan(&entry.Action, &entry.Priority, &tagsJSON, &entry.Source, &entry.TriagedAt, &reportJSON, &snoozeUntil, &entry.Starred, &reason); err != nil {
 		return TriageEntry{}, false
 	}
+	entry.SnoozeUntil = snoozeUntil.String
+	entry.Reason = reason.String
 
 	if tagsJSON.Valid {
 		_ = json.Unmarshal([]byte(tagsJSON.String), &entry.Tags)
@@ -144,14 +348,17 @@ func (s *TriageStore) GetItem(id string) (TriageEntry, bool) {
 }
 
 // HasTriaged returns true if the given document ID has been triaged.
-func (s *TriageStore) HasTriaged(id string) bool {
+// Expired snoozes don't count, so those items become untriaged again, and
+// neither do entries with no action, which only hold a star or a reason.
+func (s *SQLiteTriageStore) HasTriaged(id string) bool {
 	var exists int
-	err := s.db.QueryRow(`SELECT 1 FROM triage_entries WHERE id = ?`, id).Scan(&exists)
+	now := time.Now().UTC().Format(time.RFC3339)
+	err := s.db.QueryRow(`SELECT 1 FROM triage_entries WHERE id = ? AND action <> '' AND (snooze_until IS NULL OR snooze_until > ?)`, id, now).Scan(&exists)
 	return err == nil
 }
 
 // GetUntriagedIDs returns the subset of allIDs that have not been triaged.
-func (s *TriageStore) GetUntriagedIDs(allIDs []string) []string {
+func (s *SQLiteTriageStore) GetUntriagedIDs(allIDs []string) []string {
 	var result []string
 	for _, id := range allIDs {
 		if !s.HasTriaged(id) {
@@ -161,8 +368,113 @@ func (s *TriageStore) GetUntriagedIDs(allIDs []string) []string {
 	return result
 }
 
+// Count returns the number of stored entries, including snoozes.
+func (s *SQLiteTriageStore) Count() (int, error) {
+	var n int
+	if err := s.db.QueryRow(`SELECT COUNT(*) FROM triage_entries`).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count triage entries: %w", err)
+	}
+	return n, nil
+}
+
+// Backup writes every stored entry, including reports and snoozes, as JSON.
+func (s *SQLiteTriageStore) Backup(w io.Writer) error {
+	rows, err := s.db.Query(
+		`SELECT id, action, priority, tags, source, triaged_at, report, snooze_until, starred, reason FROM triage_entries ORDER BY id`)
+	if err != nil {
+		return fmt.Errorf("query triage entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []backupEntry
+	for rows.Next() {
+		var e backupEntry
+		var tagsJSON, reportJSON, snoozeUntil, reason sql.NullString
+		if err := rows.Scan(&e.ID, &e.Action, &e.Priority, &tagsJSON, &e.Source, &e.TriagedAt, &reportJSON, &snoozeUntil, &e.Starred, &reason); err != nil {
+			return fmt.Errorf("scan triage entry: %w", err)
+		}
+		e.SnoozeUntil = snoozeUntil.String
+		e.Reason = reason.String
+		if tagsJSON.Valid {
+			_ = json.Unmarshal([]byte(tagsJSON.String), &e.Tags)
+		}
+		if reportJSON.Valid {
+			var r triage.Result
+			if json.Unmarshal([]byte(reportJSON.String), &r) == nil {
+				e.Report = &r
+			}
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("query triage entries: %w", err)
+	}
+	return writeBackup(w, entries)
+}
+
+// Restore upserts the entries of a backup written by Backup, keeping their
+// original triage times, and returns how many were restored. The whole
+// backup is applied in one transaction, so a bad entry restores nothing.
+func (s *SQLiteTriageStore) Restore(r io.Reader) (int, error) {
+	entries, err := readBackup(r)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("begin restore tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(`INSERT INTO triage_entries (id, action, priority, tags, source, triaged_at, report, snooze_until, starred, reason)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET
+			action=excluded.action,
+			priority=excluded.priority,
+			tags=excluded.tags,
+			source=excluded.source,
+			triaged_at=excluded.triaged_at,
+			report=excluded.report,
+			snooze_until=excluded.snooze_until,
+			starred=excluded.starred,
+			reason=excluded.reason`)
+	if err != nil {
+		return 0, fmt.Errorf("prepare restore stmt: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, e := range entries {
+		var tagsJSON, reportJSON, snoozeUntil, reason *string
+		if len(e.Tags) > 0 {
+			b, _ := json.Marshal(e.Tags)
+			str := string(b)
+			tagsJSON = &str
+		}
+		if e.Report != nil {
+			b, _ := json.Marshal(e.Report)
+			str := string(b)
+			reportJSON = &str
+		}
+		if e.SnoozeUntil != "" {
+			snoozeUntil = &e.SnoozeUntil
+		}
+		if e.Reason != "" {
+			reason = &e.Reason
+		}
+		if _, err := stmt.Exec(e.ID, e.Action, e.Priority, tagsJSON, e.Source, e.TriagedAt, reportJSON, snoozeUntil, e.Starred, reason); err != nil {
+			return 0, fmt.Errorf("restore entry %s: %w", e.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit restore: %w", err)
+	}
+	return len(entries), nil
+}
+
 // Save is a no-op retained for caller compatibility. Writes are immediate.
-func (s *TriageStore) Save() error {
+func (s *SQLiteTriageStore) Save() error {
 	return nil
 }
 
@@ -181,7 +493,7 @@ type legacyEntry struct {
 	Source    string   `json:"source"`
 }
 
-func (s *TriageStore) migrateFromJSON() error {
+func (s *SQLiteTriageStore) migrateFromJSON() error {
 	configDir, err := EnsureConfigDir()
 	if err != nil {
 		return err
@@ -1,10 +1,15 @@
 package config
 
 import (
+	"bytes"
+	"database/sql"
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/mcao2/readwise-triage/internal/triage"
 	"gopkg.in/yaml.v3"
@@ -94,6 +99,30 @@ func TestTriageStoreEmpty(t *testing.T) {
 	}
 }
 
+func TestTriageStoreCount(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+
+	store, err := LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore failed: %v", err)
+	}
+	defer store.Close()
+
+	if TriageDBPath() != filepath.Join(tmpDir, "triage.db") {
+		t.Errorf("TriageDBPath() = %q", TriageDBPath())
+	}
+	if n, err := store.Count(); err != nil || n != 0 {
+		t.Errorf("Count() = %d, %v; want 0", n, err)
+	}
+	store.SetItem("a", "archive", "", "manual", nil, nil)
+	store.SetItem("b", "later", "", "manual", nil, nil)
+	store.SnoozeItem("c", time.Now().Add(time.Hour))
+	if n, err := store.Count(); err != nil || n != 3 {
+		t.Errorf("Count() = %d, %v; want 3", n, err)
+	}
+}
+
 func TestTriageStoreWithReport(t *testing.T) {
 	tmpDir := t.TempDir()
 	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
@@ -383,6 +412,12 @@ func TestConfigSave(t *testing.T) {
 	if !loaded.UseLLMTriage {
 		t.Error("expected UseLLMTriage true")
 	}
+	// Unset optional flags aren't written into the user's file
+	for _, key := range []string{"deduplicate:", "compact:"} {
+		if strings.Contains(string(data), key) {
+			t.Errorf("expected %s left out when unset, got:\n%s", key, data)
+		}
+	}
 }
 
 func TestConfigSavePreservesTokens(t *testing.T) {
@@ -502,3 +537,890 @@ func TestLoadConfigInvalidYAML(t *testing.T) {
 		t.Error("expected error on invalid YAML")
 	}
 }
+
+func TestLoadConfigActionLocations(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		want    map[string]string
+		wantErr string
+	}{
+		{"mapped", "action_locations:\n  read_now: shortlist\n  needs_review: \"\"\n", map[string]string{"read_now": "shortlist", "needs_review": ""}, ""},
+		{"unknown location", "action_locations:\n  read_now: inbox\n", nil, `unknown location "inbox" for read_now`},
+		{"unknown action", "action_locations:\n  snooze: later\n", nil, `unknown action "snooze"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.yaml")
+			os.WriteFile(configPath, []byte(tt.yaml), 0600)
+			t.Setenv("READWISE_TRIAGE_CONFIG", configPath)
+			t.Setenv("READWISE_PROFILE", "")
+
+			cfg, err := Load()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Load() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(cfg.ActionLocations) != len(tt.want) {
+				t.Fatalf("ActionLocations = %v, want %v", cfg.ActionLocations, tt.want)
+			}
+			for action, location := range tt.want {
+				if got, ok := cfg.ActionLocations[action]; !ok || got != location {
+					t.Errorf("ActionLocations[%s] = %q (set %v), want %q", action, got, ok, location)
+				}
+			}
+		})
+	}
+}
+
+func writeProfilesConfig(t *testing.T) string {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	cfgData := Config{
+		ReadwiseToken: "default-token",
+		InboxDaysAgo:  14,
+		Theme:         "dracula",
+		LLM:           LLMConfig{Provider: "openai", APIKey: "default-key"},
+		Profiles: map[string]Profile{
+			"work": {
+				ReadwiseToken: "work-token",
+				Theme:         "nord",
+				LLM:           LLMConfig{Provider: "anthropic"},
+			},
+			"home": {ReadwiseToken: "home-token"},
+		},
+	}
+	data, _ := yaml.Marshal(cfgData)
+	os.WriteFile(configPath, data, 0600)
+
+	t.Setenv("READWISE_TRIAGE_CONFIG", configPath)
+	t.Setenv("READWISE_TOKEN", "")
+	t.Setenv("INBOX_DAYS_AGO", "")
+	t.Setenv("DEFAULT_DAYS_AGO", "")
+	t.Setenv("READWISE_PROFILE", "")
+	t.Cleanup(func() { SetProfile("") })
+	return configPath
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	writeProfilesConfig(t)
+	SetProfile("work")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Profile != "work" {
+		t.Errorf("expected profile 'work', got %q", cfg.Profile)
+	}
+	if cfg.ReadwiseToken != "work-token" {
+		t.Errorf("expected profile token, got %q", cfg.ReadwiseToken)
+	}
+	if cfg.Theme != "nord" {
+		t.Errorf("expected profile theme 'nord', got %q", cfg.Theme)
+	}
+	if cfg.LLM.Provider != "anthropic" {
+		t.Errorf("expected profile provider 'anthropic', got %q", cfg.LLM.Provider)
+	}
+	// Unset profile fields fall back to the top-level values
+	if cfg.LLM.APIKey != "default-key" {
+		t.Errorf("expected default api key to be merged, got %q", cfg.LLM.APIKey)
+	}
+	if cfg.InboxDaysAgo != 14 {
+		t.Errorf("expected default days 14, got %d", cfg.InboxDaysAgo)
+	}
+}
+
+func TestLoadConfigNoProfile(t *testing.T) {
+	writeProfilesConfig(t)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Profile != "" {
+		t.Errorf("expected no profile, got %q", cfg.Profile)
+	}
+	if cfg.ReadwiseToken != "default-token" {
+		t.Errorf("expected default token, got %q", cfg.ReadwiseToken)
+	}
+}
+
+func TestLoadConfigProfilePrecedence(t *testing.T) {
+	writeProfilesConfig(t)
+
+	// Env selects a profile when no flag is given
+	t.Setenv("READWISE_PROFILE", "home")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.ReadwiseToken != "home-token" {
+		t.Errorf("expected env-selected profile token, got %q", cfg.ReadwiseToken)
+	}
+
+	// Flag wins over env
+	SetProfile("work")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.ReadwiseToken != "work-token" {
+		t.Errorf("expected flag-selected profile token, got %q", cfg.ReadwiseToken)
+	}
+
+	// READWISE_TOKEN still overrides the profile token
+	t.Setenv("READWISE_TOKEN", "env-token")
+	cfg, err = Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.ReadwiseToken != "env-token" {
+		t.Errorf("expected env token override, got %q", cfg.ReadwiseToken)
+	}
+}
+
+func TestLoadConfigUnknownProfile(t *testing.T) {
+	writeProfilesConfig(t)
+	SetProfile("missing")
+
+	if _, err := Load(); err == nil {
+		t.Error("expected error for unknown profile")
+	}
+
+	SetProfile("../evil")
+	if _, err := Load(); err == nil {
+		t.Error("expected error for profile name with path separator")
+	}
+}
+
+func TestConfigSaveProfileTheme(t *testing.T) {
+	configPath := writeProfilesConfig(t)
+	SetProfile("work")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	cfg.Theme = "gruvbox"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	savedData, _ := os.ReadFile(configPath)
+	var loaded Config
+	yaml.Unmarshal(savedData, &loaded)
+
+	if loaded.Theme != "dracula" {
+		t.Errorf("expected top-level theme untouched, got %q", loaded.Theme)
+	}
+	if loaded.Profiles["work"].Theme != "gruvbox" {
+		t.Errorf("expected profile theme 'gruvbox', got %q", loaded.Profiles["work"].Theme)
+	}
+	if loaded.Profiles["work"].ReadwiseToken != "work-token" {
+		t.Errorf("expected profile token preserved, got %q", loaded.Profiles["work"].ReadwiseToken)
+	}
+}
+
+func TestConfigSaveLLMProvider(t *testing.T) {
+	tests := []struct {
+		name         string
+		profile      string
+		wantTopLevel string
+		wantProfile  map[string]string
+	}{
+		{"top level", "", "ollama", map[string]string{"work": "anthropic"}},
+		{"profile with provider", "work", "openai", map[string]string{"work": "ollama"}},
+		{"profile with key only", "home", "ollama", map[string]string{"home": ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := writeProfilesConfig(t)
+			t.Setenv("LLM_API_KEY", "")
+			t.Setenv("LLM_PROVIDER", "")
+			t.Setenv("LLM_MODEL", "")
+			SetProfile(tt.profile)
+
+			cfg, err := Load()
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			cfg.LLM.Provider, cfg.LLM.Model = "ollama", "llama3"
+			cfg.WriteLLM = true
+			if err := cfg.Save(); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+
+			savedData, _ := os.ReadFile(configPath)
+			var loaded Config
+			yaml.Unmarshal(savedData, &loaded)
+			if loaded.LLM.Provider != tt.wantTopLevel {
+				t.Errorf("top-level provider = %q, want %q", loaded.LLM.Provider, tt.wantTopLevel)
+			}
+			if loaded.LLM.APIKey != "default-key" {
+				t.Errorf("expected API key preserved, got %q", loaded.LLM.APIKey)
+			}
+			for name, want := range tt.wantProfile {
+				if got := loaded.Profiles[name].LLM.Provider; got != want {
+					t.Errorf("profile %s provider = %q, want %q", name, got, want)
+				}
+			}
+
+			reloaded, err := Load()
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			if llm := reloaded.GetLLMConfig(); llm.Provider != "ollama" || llm.Model != "llama3" {
+				t.Errorf("GetLLMConfig() = %s/%s, want ollama/llama3", llm.Provider, llm.Model)
+			}
+		})
+	}
+}
+
+func TestConfigSaveKeepsLLMProvider(t *testing.T) {
+	configPath := writeProfilesConfig(t)
+	SetProfile("work")
+
+	// Saving other preferences leaves the provider alone unless the picker
+	// changed it
+	cfg := &Config{Profile: "work", Theme: "nord", InboxDaysAgo: 7}
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	savedData, _ := os.ReadFile(configPath)
+	var loaded Config
+	yaml.Unmarshal(savedData, &loaded)
+	if loaded.LLM.Provider != "openai" || loaded.LLM.APIKey != "default-key" {
+		t.Errorf("top-level LLM = %+v, want openai with its key", loaded.LLM)
+	}
+	if got := loaded.Profiles["work"].LLM.Provider; got != "anthropic" {
+		t.Errorf("work profile provider = %q, want anthropic", got)
+	}
+}
+
+func TestTriageStorePathPerProfile(t *testing.T) {
+	writeProfilesConfig(t)
+
+	defaultPath := getTriageDBPath()
+	SetProfile("work")
+	workPath := getTriageDBPath()
+	SetProfile("home")
+	homePath := getTriageDBPath()
+
+	if defaultPath == workPath || workPath == homePath || defaultPath == homePath {
+		t.Errorf("expected distinct store paths, got %q, %q, %q", defaultPath, workPath, homePath)
+	}
+
+	// Decisions in one profile don't leak into another
+	SetProfile("work")
+	workStore, err := LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore failed: %v", err)
+	}
+	workStore.SetItem("doc1", "archive", "", "manual", nil, nil)
+	workStore.Close()
+
+	SetProfile("home")
+	homeStore, err := LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore failed: %v", err)
+	}
+	defer homeStore.Close()
+	if homeStore.HasTriaged("doc1") {
+		t.Error("expected doc1 not to be triaged in the home profile store")
+	}
+}
+
+func TestTriageStoreSnooze(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+
+	store, err := LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore failed: %v", err)
+	}
+	defer store.Close()
+
+	now := time.Now()
+	store.SnoozeItem("active", now.Add(24*time.Hour))
+	store.SnoozeItem("expired", now.Add(-time.Hour))
+
+	entry, ok := store.GetItem("active")
+	if !ok {
+		t.Fatal("expected snoozed item in store")
+	}
+	if entry.Source != "snooze" || entry.Action != "snooze" {
+		t.Errorf("expected snooze source/action, got %q/%q", entry.Source, entry.Action)
+	}
+	if !entry.Snoozed(now) {
+		t.Error("expected active snooze")
+	}
+	if !store.HasTriaged("active") {
+		t.Error("expected active snooze to count as triaged")
+	}
+
+	expired, _ := store.GetItem("expired")
+	if expired.Snoozed(now) {
+		t.Error("expected expired snooze to be inactive")
+	}
+	if store.HasTriaged("expired") {
+		t.Error("expected expired snooze not to count as triaged")
+	}
+
+	// A real decision replaces the snooze
+	store.SetItem("active", "later", "", "manual", nil, nil)
+	entry, _ = store.GetItem("active")
+	if entry.SnoozeUntil != "" {
+		t.Errorf("expected snooze cleared after SetItem, got %q", entry.SnoozeUntil)
+	}
+}
+
+func TestTriageStoreMigratesSnoozeColumn(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+
+	// Create a database with the pre-snooze schema
+	db, err := sql.Open("sqlite", filepath.Join(tmpDir, "triage.db"))
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	_, err = db.Exec(`CREATE TABLE triage_entries (
+		id TEXT PRIMARY KEY, action TEXT NOT NULL, priority TEXT NOT NULL DEFAULT '',
+		tags TEXT, source TEXT NOT NULL, triaged_at TEXT NOT NULL, report TEXT)`)
+	if err != nil {
+		t.Fatalf("create legacy table: %v", err)
+	}
+	db.Exec(`INSERT INTO triage_entries (id, action, source, triaged_at) VALUES ('old', 'archive', 'manual', '2024-01-01T00:00:00Z')`)
+	db.Close()
+
+	store, err := LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore failed: %v", err)
+	}
+	defer store.Close()
+
+	if entry, ok := store.GetItem("old"); !ok || entry.Action != "archive" {
+		t.Errorf("expected legacy entry readable after migration, got %+v", entry)
+	}
+	store.SnoozeItem("new", time.Now().Add(time.Hour))
+	if !store.HasTriaged("new") {
+		t.Error("expected snooze to work on migrated database")
+	}
+}
+
+func TestTriageStoreMigratesOriginalSchema(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+	dbPath := filepath.Join(tmpDir, "triage.db")
+
+	// The original schema had neither report nor snooze_until, and no version
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	_, err = db.Exec(`CREATE TABLE triage_entries (
+		id TEXT PRIMARY KEY, action TEXT NOT NULL, priority TEXT NOT NULL DEFAULT '',
+		tags TEXT, source TEXT NOT NULL, triaged_at TEXT NOT NULL)`)
+	if err != nil {
+		t.Fatalf("create original table: %v", err)
+	}
+	db.Exec(`INSERT INTO triage_entries (id, action, priority, tags, source, triaged_at) VALUES ('old', 'later', 'high', '["go"]', 'manual', '2024-01-01T00:00:00Z')`)
+	db.Close()
+
+	store, err := LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore failed: %v", err)
+	}
+	if version, err := schemaVersion(store.db); err != nil || version != len(triageMigrations) {
+		t.Errorf("schema version = %d (%v), want %d", version, err, len(triageMigrations))
+	}
+	var reportColumns int
+	if err := store.db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info('triage_entries') WHERE name = 'report'`).Scan(&reportColumns); err != nil || reportColumns != 1 {
+		t.Errorf("expected the report column added, got %d (%v)", reportColumns, err)
+	}
+	entry, ok := store.GetItem("old")
+	if !ok || entry.Action != "later" || entry.Priority != "high" || len(entry.Tags) != 1 || entry.Tags[0] != "go" {
+		t.Errorf("expected the existing row to survive, got %+v", entry)
+	}
+
+	// New columns are usable
+	report := &triage.Result{ID: "new"}
+	store.SetItem("new", "archive", "", "llm", nil, report)
+	if entry, _ := store.GetItem("new"); entry.Report == nil {
+		t.Error("expected the report stored in the migrated column")
+	}
+	store.Close()
+
+	// Reopening is a no-op
+	store, err = LoadTriageStore()
+	if err != nil {
+		t.Fatalf("reopen failed: %v", err)
+	}
+	defer store.Close()
+	if n, _ := store.Count(); n != 2 {
+		t.Errorf("expected 2 entries after reopening, got %d", n)
+	}
+}
+
+func TestLoadTriageStoreCorrupt(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+
+	dbPath := filepath.Join(tmpDir, "triage.db")
+	if err := os.WriteFile(dbPath, []byte(strings.Repeat("not a database ", 100)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := LoadTriageStore()
+	if err == nil {
+		store.Close()
+		t.Fatal("expected an error for a corrupt database")
+	}
+	if !strings.Contains(err.Error(), dbPath) {
+		t.Errorf("expected error to name %s, got %v", dbPath, err)
+	}
+}
+
+func TestLoadTriageStoreLocked(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+
+	old := triageBusyTimeout
+	triageBusyTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { triageBusyTimeout = old })
+
+	// Another instance holding an exclusive lock on a rollback-journal db
+	dbPath := filepath.Join(tmpDir, "triage.db")
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+	conn, err := db.Conn(t.Context())
+	if err != nil {
+		t.Fatalf("conn: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(t.Context(), "CREATE TABLE t (x); BEGIN EXCLUSIVE"); err != nil {
+		t.Fatalf("lock db: %v", err)
+	}
+
+	start := time.Now()
+	store, err := LoadTriageStore()
+	if err == nil {
+		store.Close()
+		t.Fatal("expected an error while the database is locked")
+	}
+	if !strings.Contains(err.Error(), dbPath) {
+		t.Errorf("expected error to name %s, got %v", dbPath, err)
+	}
+	if elapsed := time.Since(start); elapsed < triageBusyTimeout {
+		t.Errorf("expected a retry for the busy timeout, gave up after %v", elapsed)
+	}
+
+	// Once the lock is released the store opens normally
+	if _, err := conn.ExecContext(t.Context(), "COMMIT"); err != nil {
+		t.Fatalf("unlock db: %v", err)
+	}
+	store, err = LoadTriageStore()
+	if err != nil {
+		t.Fatalf("LoadTriageStore after unlock failed: %v", err)
+	}
+	store.Close()
+}
+
+func TestLoadPromptTemplate(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(dir, "config.yaml"))
+	os.WriteFile(filepath.Join(dir, "prompt.txt"), []byte("From file:\n%s"), 0600)
+
+	tests := []struct {
+		name    string
+		llm     LLMConfig
+		want    string
+		wantErr bool
+	}{
+		{"unset", LLMConfig{}, "", false},
+		{"inline", LLMConfig{PromptTemplate: "Inline:\n%s"}, "Inline:\n%s", false},
+		{"relative file", LLMConfig{PromptFile: "prompt.txt"}, "From file:\n%s", false},
+		{"absolute file", LLMConfig{PromptFile: filepath.Join(dir, "prompt.txt")}, "From file:\n%s", false},
+		{"inline wins", LLMConfig{PromptTemplate: "Inline:\n%s", PromptFile: "prompt.txt"}, "Inline:\n%s", false},
+		{"missing file", LLMConfig{PromptFile: "missing.txt"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.llm.LoadPromptTemplate()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestTriageStoreContract runs the same behavioral checks against every
+// TriageStore implementation.
+func TestTriageStoreContract(t *testing.T) {
+	impls := []struct {
+		name string
+		open func(t *testing.T) TriageStore
+	}{
+		{"sqlite", func(t *testing.T) TriageStore {
+			t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+			store, err := LoadTriageStore()
+			if err != nil {
+				t.Fatalf("LoadTriageStore failed: %v", err)
+			}
+			t.Cleanup(func() { store.Close() })
+			return store
+		}},
+		{"memory", func(t *testing.T) TriageStore {
+			return NewMemTriageStore()
+		}},
+	}
+
+	for _, impl := range impls {
+		t.Run(impl.name, func(t *testing.T) {
+			t.Run("backup and restore", func(t *testing.T) {
+				src := impl.open(t)
+				report := &triage.Result{ID: "a", Title: "A", TriageDecision: triage.TriageDecision{Action: "read_now", Reason: "timely"}}
+				src.SetItem("a", "read_now", "high", "llm", []string{"go"}, report)
+				src.SetItem("b", "archive", "", "manual", nil, nil)
+				src.SetStarred("b", true)
+				src.SetReason("b", "already read the paper")
+				src.SnoozeItem("c", time.Now().Add(48*time.Hour))
+
+				var buf bytes.Buffer
+				if err := src.Backup(&buf); err != nil {
+					t.Fatalf("Backup() error = %v", err)
+				}
+				dst := impl.open(t)
+				dst.SetItem("a", "delete", "low", "manual", nil, nil)
+				n, err := dst.Restore(bytes.NewReader(buf.Bytes()))
+				if err != nil {
+					t.Fatalf("Restore() error = %v", err)
+				}
+				if n != 3 {
+					t.Errorf("Restore() = %d, want 3", n)
+				}
+				for _, id := range []string{"a", "b", "c"} {
+					want, _ := src.GetItem(id)
+					got, ok := dst.GetItem(id)
+					if !ok {
+						t.Fatalf("expected %s restored", id)
+					}
+					if !reflect.DeepEqual(got, want) {
+						t.Errorf("restored %s = %+v, want %+v", id, got, want)
+					}
+				}
+				if got, _ := dst.GetItem("a"); got.Report == nil || got.Report.TriageDecision.Reason != "timely" {
+					t.Errorf("expected the report restored, got %+v", got.Report)
+				}
+				if !dst.HasTriaged("c") {
+					t.Error("expected the restored snooze to still hide c")
+				}
+
+				if _, err := dst.Restore(strings.NewReader(`{"version":1,"entries":[{"action":"later"}]}`)); err == nil {
+					t.Error("expected an entry without an id to be rejected")
+				}
+				if _, err := dst.Restore(strings.NewReader(`{"version":99,"entries":[]}`)); err == nil {
+					t.Error("expected a newer backup version to be rejected")
+				}
+			})
+
+			t.Run("set and get", func(t *testing.T) {
+				store := impl.open(t)
+				report := &triage.Result{ID: "a", Title: "A", TriageDecision: triage.TriageDecision{Action: "later", Reason: "why"}}
+				store.SetItem("a", "later", "medium", "llm", []string{"go", "tui"}, report)
+
+				entry, ok := store.GetItem("a")
+				if !ok {
+					t.Fatal("expected entry for a")
+				}
+				if entry.Action != "later" || entry.Priority != "medium" || entry.Source != "llm" {
+					t.Errorf("unexpected entry: %+v", entry)
+				}
+				if len(entry.Tags) != 2 || entry.Tags[0] != "go" || entry.Tags[1] != "tui" {
+					t.Errorf("expected tags [go tui], got %v", entry.Tags)
+				}
+				if entry.Report == nil || entry.Report.TriageDecision.Reason != "why" {
+					t.Errorf("expected report to round-trip, got %+v", entry.Report)
+				}
+				if _, err := time.Parse(time.RFC3339, entry.TriagedAt); err != nil {
+					t.Errorf("expected RFC3339 triaged_at, got %q", entry.TriagedAt)
+				}
+				if _, ok := store.GetItem("missing"); ok {
+					t.Error("expected no entry for missing id")
+				}
+			})
+
+			t.Run("overwrite", func(t *testing.T) {
+				store := impl.open(t)
+				store.SetItem("a", "later", "medium", "llm", []string{"go"}, &triage.Result{ID: "a"})
+				store.SetItem("a", "archive", "", "manual", nil, nil)
+
+				entry, _ := store.GetItem("a")
+				if entry.Action != "archive" || entry.Source != "manual" {
+					t.Errorf("expected overwritten entry, got %+v", entry)
+				}
+				if len(entry.Tags) != 0 || entry.Report != nil {
+					t.Errorf("expected tags and report cleared, got %v / %+v", entry.Tags, entry.Report)
+				}
+			})
+
+			t.Run("untriaged ids", func(t *testing.T) {
+				store := impl.open(t)
+				store.SetItem("a", "read_now", "high", "manual", nil, nil)
+				store.SetItem("b", "later", "", "manual", nil, nil)
+
+				if !store.HasTriaged("a") || store.HasTriaged("c") {
+					t.Error("unexpected HasTriaged result")
+				}
+				untriaged := store.GetUntriagedIDs([]string{"a", "b", "c", "d"})
+				if len(untriaged) != 2 || untriaged[0] != "c" || untriaged[1] != "d" {
+					t.Errorf("expected [c d], got %v", untriaged)
+				}
+			})
+
+			t.Run("starred", func(t *testing.T) {
+				store := impl.open(t)
+				store.SetStarred("new", true)
+				entry, ok := store.GetItem("new")
+				if !ok || !entry.Starred || entry.Action != "" {
+					t.Errorf("expected a starred entry without an action, got %+v (%v)", entry, ok)
+				}
+				if store.HasTriaged("new") {
+					t.Error("expected a starred entry without an action to count as untriaged")
+				}
+				if untriaged := store.GetUntriagedIDs([]string{"new"}); len(untriaged) != 1 {
+					t.Errorf("expected the starred entry untriaged, got %v", untriaged)
+				}
+
+				// The star survives decisions and snoozes
+				store.SetItem("new", "archive", "low", "manual", nil, nil)
+				if entry, _ := store.GetItem("new"); !entry.Starred || entry.Action != "archive" {
+					t.Errorf("expected the star kept after SetItem, got %+v", entry)
+				}
+				store.SnoozeItem("new", time.Now().Add(time.Hour))
+				if entry, _ := store.GetItem("new"); !entry.Starred {
+					t.Errorf("expected the star kept after SnoozeItem, got %+v", entry)
+				}
+
+				store.SetItem("kept", "later", "", "manual", []string{"go"}, nil)
+				store.SetStarred("kept", true)
+				store.SetStarred("kept", false)
+				if entry, _ := store.GetItem("kept"); entry.Starred || entry.Action != "later" || len(entry.Tags) != 1 {
+					t.Errorf("expected unstarring to leave the decision alone, got %+v", entry)
+				}
+			})
+
+			t.Run("reason", func(t *testing.T) {
+				store := impl.open(t)
+				store.SetItem("a", "archive", "", "manual", nil, nil)
+				store.SetReason("a", "covered in last week's issue")
+				if entry, _ := store.GetItem("a"); entry.Reason != "covered in last week's issue" || entry.Action != "archive" {
+					t.Errorf("expected the reason stored with the decision, got %+v", entry)
+				}
+
+				// The reason survives later decisions until cleared
+				store.SetItem("a", "later", "low", "manual", nil, nil)
+				if entry, _ := store.GetItem("a"); entry.Reason != "covered in last week's issue" {
+					t.Errorf("expected the reason kept after SetItem, got %+v", entry)
+				}
+				store.SetReason("a", "")
+				if entry, _ := store.GetItem("a"); entry.Reason != "" || entry.Action != "later" {
+					t.Errorf("expected the reason cleared, got %+v", entry)
+				}
+
+				// A reason on an undecided item leaves it untriaged
+				store.SetReason("b", "maybe")
+				if entry, ok := store.GetItem("b"); !ok || entry.Reason != "maybe" || entry.Action != "" {
+					t.Errorf("expected a reason entry without an action, got %+v (%v)", entry, ok)
+				}
+				if store.HasTriaged("b") {
+					t.Error("expected a reason-only entry to count as untriaged")
+				}
+			})
+
+			t.Run("snooze", func(t *testing.T) {
+				store := impl.open(t)
+				store.SnoozeItem("active", time.Now().Add(time.Hour))
+				store.SnoozeItem("expired", time.Now().Add(-time.Hour))
+
+				if !store.HasTriaged("active") {
+					t.Error("expected active snooze to count as triaged")
+				}
+				if store.HasTriaged("expired") {
+					t.Error("expected expired snooze to count as untriaged")
+				}
+				entry, _ := store.GetItem("active")
+				if entry.Action != "snooze" || !entry.Snoozed(time.Now()) {
+					t.Errorf("expected snoozed entry, got %+v", entry)
+				}
+
+				store.SetItem("active", "archive", "", "manual", nil, nil)
+				entry, _ = store.GetItem("active")
+				if entry.SnoozeUntil != "" {
+					t.Errorf("expected SetItem to clear the snooze, got %q", entry.SnoozeUntil)
+				}
+			})
+
+			t.Run("save and close", func(t *testing.T) {
+				store := impl.open(t)
+				if err := store.Save(); err != nil {
+					t.Errorf("Save failed: %v", err)
+				}
+				if err := store.Close(); err != nil {
+					t.Errorf("Close failed: %v", err)
+				}
+			})
+		})
+	}
+}
+
+func TestLoadConfigThemeOverride(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	os.WriteFile(configPath, []byte("theme: dracula\n"), 0600)
+	t.Setenv("READWISE_TRIAGE_CONFIG", configPath)
+	t.Setenv("READWISE_TRIAGE_THEME", "nord")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.ThemeOverride != "nord" {
+		t.Errorf("expected theme override 'nord', got %q", cfg.ThemeOverride)
+	}
+	if cfg.Theme != "dracula" {
+		t.Errorf("expected persisted theme 'dracula' to be kept, got %q", cfg.Theme)
+	}
+
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	data, _ := os.ReadFile(configPath)
+	if strings.Contains(string(data), "nord") {
+		t.Error("expected theme override not to be saved")
+	}
+}
+
+func TestLoadConfigPresets(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.yaml")
+	t.Setenv("READWISE_TRIAGE_CONFIG", configPath)
+	t.Setenv("READWISE_PROFILE", "")
+	data := `presets:
+  videos:
+    location: feed
+    days: 3
+    category: video
+  archive-me:
+    tag: to-read
+`
+	if err := os.WriteFile(configPath, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	want := FetchPreset{Location: "feed", Days: 3, Category: "video"}
+	if got := cfg.Presets["videos"]; got != want {
+		t.Errorf("videos preset = %+v, want %+v", got, want)
+	}
+	if got := cfg.PresetNames(); strings.Join(got, ",") != "archive-me,videos" {
+		t.Errorf("PresetNames() = %v, want sorted names", got)
+	}
+
+	// Saving preferences keeps hand-written presets
+	cfg.Theme = "nord"
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	reloaded, err := Load()
+	if err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if got := reloaded.Presets["archive-me"]; got.Tag != "to-read" {
+		t.Errorf("expected presets preserved after Save, got %+v", reloaded.Presets)
+	}
+}
+
+func TestStaleAfter(t *testing.T) {
+	tests := []struct {
+		days int
+		want time.Duration
+	}{
+		{0, 730 * 24 * time.Hour},
+		{365, 365 * 24 * time.Hour},
+		{-1, 0},
+	}
+	for _, tt := range tests {
+		cfg := &Config{StaleAfterDays: tt.days}
+		if got := cfg.StaleAfter(); got != tt.want {
+			t.Errorf("StaleAfter() with stale_after_days %d = %v, want %v", tt.days, got, tt.want)
+		}
+	}
+}
+
+func TestSessionSaveInterval(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    time.Duration
+	}{
+		{0, 5 * time.Second},
+		{30, 30 * time.Second},
+		{-1, 0},
+	}
+	for _, tt := range tests {
+		cfg := &Config{SessionSaveSeconds: tt.seconds}
+		if got := cfg.SessionSaveInterval(); got != tt.want {
+			t.Errorf("SessionSaveInterval() with session_save_seconds %d = %v, want %v", tt.seconds, got, tt.want)
+		}
+	}
+}
+
+func TestSessionRoundTrip(t *testing.T) {
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+
+	if s, err := LoadSession(); err != nil || s != nil {
+		t.Fatalf("expected no session, got %+v, %v", s, err)
+	}
+	want := Session{Location: "feed", Lookback: 14, Filter: "source:x", CursorID: "doc1", SavedAt: time.Now().Truncate(time.Second)}
+	if err := SaveSession(want); err != nil {
+		t.Fatalf("SaveSession failed: %v", err)
+	}
+	got, err := LoadSession()
+	if err != nil || got == nil {
+		t.Fatalf("LoadSession failed: %+v, %v", got, err)
+	}
+	if got.Location != want.Location || got.Lookback != want.Lookback || got.Filter != want.Filter || got.CursorID != want.CursorID || !got.SavedAt.Equal(want.SavedAt) {
+		t.Errorf("expected %+v, got %+v", want, *got)
+	}
+
+	if err := ClearSession(); err != nil {
+		t.Fatalf("ClearSession failed: %v", err)
+	}
+	if err := ClearSession(); err != nil {
+		t.Errorf("expected clearing a missing session to succeed, got %v", err)
+	}
+	if s, _ := LoadSession(); s != nil {
+		t.Errorf("expected the session removed, got %+v", s)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"math/rand/v2"
 	"net/http"
 	"os"
 	"strconv"
@@ -15,6 +17,8 @@ const (
 	authURL        = "https://readwise.io/api/v2/auth/"
 	maxRetries     = 3
 	retryDelay     = time.Second
+	maxBackoff     = 30 * time.Second
+	maxElapsed     = 2 * time.Minute
 )
 
 // HTTPClient defines the interface for HTTP operations
@@ -27,6 +31,12 @@ type Client struct {
 	token      string
 	baseURL    string
 	httpClient HTTPClient
+
+	maxRetries int           // attempts per request, including the first
+	maxElapsed time.Duration // give up rather than wait past this since the first attempt
+	retryDelay time.Duration // base of the exponential backoff
+
+	logger *slog.Logger
 }
 
 // ClientOption allows configuring the Client
@@ -46,6 +56,33 @@ func WithBaseURL(url string) ClientOption {
 	}
 }
 
+// WithMaxRetries sets how many attempts a request gets before failing
+func WithMaxRetries(n int) ClientOption {
+	return func(c *Client) {
+		if n > 0 {
+			c.maxRetries = n
+		}
+	}
+}
+
+// WithMaxElapsed caps the total time spent retrying a single request
+func WithMaxElapsed(d time.Duration) ClientOption {
+	return func(c *Client) {
+		if d > 0 {
+			c.maxElapsed = d
+		}
+	}
+}
+
+// WithLogger logs each request's status, duration, and retries to logger
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
 // NewClient creates a new Readwise API client
 func NewClient(token string, opts ...ClientOption) (*Client, error) {
 	if token == "" {
@@ -59,6 +96,10 @@ func NewClient(token string, opts ...ClientOption) (*Client, error) {
 		token:      token,
 		baseURL:    defaultBaseURL,
 		httpClient: &http.Client{Timeout: 30 * time.Second},
+		maxRetries: maxRetries,
+		maxElapsed: maxElapsed,
+		retryDelay: retryDelay,
+		logger:     slog.New(slog.DiscardHandler),
 	}
 
 	for _, opt := range opts {
@@ -78,53 +119,95 @@ func (c *Client) VerifyToken() (bool, error) {
 	req.Header.Set("Authorization", "Token "+c.token)
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.logger.Warn("readwise token check failed", "err", err)
 		return false, err
 	}
 	defer resp.Body.Close()
 
+	c.logger.Debug("readwise token check", "status", resp.StatusCode)
 	return resp.StatusCode == http.StatusNoContent, nil
 }
 
-// doRequest performs an HTTP request with retry logic
+// doRequest performs an HTTP request with retry logic. Transient failures
+// back off exponentially with jitter; a 429 Retry-After takes precedence.
 func (c *Client) doRequest(req *http.Request) (*http.Response, error) {
 	var lastErr error
+	var wait time.Duration
+	start := time.Now()
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
+	attempt := 0
+	for ; attempt < c.maxRetries; attempt++ {
 		if attempt > 0 {
-			time.Sleep(retryDelay * time.Duration(attempt))
+			if time.Since(start)+wait > c.maxElapsed {
+				c.logger.Error("readwise request gave up", "method", req.Method, "path", req.URL.Path, "attempts", attempt, "elapsed", time.Since(start), "err", lastErr)
+				return nil, fmt.Errorf("request failed after %d attempts in %s: %w", attempt, time.Since(start).Round(time.Millisecond), lastErr)
+			}
+			time.Sleep(wait)
+			// The previous attempt consumed the body
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("failed to reset request body: %w", err)
+				}
+				req.Body = body
+			}
 		}
+		wait = c.nextBackoff(attempt + 1)
 
 		req.Header.Set("Authorization", "Token "+c.token)
 		req.Header.Set("Content-Type", "application/json")
 
+		sent := time.Now()
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			c.logger.Warn("readwise request failed", "method", req.Method, "path", req.URL.Path, "attempt", attempt+1, "err", err)
 			lastErr = err
 			continue
 		}
+		attrs := []any{"method", req.Method, "path", req.URL.Path, "status", resp.StatusCode, "attempt", attempt + 1, "duration", time.Since(sent)}
 
 		if resp.StatusCode == http.StatusTooManyRequests {
 			resp.Body.Close()
-			retryAfter := resp.Header.Get("Retry-After")
-			if seconds, err := strconv.Atoi(retryAfter); err == nil {
-				time.Sleep(time.Duration(seconds) * time.Second)
-			} else {
-				time.Sleep(retryDelay * time.Duration(attempt+1))
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+				wait = time.Duration(seconds) * time.Second
 			}
+			c.logger.Warn("readwise rate limited", append(attrs, "retry_in", wait)...)
 			lastErr = fmt.Errorf("rate limited: %d", resp.StatusCode)
 			continue
 		}
 
 		if resp.StatusCode >= 500 {
 			resp.Body.Close()
+			c.logger.Warn("readwise server error", attrs...)
 			lastErr = fmt.Errorf("server error: %d", resp.StatusCode)
 			continue
 		}
 
+		if resp.StatusCode >= 400 {
+			c.logger.Warn("readwise request rejected", attrs...)
+		} else {
+			c.logger.Debug("readwise request", attrs...)
+		}
 		return resp, nil
 	}
 
-	return nil, fmt.Errorf("request failed after %d retries: %w", maxRetries, lastErr)
+	c.logger.Error("readwise request gave up", "method", req.Method, "path", req.URL.Path, "attempts", attempt, "elapsed", time.Since(start), "err", lastErr)
+	return nil, fmt.Errorf("request failed after %d retries: %w", attempt, lastErr)
+}
+
+// nextBackoff returns the delay before retry number attempt (starting at 1):
+// retryDelay doubled per attempt plus up to 50% jitter, capped at maxBackoff.
+// The jitter keeps concurrent batch workers from retrying in lockstep.
+func (c *Client) nextBackoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := c.retryDelay
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d += time.Duration(rand.Float64() * float64(d) / 2)
+	return min(d, maxBackoff)
 }
 
 // decodeJSON reads and decodes JSON from response body
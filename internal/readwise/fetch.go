@@ -11,6 +11,24 @@ import (
 type FetchOptions struct {
 	DaysAgo  int
 	Location string
+
+	// UpdatedAfter, when set, fetches items updated since this time
+	// instead of the last DaysAgo days.
+	UpdatedAfter time.Time
+
+	// Before, when set, limits results to items updated before this time,
+	// so that together with UpdatedAfter it bounds a window.
+	Before time.Time
+
+	// Limit, when positive, stops paging once this many items are fetched.
+	Limit int
+
+	// Tag, when set, only fetches items with this tag (filtered server-side).
+	Tag string
+
+	// Category, when set, only fetches items of this category, e.g. "video"
+	// or "article" (filtered server-side).
+	Category string
 }
 
 // DefaultFetchOptions returns default fetch options
@@ -21,8 +39,26 @@ func DefaultFetchOptions() FetchOptions {
 	}
 }
 
+// Cursor continues a list query where an earlier fetch stopped. It is
+// returned by GetInboxItemsCursor and GetNextPage and passed back to
+// GetNextPage.
+type Cursor struct {
+	query  url.Values
+	before time.Time
+	page   *string // pageCursor of the page to fetch, nil for the first
+	skip   int     // items at the start of that page already returned
+}
+
 // GetInboxItems fetches inbox items from Readwise with pagination
 func (c *Client) GetInboxItems(opts FetchOptions) ([]Item, error) {
+	items, _, err := c.GetInboxItemsCursor(opts)
+	return items, err
+}
+
+// GetInboxItemsCursor fetches inbox items like GetInboxItems and also
+// returns a cursor for the items after them, or nil when every matching
+// item was fetched. The cursor is only non-nil when Limit stopped paging.
+func (c *Client) GetInboxItemsCursor(opts FetchOptions) ([]Item, *Cursor, error) {
 	if opts.DaysAgo == 0 {
 		opts.DaysAgo = DefaultFetchOptions().DaysAgo
 	}
@@ -31,33 +67,120 @@ func (c *Client) GetInboxItems(opts FetchOptions) ([]Item, error) {
 	}
 
 	startDate := time.Now().AddDate(0, 0, -opts.DaysAgo)
-	updatedAfter := startDate.Format(time.RFC3339)
+	if !opts.UpdatedAfter.IsZero() {
+		startDate = opts.UpdatedAfter
+	}
+	params := url.Values{}
+	params.Set("location", opts.Location)
+	params.Set("updatedAfter", startDate.UTC().Format(time.RFC3339))
+	if !opts.Before.IsZero() {
+		params.Set("updatedBefore", opts.Before.UTC().Format(time.RFC3339))
+	}
+	if opts.Tag != "" {
+		params.Set("tag", opts.Tag)
+	}
+	if opts.Category != "" {
+		params.Set("category", opts.Category)
+	}
 
 	var allItems []Item
-	var cursor *string
+	cursor := &Cursor{query: params, before: opts.Before}
 
 	for {
-		items, nextCursor, err := c.fetchPage(updatedAfter, opts.Location, cursor)
+		items, nextCursor, err := c.fetchPage(params, cursor.page)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		for i, item := range items {
+			// Guard the upper bound locally in case the API ignores it
+			if !opts.Before.IsZero() && !item.UpdatedAt.Time.IsZero() && !item.UpdatedAt.Time.Before(opts.Before) {
+				continue
+			}
+			allItems = append(allItems, item)
+			if opts.Limit > 0 && len(allItems) == opts.Limit && i+1 < len(items) {
+				// Stopped mid-page: continue with the rest of this page
+				cursor.skip = i + 1
+				return allItems, cursor, nil
+			}
 		}
+		cursor.page = nextCursor
+
+		if cursor.page == nil {
+			return allItems, nil, nil
+		}
+		if opts.Limit > 0 && len(allItems) >= opts.Limit {
+			return allItems, cursor, nil
+		}
+	}
+}
 
-		allItems = append(allItems, items...)
-		cursor = nextCursor
+// GetNextPage fetches the next page of a query that an earlier fetch left
+// off at, and returns the cursor after it, or nil at the end.
+func (c *Client) GetNextPage(cursor *Cursor) ([]Item, *Cursor, error) {
+	if cursor == nil {
+		return nil, nil, fmt.Errorf("no more pages to fetch")
+	}
+	items, nextCursor, err := c.fetchPage(cursor.query, cursor.page)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		if cursor == nil {
-			break
+	var pageItems []Item
+	for _, item := range items[min(cursor.skip, len(items)):] {
+		if !cursor.before.IsZero() && !item.UpdatedAt.Time.IsZero() && !item.UpdatedAt.Time.Before(cursor.before) {
+			continue
 		}
+		pageItems = append(pageItems, item)
 	}
 
-	return allItems, nil
+	if nextCursor == nil {
+		return pageItems, nil, nil
+	}
+	return pageItems, &Cursor{query: cursor.query, before: cursor.before, page: nextCursor}, nil
+}
+
+// GetDocument fetches the current metadata of a single document.
+func (c *Client) GetDocument(id string) (Item, error) {
+	return c.getDocument(id, false)
+}
+
+// GetDocumentHTML fetches the full HTML content of a single document.
+// Documents without stored content, such as some feed items, return "".
+func (c *Client) GetDocumentHTML(id string) (string, error) {
+	item, err := c.getDocument(id, true)
+	if err != nil {
+		return "", err
+	}
+	return item.HTMLContent, nil
 }
 
-// fetchPage fetches a single page of results
-func (c *Client) fetchPage(updatedAfter, location string, cursor *string) ([]Item, *string, error) {
+// getDocument looks up one document with the list endpoint's id filter.
+func (c *Client) getDocument(id string, withHTML bool) (Item, error) {
 	params := url.Values{}
-	params.Set("location", location)
-	params.Set("updatedAfter", updatedAfter)
+	params.Set("id", id)
+	if withHTML {
+		params.Set("withHtmlContent", "true")
+	}
+
+	items, _, err := c.fetchPage(params, nil)
+	if err != nil {
+		return Item{}, fmt.Errorf("failed to fetch document %s: %w", id, err)
+	}
+	for _, item := range items {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+	return Item{}, fmt.Errorf("document %s not found", id)
+}
+
+// fetchPage fetches a single page of results for the given list query
+func (c *Client) fetchPage(query url.Values, cursor *string) ([]Item, *string, error) {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
 	if cursor != nil {
 		params.Set("pageCursor", *cursor)
 	}
@@ -2,9 +2,13 @@ package readwise
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 )
@@ -352,12 +356,145 @@ func TestDoRequest429FallsBackToExponentialBackoff(t *testing.T) {
 	if mock.callCount != 2 {
 		t.Errorf("expected 2 calls, got %d", mock.callCount)
 	}
-	// First attempt (attempt=0) with no Retry-After sleeps retryDelay * (0+1) = 1s
+	// With no Retry-After the first retry backs off at least retryDelay (1s)
 	if elapsed < 900*time.Millisecond {
 		t.Errorf("expected backoff delay of ~1s, got %v", elapsed)
 	}
 }
 
+func TestNextBackoff(t *testing.T) {
+	client, _ := NewClient("test-token")
+
+	var prevMax time.Duration
+	for attempt := 1; attempt <= 5; attempt++ {
+		base := retryDelay << (attempt - 1)
+		lo, hi := base, base+base/2
+		for i := 0; i < 50; i++ {
+			d := client.nextBackoff(attempt)
+			if d < lo || d > hi {
+				t.Fatalf("nextBackoff(%d) = %v, want within [%v, %v]", attempt, d, lo, hi)
+			}
+			if d < prevMax {
+				t.Fatalf("nextBackoff(%d) = %v shorter than attempt %d could be (%v)", attempt, d, attempt-1, prevMax)
+			}
+		}
+		prevMax = hi
+	}
+
+	for i := 0; i < 50; i++ {
+		if d := client.nextBackoff(20); d > maxBackoff {
+			t.Fatalf("nextBackoff(20) = %v, want capped at %v", d, maxBackoff)
+		}
+	}
+}
+
+func TestDoRequestRetryLimits(t *testing.T) {
+	serverErrors := func(n int) []*http.Response {
+		var resps []*http.Response
+		for i := 0; i < n; i++ {
+			resps = append(resps, &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+			})
+		}
+		return resps
+	}
+
+	tests := []struct {
+		name      string
+		opts      []ClientOption
+		wantCalls int
+	}{
+		{"default retries", nil, maxRetries},
+		{"max retries honored", []ClientOption{WithMaxRetries(5)}, 5},
+		{"single attempt", []ClientOption{WithMaxRetries(1)}, 1},
+		{"max elapsed stops early", []ClientOption{WithMaxRetries(10), WithMaxElapsed(60 * time.Millisecond)}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{responses: serverErrors(10)}
+			client, _ := NewClient("test-token", append([]ClientOption{WithHTTPClient(mock)}, tt.opts...)...)
+			client.retryDelay = 10 * time.Millisecond // waits of ~10ms, ~20ms, ~40ms keep the test fast
+
+			req, _ := http.NewRequest("GET", client.baseURL+"/list/", nil)
+			if _, err := client.doRequest(req); err == nil {
+				t.Fatal("expected error after exhausting retries")
+			}
+			if mock.callCount != tt.wantCalls {
+				t.Errorf("expected %d calls, got %d", tt.wantCalls, mock.callCount)
+			}
+		})
+	}
+}
+
+func TestDoRequestLogsFailures(t *testing.T) {
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(bytes.NewReader(nil))},
+			{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(bytes.NewReader(nil))},
+		},
+	}
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, _ := NewClient("test-token", WithHTTPClient(mock), WithMaxRetries(2), WithLogger(logger))
+	client.retryDelay = time.Millisecond
+
+	req, _ := http.NewRequest("GET", client.baseURL+"/list/", nil)
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	out := logs.String()
+	for _, want := range []string{
+		`msg="readwise server error" method=GET path=/api/v3/list/ status=500 attempt=1`,
+		`msg="readwise server error" method=GET path=/api/v3/list/ status=500 attempt=2`,
+		`msg="readwise request gave up" method=GET path=/api/v3/list/ attempts=2`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected log line containing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestDoRequestSilentByDefault(t *testing.T) {
+	mock := &mockHTTPClient{
+		responses: []*http.Response{{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(bytes.NewReader(nil))}},
+	}
+	client, _ := NewClient("test-token", WithHTTPClient(mock), WithMaxRetries(1), WithLogger(nil))
+	if client.logger.Enabled(context.Background(), slog.LevelError) {
+		t.Error("expected the default logger to discard everything")
+	}
+	req, _ := http.NewRequest("GET", client.baseURL+"/list/", nil)
+	if _, err := client.doRequest(req); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestDoRequestRetryResendsBody(t *testing.T) {
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusInternalServerError, Body: io.NopCloser(bytes.NewReader(nil))},
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(`{}`)))},
+		},
+	}
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	client.retryDelay = time.Millisecond
+
+	req, _ := http.NewRequest("PATCH", client.baseURL+"/update/x/", bytes.NewReader([]byte(`{"location":"archive"}`)))
+	resp, err := client.doRequest(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(mock.requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(mock.requests))
+	}
+	body, _ := io.ReadAll(mock.requests[1].Body)
+	if string(body) != `{"location":"archive"}` {
+		t.Errorf("retry sent body %q", body)
+	}
+}
+
 func TestUpdateDocumentNoDocumentIDInBody(t *testing.T) {
 	mock := &mockHTTPClient{
 		responses: []*http.Response{
@@ -473,6 +610,43 @@ func TestBatchUpdateWithFailure(t *testing.T) {
 	}
 }
 
+func TestBatchUpdateDocumentGone(t *testing.T) {
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusNotFound, Body: io.NopCloser(bytes.NewReader([]byte(`{"detail":"Not found."}`)))},
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(`{}`)))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock), WithBaseURL("http://fake"))
+
+	updates := []UpdateRequest{
+		{DocumentID: "deleted", Location: "archive"},
+		{DocumentID: "2", Location: "later"},
+	}
+	progress := make(chan BatchUpdateProgress, len(updates))
+	result, err := client.BatchUpdate(updates, progress)
+	close(progress)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Gone != 1 || result.Success != 1 || result.Failed != 0 || len(result.Errors) != 0 {
+		t.Errorf("expected 1 gone and 1 success without failures, got %+v", result)
+	}
+	// A 404 is not retried
+	if len(mock.requests) != 2 {
+		t.Errorf("expected 2 requests, got %d", len(mock.requests))
+	}
+
+	first := <-progress
+	if first.ItemID != "deleted" || !first.Gone || first.Success || !errors.Is(first.Error, ErrDocumentGone) {
+		t.Errorf("expected the 404 reported as gone, got %+v", first)
+	}
+	if second := <-progress; second.Gone || !second.Success {
+		t.Errorf("expected the second update to succeed, got %+v", second)
+	}
+}
+
 func TestBatchUpdateProgress(t *testing.T) {
 	mock := &mockHTTPClient{
 		responses: []*http.Response{
@@ -697,6 +871,46 @@ func TestUpdateDocumentWithNotes(t *testing.T) {
 	}
 }
 
+func TestUpdateDocumentReadingProgress(t *testing.T) {
+	zero := 0.0
+	tests := []struct {
+		name     string
+		progress *float64
+		wantSent bool
+	}{
+		{"set", &zero, true},
+		{"unset", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{
+				responses: []*http.Response{
+					{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(`{}`)))},
+				},
+			}
+			client, _ := NewClient("test-token", WithHTTPClient(mock), WithBaseURL("http://fake"))
+			if err := client.UpdateDocument(UpdateRequest{DocumentID: "doc1", Location: "new", ReadingProgress: tt.progress}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			body, _ := io.ReadAll(mock.requests[0].Body)
+			var payload map[string]interface{}
+			json.Unmarshal(body, &payload)
+
+			got, sent := payload["reading_progress"]
+			if sent != tt.wantSent {
+				t.Fatalf("reading_progress sent = %v, want %v (body %s)", sent, tt.wantSent, body)
+			}
+			if sent && got != 0.0 {
+				t.Errorf("reading_progress = %v, want 0", got)
+			}
+			if payload["location"] != "new" {
+				t.Errorf("expected location alongside progress, got %v", payload["location"])
+			}
+		})
+	}
+}
+
 func TestGetInboxItemsNonOKStatus(t *testing.T) {
 	mock := &mockHTTPClient{
 		responses: []*http.Response{
@@ -735,3 +949,354 @@ func TestGetInboxItemsDefaultOptions(t *testing.T) {
 		t.Errorf("expected 1 item, got %d", len(items))
 	}
 }
+
+func TestGetInboxItemsUpdatedAfter(t *testing.T) {
+	since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	body, _ := json.Marshal(ListResponse{})
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	if _, err := client.GetInboxItems(FetchOptions{DaysAgo: 7, UpdatedAfter: since}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mock.requests) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(mock.requests))
+	}
+	if got := mock.requests[0].URL.Query().Get("updatedAfter"); got != since.Format(time.RFC3339) {
+		t.Errorf("updatedAfter = %q, want %q", got, since.Format(time.RFC3339))
+	}
+}
+
+func TestGetInboxItemsWindow(t *testing.T) {
+	after := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	before := after.AddDate(0, 0, 7)
+	body, _ := json.Marshal(ListResponse{Results: []Item{
+		{ID: "in", UpdatedAt: FlexibleTime{after.Add(time.Hour)}},
+		{ID: "late", UpdatedAt: FlexibleTime{before.Add(time.Hour)}},
+	}})
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	items, err := client.GetInboxItems(FetchOptions{UpdatedAfter: after, Before: before})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	query := mock.requests[0].URL.Query()
+	if got := query.Get("updatedAfter"); got != after.Format(time.RFC3339) {
+		t.Errorf("updatedAfter = %q, want %q", got, after.Format(time.RFC3339))
+	}
+	if got := query.Get("updatedBefore"); got != before.Format(time.RFC3339) {
+		t.Errorf("updatedBefore = %q, want %q", got, before.Format(time.RFC3339))
+	}
+	if len(items) != 1 || items[0].ID != "in" {
+		t.Errorf("expected only the in-window item, got %+v", items)
+	}
+
+	// Without Before no upper bound is sent
+	mock = &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+		},
+	}
+	client, _ = NewClient("test-token", WithHTTPClient(mock))
+	if _, err := client.GetInboxItems(FetchOptions{UpdatedAfter: after}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if query := mock.requests[0].URL.Query(); query.Has("updatedBefore") {
+		t.Errorf("unexpected updatedBefore %q", query.Get("updatedBefore"))
+	}
+}
+
+func TestGetInboxItemsLimit(t *testing.T) {
+	page := func(ids []string, next string) *http.Response {
+		resp := ListResponse{}
+		for _, id := range ids {
+			resp.Results = append(resp.Results, Item{ID: id})
+		}
+		if next != "" {
+			resp.NextPageCursor = &next
+		}
+		body, _ := json.Marshal(resp)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}
+	}
+	pages := func() []*http.Response {
+		return []*http.Response{
+			page([]string{"a", "b"}, "p2"),
+			page([]string{"c", "d"}, "p3"),
+			page([]string{"e"}, ""),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		limit     int
+		wantIDs   []string
+		wantPages int
+	}{
+		{"first page suffices", 1, []string{"a"}, 1},
+		{"spans pages", 3, []string{"a", "b", "c"}, 2},
+		{"exact page boundary", 4, []string{"a", "b", "c", "d"}, 2},
+		{"limit above total", 10, []string{"a", "b", "c", "d", "e"}, 3},
+		{"no limit", 0, []string{"a", "b", "c", "d", "e"}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockHTTPClient{responses: pages()}
+			client, _ := NewClient("test-token", WithHTTPClient(mock))
+			items, err := client.GetInboxItems(FetchOptions{Limit: tt.limit})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var ids []string
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if len(mock.requests) != tt.wantPages {
+				t.Errorf("fetched %d pages, want %d", len(mock.requests), tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestGetNextPage(t *testing.T) {
+	page := func(ids []string, next string) *http.Response {
+		resp := ListResponse{}
+		for _, id := range ids {
+			resp.Results = append(resp.Results, Item{ID: id})
+		}
+		if next != "" {
+			resp.NextPageCursor = &next
+		}
+		body, _ := json.Marshal(resp)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}
+	}
+	ids := func(items []Item) string {
+		var out []string
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return strings.Join(out, ",")
+	}
+
+	mock := &mockHTTPClient{responses: []*http.Response{
+		page([]string{"a", "b", "c"}, "p2"),
+		page([]string{"a", "b", "c"}, "p2"), // the first page again, for the rest of it
+		page([]string{"d", "e"}, ""),
+	}}
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+
+	items, cursor, err := client.GetInboxItemsCursor(FetchOptions{Location: "feed", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids(items) != "a,b" || cursor == nil {
+		t.Fatalf("expected a,b and a cursor, got %q / %v", ids(items), cursor)
+	}
+
+	// The limit stopped mid-page, so the next page is the rest of it
+	items, cursor, err = client.GetNextPage(cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids(items) != "c" || cursor == nil {
+		t.Fatalf("expected c and a cursor, got %q / %v", ids(items), cursor)
+	}
+	if query := mock.requests[1].URL.Query(); query.Get("location") != "feed" || query.Has("pageCursor") {
+		t.Errorf("expected the original query without a page cursor, got %v", query)
+	}
+
+	items, cursor, err = client.GetNextPage(cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids(items) != "d,e" || cursor != nil {
+		t.Errorf("expected d,e and no cursor at the end, got %q / %v", ids(items), cursor)
+	}
+	if query := mock.requests[2].URL.Query(); query.Get("pageCursor") != "p2" || query.Get("location") != "feed" {
+		t.Errorf("expected pageCursor=p2 with the original query, got %v", query)
+	}
+
+	if _, _, err := client.GetNextPage(nil); err == nil {
+		t.Error("expected an error without a cursor")
+	}
+}
+
+func TestGetInboxItemsCursorAtPageBoundary(t *testing.T) {
+	next := "p2"
+	body, _ := json.Marshal(ListResponse{Results: []Item{{ID: "a"}, {ID: "b"}}, NextPageCursor: &next})
+	mock := &mockHTTPClient{responses: []*http.Response{
+		{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+	}}
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+
+	_, cursor, err := client.GetInboxItemsCursor(FetchOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cursor == nil || cursor.page == nil || *cursor.page != "p2" || cursor.skip != 0 {
+		t.Errorf("expected a cursor at page p2, got %+v", cursor)
+	}
+
+	// Without a limit everything is fetched and there is nothing left
+	body, _ = json.Marshal(ListResponse{Results: []Item{{ID: "a"}}})
+	mock = &mockHTTPClient{responses: []*http.Response{
+		{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+	}}
+	client, _ = NewClient("test-token", WithHTTPClient(mock))
+	if _, cursor, _ := client.GetInboxItemsCursor(FetchOptions{}); cursor != nil {
+		t.Errorf("expected no cursor after fetching everything, got %+v", cursor)
+	}
+}
+
+func TestGetInboxItemsTag(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+	}{
+		{"tag set", "to-read"},
+		{"no tag", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := "p2"
+			first, _ := json.Marshal(ListResponse{Results: []Item{{ID: "a"}}, NextPageCursor: &next})
+			second, _ := json.Marshal(ListResponse{Results: []Item{{ID: "b"}}})
+			mock := &mockHTTPClient{
+				responses: []*http.Response{
+					{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(first))},
+					{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(second))},
+				},
+			}
+
+			client, _ := NewClient("test-token", WithHTTPClient(mock))
+			if _, err := client.GetInboxItems(FetchOptions{Tag: tt.tag}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// Every page carries the same filters
+			for i, req := range mock.requests {
+				query := req.URL.Query()
+				if tt.tag == "" && query.Has("tag") {
+					t.Errorf("page %d: unexpected tag %q", i+1, query.Get("tag"))
+				}
+				if tt.tag != "" && query.Get("tag") != tt.tag {
+					t.Errorf("page %d: tag = %q, want %q", i+1, query.Get("tag"), tt.tag)
+				}
+				if query.Get("location") != "new" {
+					t.Errorf("page %d: location = %q, want new", i+1, query.Get("location"))
+				}
+			}
+			if got := mock.requests[1].URL.Query().Get("pageCursor"); got != "p2" {
+				t.Errorf("second page cursor = %q, want p2", got)
+			}
+		})
+	}
+}
+
+func TestGetInboxItemsCategory(t *testing.T) {
+	body, _ := json.Marshal(ListResponse{Results: []Item{{ID: "a"}}})
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	if _, err := client.GetInboxItems(FetchOptions{Location: "feed", Category: "video"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	query := mock.requests[0].URL.Query()
+	if query.Get("category") != "video" || query.Get("location") != "feed" {
+		t.Errorf("expected category=video and location=feed, got %v", query)
+	}
+}
+
+func TestGetInboxItemsMinimalResponse(t *testing.T) {
+	// Documents missing most fields still decode; null times stay zero
+	body := []byte(`{"count":2,"nextPageCursor":null,"results":[{"id":"a","title":"First"},{"id":"b","title":"Second","saved_at":null}]}`)
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	items, err := client.GetInboxItems(FetchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 2 || items[0].ID != "a" || items[1].Title != "Second" {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+	if items[1].URL != "" || !items[1].SavedAt.IsZero() || items[1].Tags != nil {
+		t.Errorf("expected missing fields to stay empty, got %+v", items[1])
+	}
+	// The list endpoint leaves out html_content unless withHtmlContent is set
+	if query := mock.requests[0].URL.Query(); query.Has("withHtmlContent") {
+		t.Errorf("expected list fetches not to ask for HTML content, got %v", query)
+	}
+}
+
+func TestGetDocumentHTML(t *testing.T) {
+	body := []byte(`{"count":1,"nextPageCursor":null,"results":[{"id":"doc1","title":"T","html_content":"<p>Full <b>text</b></p>"}]}`)
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+			{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"count":0,"results":[]}`))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	html, err := client.GetDocumentHTML("doc1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if html != "<p>Full <b>text</b></p>" {
+		t.Errorf("GetDocumentHTML() = %q", html)
+	}
+	query := mock.requests[0].URL.Query()
+	if query.Get("id") != "doc1" || query.Get("withHtmlContent") != "true" {
+		t.Errorf("expected id=doc1 and withHtmlContent=true, got %v", query)
+	}
+
+	if _, err := client.GetDocumentHTML("gone"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestGetDocument(t *testing.T) {
+	body := []byte(`{"count":1,"nextPageCursor":null,"results":[{"id":"doc1","title":"Updated","tags":{"go":{"name":"go"}},"reading_progress":0.4}]}`)
+	mock := &mockHTTPClient{
+		responses: []*http.Response{
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))},
+			{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"count":0,"results":[]}`))},
+		},
+	}
+
+	client, _ := NewClient("test-token", WithHTTPClient(mock))
+	item, err := client.GetDocument("doc1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if item.Title != "Updated" || item.ReadingProgress != 0.4 || len(item.Tags) != 1 || item.Tags[0] != "go" {
+		t.Errorf("GetDocument() = %+v", item)
+	}
+	query := mock.requests[0].URL.Query()
+	if query.Get("id") != "doc1" || query.Has("withHtmlContent") {
+		t.Errorf("expected only id=doc1, got %v", query)
+	}
+
+	if _, err := client.GetDocument("gone"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -11,10 +11,14 @@ type FlexibleTime struct {
 	time.Time
 }
 
-// UnmarshalJSON implements custom JSON unmarshaling for FlexibleTime
+// UnmarshalJSON implements custom JSON unmarshaling for FlexibleTime. A null
+// leaves the zero time.
 func (ft *FlexibleTime) UnmarshalJSON(data []byte) error {
 	// Remove quotes from JSON string
 	str := string(data)
+	if str == "null" {
+		return nil
+	}
 	if len(str) >= 2 && str[0] == '"' && str[len(str)-1] == '"' {
 		str = str[1 : len(str)-1]
 	}
@@ -92,6 +96,7 @@ type Item struct {
 	ReadingProgress float64       `json:"reading_progress"`
 	FirstOpenedAt   *FlexibleTime `json:"first_opened_at,omitempty"`
 	LastOpenedAt    *FlexibleTime `json:"last_opened_at,omitempty"`
+	HTMLContent     string        `json:"html_content,omitempty"` // only set when requested with withHtmlContent
 }
 
 // ListResponse represents the API response structure
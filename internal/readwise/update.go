@@ -3,6 +3,7 @@ package readwise
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -14,13 +15,21 @@ type UpdateRequest struct {
 	Location   string   `json:"location,omitempty"`
 	Tags       []string `json:"tags,omitempty"`
 	Notes      string   `json:"notes,omitempty"`
+
+	// ReadingProgress sets the reading progress (0.0–1.0); nil leaves it alone
+	ReadingProgress *float64 `json:"reading_progress,omitempty"`
 }
 
+// ErrDocumentGone is returned by UpdateDocument when Readwise no longer has
+// the document, usually because it was deleted in the web app.
+var ErrDocumentGone = errors.New("document no longer exists in Readwise")
+
 // BatchUpdateResult tracks the result of batch updates
 type BatchUpdateResult struct {
 	Total   int
 	Success int
 	Failed  int
+	Gone    int // documents already deleted in Readwise, not counted as failures
 	Errors  []error
 }
 
@@ -37,6 +46,9 @@ func (c *Client) UpdateDocument(update UpdateRequest) error {
 	if update.Notes != "" {
 		payload["notes"] = update.Notes
 	}
+	if update.ReadingProgress != nil {
+		payload["reading_progress"] = *update.ReadingProgress
+	}
 
 	body, err := json.Marshal(payload)
 	if err != nil {
@@ -56,6 +68,9 @@ func (c *Client) UpdateDocument(update UpdateRequest) error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("update failed with status %d: %w", resp.StatusCode, ErrDocumentGone)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("update failed with status %d", resp.StatusCode)
 	}
@@ -77,7 +92,10 @@ func (c *Client) BatchUpdate(updates []UpdateRequest, progressChan chan<- BatchU
 		<-rateLimiter.C
 
 		err := c.UpdateDocument(update)
-		if err != nil {
+		gone := errors.Is(err, ErrDocumentGone)
+		if gone {
+			result.Gone++
+		} else if err != nil {
 			result.Failed++
 			result.Errors = append(result.Errors, fmt.Errorf("update %s: %w", update.DocumentID, err))
 		} else {
@@ -90,6 +108,8 @@ func (c *Client) BatchUpdate(updates []UpdateRequest, progressChan chan<- BatchU
 				Total:   len(updates),
 				ItemID:  update.DocumentID,
 				Success: err == nil,
+				Gone:    gone,
+				Error:   err,
 			}
 		}
 	}
@@ -103,4 +123,6 @@ type BatchUpdateProgress struct {
 	Total   int
 	ItemID  string
 	Success bool
+	Gone    bool  // the document was already deleted in Readwise
+	Error   error // nil on success
 }
@@ -0,0 +1,133 @@
+package triage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrorKind classifies an LLM API error so callers can give tailored advice.
+type ErrorKind int
+
+const (
+	ErrorOther ErrorKind = iota
+	ErrorAuth
+	ErrorQuota
+	ErrorRateLimit
+	ErrorModelNotFound
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorAuth:
+		return "auth"
+	case ErrorQuota:
+		return "quota"
+	case ErrorRateLimit:
+		return "rate_limit"
+	case ErrorModelNotFound:
+		return "model_not_found"
+	default:
+		return "other"
+	}
+}
+
+// APIError is an error response from an LLM provider.
+type APIError struct {
+	StatusCode int
+	Kind       ErrorKind
+	Type       string // provider error type, e.g. "authentication_error"
+	Code       string // provider error code, e.g. "insufficient_quota"
+	Message    string
+	Model      string // model the request was for, named in model-not-found advice
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+}
+
+// Advice returns guidance for the error's kind, or "" when there is none.
+func (e *APIError) Advice() string {
+	switch e.Kind {
+	case ErrorAuth:
+		return "Your API key is invalid or lacks access — check llm.api_key or LLM_API_KEY"
+	case ErrorQuota:
+		return "You've hit your quota or run out of credits — check billing with your provider"
+	case ErrorRateLimit:
+		return "The provider is rate limiting requests — wait a minute and try again"
+	case ErrorModelNotFound:
+		if e.Model != "" {
+			return fmt.Sprintf("Model %s not found — check llm.model", e.Model)
+		}
+		return "Model not found — check llm.model"
+	default:
+		return ""
+	}
+}
+
+// ErrorAdvice returns the advice for an APIError wrapped in err, or "" when
+// err isn't one or its kind has none.
+func ErrorAdvice(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Advice()
+	}
+	return ""
+}
+
+// parseAPIError extracts a human-readable message and classification from an
+// API error response. OpenAI-style and Anthropic bodies carry an error object
+// with message, type and code; Ollama sends the message as a plain string.
+// Other bodies are kept raw as the message.
+func parseAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Message: string(body)}
+	var parsed struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &parsed) == nil && len(parsed.Error) > 0 {
+		var detail struct {
+			Message string          `json:"message"`
+			Type    string          `json:"type"`
+			Code    json.RawMessage `json:"code"` // a string for OpenAI, a number for Gemini
+			Status  string          `json:"status"`
+		}
+		var text string
+		if json.Unmarshal(parsed.Error, &detail) == nil && detail.Message != "" {
+			apiErr.Message = detail.Message
+			apiErr.Type = detail.Type
+			if apiErr.Type == "" {
+				apiErr.Type = detail.Status
+			}
+			if json.Unmarshal(detail.Code, &text) == nil {
+				apiErr.Code = text
+			}
+		} else if json.Unmarshal(parsed.Error, &text) == nil && text != "" {
+			apiErr.Message = text
+		}
+	}
+	apiErr.Kind = classifyAPIError(apiErr)
+	return apiErr
+}
+
+// classifyAPIError maps provider error types, codes and statuses to a kind.
+func classifyAPIError(e *APIError) ErrorKind {
+	message := strings.ToLower(e.Message)
+	switch {
+	case e.Code == "insufficient_quota" || e.StatusCode == http.StatusPaymentRequired ||
+		strings.Contains(message, "quota") || strings.Contains(message, "credit balance") || strings.Contains(message, "insufficient credits"):
+		return ErrorQuota
+	case e.Code == "invalid_api_key" || e.Type == "authentication_error" || e.Type == "permission_error" ||
+		e.Type == "UNAUTHENTICATED" || e.Type == "PERMISSION_DENIED" || strings.Contains(message, "api key not valid") ||
+		e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+		return ErrorAuth
+	case e.Code == "rate_limit_exceeded" || e.Type == "rate_limit_error" || e.StatusCode == http.StatusTooManyRequests:
+		return ErrorRateLimit
+	case e.Code == "model_not_found" ||
+		(e.Type == "not_found_error" || e.StatusCode == http.StatusNotFound) && strings.Contains(message, "model"):
+		return ErrorModelNotFound
+	default:
+		return ErrorOther
+	}
+}
@@ -2,11 +2,15 @@ package triage
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 )
@@ -22,11 +26,33 @@ var providerDefaults = map[string]struct {
 	BaseURL   string
 	Model     string
 	APIFormat string
+	Headers   map[string]string // sent with every request
 }{
 	"perplexity": {BaseURL: "https://api.perplexity.ai/chat/completions", Model: "sonar", APIFormat: "openai"},
 	"openai":     {BaseURL: "https://api.openai.com/v1/chat/completions", Model: "gpt-4o-mini", APIFormat: "openai"},
 	"anthropic":  {BaseURL: "https://api.anthropic.com/v1/messages", Model: "claude-sonnet-4-5-20250929", APIFormat: "anthropic"},
 	"ollama":     {BaseURL: "http://localhost:11434/v1/chat/completions", Model: "llama3", APIFormat: "openai"},
+	// OpenRouter rejects some models' requests without app attribution
+	"openrouter": {BaseURL: "https://openrouter.ai/api/v1/chat/completions", Model: "openai/gpt-4o-mini", APIFormat: "openai", Headers: map[string]string{
+		"HTTP-Referer": "https://github.com/mcao2/readwise-triage",
+		"X-Title":      "readwise-triage",
+	}},
+}
+
+// Providers returns the names of the known providers in sorted order.
+func Providers() []string {
+	names := make([]string, 0, len(providerDefaults))
+	for name := range providerDefaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultModel returns the model used for a known provider when none is
+// configured, or "" for an unknown provider.
+func DefaultModel(provider string) string {
+	return providerDefaults[provider].Model
 }
 
 // ChatMessage represents a message in the chat API
@@ -37,8 +63,9 @@ type ChatMessage struct {
 
 // ChatRequest represents the API request body
 type ChatRequest struct {
-	Model    string        `json:"model"`
-	Messages []ChatMessage `json:"messages"`
+	Model          string          `json:"model"`
+	Messages       []ChatMessage   `json:"messages"`
+	ResponseFormat *ResponseFormat `json:"response_format,omitempty"`
 }
 
 // ChatResponse represents the API response
@@ -79,7 +106,11 @@ type LLMClient struct {
 	apiKey     string
 	model      string
 	baseURL    string
+	prompt     string            // auto-triage prompt template with one %s for the items JSON
+	structured bool              // request schema-constrained JSON (openai format only)
+	headers    map[string]string // extra request headers, e.g. OpenRouter's attribution
 	httpClient *http.Client
+	logger     *slog.Logger
 }
 
 // LLMOption allows configuring the client
@@ -92,6 +123,15 @@ func WithLLMHTTPClient(client *http.Client) LLMOption {
 	}
 }
 
+// WithLLMLogger logs request sizes, response statuses, and retries to logger
+func WithLLMLogger(logger *slog.Logger) LLMOption {
+	return func(c *LLMClient) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
 // WithLLMModel sets a custom model
 func WithLLMModel(model string) LLMOption {
 	return func(c *LLMClient) {
@@ -119,8 +159,56 @@ func WithLLMAPIFormat(format string) LLMOption {
 	}
 }
 
+// WithLLMPromptTemplate overrides the built-in auto-triage prompt.
+// The template must contain exactly one %s placeholder for the items JSON.
+func WithLLMPromptTemplate(tmpl string) LLMOption {
+	return func(c *LLMClient) {
+		if tmpl != "" {
+			c.prompt = tmpl
+		}
+	}
+}
+
+// WithLLMStructuredOutput asks OpenAI-format APIs to constrain responses to
+// the triage result JSON schema, which avoids prose-wrapped or malformed
+// output. It has no effect on the anthropic format.
+func WithLLMStructuredOutput(enabled bool) LLMOption {
+	return func(c *LLMClient) {
+		c.structured = enabled
+	}
+}
+
+// WithLLMHeaders adds request headers, overriding the provider's defaults.
+// An empty value removes a default header.
+func WithLLMHeaders(headers map[string]string) LLMOption {
+	return func(c *LLMClient) {
+		for name, value := range headers {
+			if value == "" {
+				delete(c.headers, name)
+			} else {
+				c.headers[name] = value
+			}
+		}
+	}
+}
+
+// ValidatePromptTemplate checks that tmpl has exactly one %s placeholder and
+// no other format verbs (use %% for a literal percent sign).
+func ValidatePromptTemplate(tmpl string) error {
+	stripped := strings.ReplaceAll(tmpl, "%%", "")
+	placeholders := strings.Count(stripped, "%s")
+	if placeholders != 1 {
+		return fmt.Errorf("prompt template must contain exactly one %%s placeholder for the items JSON, found %d", placeholders)
+	}
+	if strings.Count(stripped, "%") != 1 {
+		return fmt.Errorf("prompt template contains format verbs other than %%s; use %%%% for a literal percent sign")
+	}
+	return nil
+}
+
 // NewLLMClient creates a new LLM API client.
-// provider can be "perplexity", "openai", "ollama", or empty (defaults to openai).
+// provider can be "perplexity", "openai", "anthropic", "ollama", "openrouter",
+// or empty (defaults to openai).
 // apiKey can be empty for providers that don't require it (e.g., ollama).
 func NewLLMClient(provider, apiKey string, opts ...LLMOption) (*LLMClient, error) {
 	if provider == "" {
@@ -140,7 +228,13 @@ func NewLLMClient(provider, apiKey string, opts ...LLMOption) (*LLMClient, error
 		apiKey:     apiKey,
 		model:      defaults.Model,
 		baseURL:    defaults.BaseURL,
+		prompt:     AutoTriagePromptTemplate,
+		headers:    maps.Clone(defaults.Headers),
 		httpClient: &http.Client{Timeout: defaultLLMTimeout},
+		logger:     slog.New(slog.DiscardHandler),
+	}
+	if client.headers == nil {
+		client.headers = make(map[string]string)
 	}
 
 	for _, opt := range opts {
@@ -175,6 +269,10 @@ func NewLLMClient(provider, apiKey string, opts ...LLMOption) (*LLMClient, error
 		return nil, fmt.Errorf("LLM model is required for provider %q", provider)
 	}
 
+	if err := ValidatePromptTemplate(client.prompt); err != nil {
+		return nil, err
+	}
+
 	// API key is required for non-local providers
 	if client.apiKey == "" && provider != "ollama" {
 		return nil, fmt.Errorf("LLM api_key is required for provider %q", provider)
@@ -184,9 +282,16 @@ func NewLLMClient(provider, apiKey string, opts ...LLMOption) (*LLMClient, error
 }
 
 // TriageItems sends items to the LLM for triage and returns the results.
-// It uses the lean auto-triage prompt that only requests fields consumed downstream.
+// It uses the lean auto-triage prompt that only requests fields consumed downstream,
+// unless a custom template was supplied via WithLLMPromptTemplate.
 func (c *LLMClient) TriageItems(itemsJSON string) ([]Result, error) {
-	prompt := fmt.Sprintf(AutoTriagePromptTemplate, itemsJSON)
+	return c.TriageItemsContext(context.Background(), itemsJSON)
+}
+
+// TriageItemsContext is TriageItems with a context; canceling ctx aborts the
+// in-flight request and any pending retries.
+func (c *LLMClient) TriageItemsContext(ctx context.Context, itemsJSON string) ([]Result, error) {
+	prompt := fmt.Sprintf(c.prompt, itemsJSON)
 
 	var body []byte
 	var err error
@@ -209,6 +314,9 @@ func (c *LLMClient) TriageItems(itemsJSON string) ([]Result, error) {
 				{Role: "user", Content: prompt},
 			},
 		}
+		if c.structured {
+			reqBody.ResponseFormat = triageResponseFormat()
+		}
 		body, err = json.Marshal(reqBody)
 	}
 	if err != nil {
@@ -218,22 +326,33 @@ func (c *LLMClient) TriageItems(itemsJSON string) ([]Result, error) {
 	var lastErr error
 	for attempt := 0; attempt < defaultMaxRetries; attempt++ {
 		if attempt > 0 {
-			time.Sleep(defaultRetryDelay * time.Duration(attempt))
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(defaultRetryDelay * time.Duration(attempt)):
+			}
 		}
 
-		results, err := c.doRequest(body)
+		results, err := c.doRequest(ctx, body)
 		if err != nil {
+			if ctx.Err() != nil {
+				c.logger.Info("llm request canceled", "attempt", attempt+1)
+				return nil, ctx.Err()
+			}
 			// Don't retry client errors (4xx)
 			var noRetry *errNoRetry
 			if errors.As(err, &noRetry) {
+				c.logger.Error("llm request failed", "attempt", attempt+1, "err", noRetry.err)
 				return nil, noRetry.err
 			}
+			c.logger.Warn("llm request failed, retrying", "attempt", attempt+1, "err", err)
 			lastErr = err
 			continue
 		}
 		return results, nil
 	}
 
+	c.logger.Error("llm triage gave up", "attempts", defaultMaxRetries, "err", lastErr)
 	return nil, fmt.Errorf("triage failed after %d retries: %w", defaultMaxRetries, lastErr)
 }
 
@@ -245,8 +364,9 @@ type errNoRetry struct {
 func (e *errNoRetry) Error() string { return e.err.Error() }
 func (e *errNoRetry) Unwrap() error { return e.err }
 
-func (c *LLMClient) doRequest(body []byte) ([]Result, error) {
-	req, err := http.NewRequest("POST", c.baseURL, bytes.NewReader(body))
+// newRequest builds an authenticated POST to the chat endpoint.
+func (c *LLMClient) newRequest(ctx context.Context, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -257,8 +377,63 @@ func (c *LLMClient) doRequest(body []byte) ([]Result, error) {
 	} else if c.apiKey != "" {
 		req.Header.Set("Authorization", "Bearer "+c.apiKey)
 	}
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
 	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// Ping sends a one-word request to check that the provider is reachable and
+// accepts the API key and model. It does not retry or parse the reply.
+func (c *LLMClient) Ping(ctx context.Context) error {
+	var body []byte
+	var err error
+	if c.apiFormat == "anthropic" {
+		body, err = json.Marshal(AnthropicRequest{
+			Model:     c.model,
+			MaxTokens: 1,
+			Messages:  []ChatMessage{{Role: "user", Content: "ping"}},
+		})
+	} else {
+		body, err = json.Marshal(ChatRequest{
+			Model:    c.model,
+			Messages: []ChatMessage{{Role: "user", Content: "ping"}},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := c.newRequest(ctx, body)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.Warn("llm ping failed", "provider", c.provider, "err", err)
+		return err
+	}
+	defer resp.Body.Close()
+	c.logger.Debug("llm ping", "provider", c.provider, "model", c.model, "status", resp.StatusCode)
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		apiErr := parseAPIError(resp.StatusCode, respBody)
+		apiErr.Model = c.model
+		return apiErr
+	}
+	return nil
+}
 
+func (c *LLMClient) doRequest(ctx context.Context, body []byte) ([]Result, error) {
+	req, err := c.newRequest(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("llm request", "provider", c.provider, "model", c.model, "request_bytes", len(body))
+	sent := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -269,9 +444,11 @@ func (c *LLMClient) doRequest(body []byte) ([]Result, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
+	c.logger.Debug("llm response", "status", resp.StatusCode, "response_bytes", len(respBody), "duration", time.Since(sent))
 
 	if resp.StatusCode != http.StatusOK {
 		apiErr := parseAPIError(resp.StatusCode, respBody)
+		apiErr.Model = c.model
 		// Don't retry client errors (4xx) — only server errors are transient
 		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
 			return nil, &errNoRetry{err: apiErr}
@@ -330,17 +507,3 @@ func (c *LLMClient) extractContent(respBody []byte) (string, error) {
 	}
 	return chatResp.Choices[0].Message.Content, nil
 }
-
-// parseAPIError extracts a human-readable message from an API error response.
-// If the body is JSON with an error.message field, it uses that; otherwise falls back to raw body.
-func parseAPIError(statusCode int, body []byte) error {
-	var parsed struct {
-		Error struct {
-			Message string `json:"message"`
-		} `json:"error"`
-	}
-	if json.Unmarshal(body, &parsed) == nil && parsed.Error.Message != "" {
-		return fmt.Errorf("API error (status %d): %s", statusCode, parsed.Error.Message)
-	}
-	return fmt.Errorf("API error (status %d): %s", statusCode, string(body))
-}
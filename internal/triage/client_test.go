@@ -1,12 +1,17 @@
 package triage
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewLLMClient(t *testing.T) {
@@ -200,6 +205,32 @@ func TestLLMClientTriageItems4xxNoRetry(t *testing.T) {
 	}
 }
 
+func TestLLMClientLogsFailedRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":{"message":"Incorrect API key","type":"invalid_request_error"}}`))
+	}))
+	defer server.Close()
+
+	var logs strings.Builder
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, _ := NewLLMClient("openai", "sk-test", WithLLMBaseURL(server.URL), WithLLMLogger(logger))
+	if _, err := client.TriageItems(`[{"id":"1","title":"Test"}]`); err == nil {
+		t.Fatal("expected error on 401 response")
+	}
+
+	out := logs.String()
+	for _, want := range []string{
+		`msg="llm request" provider=openai model=gpt-4o-mini request_bytes=`,
+		`msg="llm response" status=401`,
+		`msg="llm request failed" attempt=1 err="API error (status 401): Incorrect API key"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected log line containing %q, got:\n%s", want, out)
+		}
+	}
+}
+
 func TestLLMClientTriageItemsNonJSONResponse(t *testing.T) {
 	callCount := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -272,6 +303,66 @@ func TestLLMClientTriageItemsRetry(t *testing.T) {
 	}
 }
 
+func TestLLMClientOpenRouterHeaders(t *testing.T) {
+	tests := []struct {
+		name        string
+		provider    string
+		opts        []LLMOption
+		wantReferer string
+		wantTitle   string
+	}{
+		{"openrouter defaults", "openrouter", nil, "https://github.com/mcao2/readwise-triage", "readwise-triage"},
+		{"configured headers", "openrouter", []LLMOption{WithLLMHeaders(map[string]string{"X-Title": "my-triage", "HTTP-Referer": ""})}, "", "my-triage"},
+		{"openai has none", "openai", nil, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer sk-or-test" {
+					t.Errorf("expected Bearer auth, got %q", got)
+				}
+				if got := r.Header.Get("HTTP-Referer"); got != tt.wantReferer {
+					t.Errorf("HTTP-Referer = %q, want %q", got, tt.wantReferer)
+				}
+				if got := r.Header.Get("X-Title"); got != tt.wantTitle {
+					t.Errorf("X-Title = %q, want %q", got, tt.wantTitle)
+				}
+				resp := ChatResponse{
+					Choices: []struct {
+						Message ChatMessage `json:"message"`
+					}{
+						{Message: ChatMessage{Role: "assistant", Content: `[{"id":"item1","title":"Test","triage_decision":{"action":"later"}}]`}},
+					},
+				}
+				json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+
+			opts := append([]LLMOption{WithLLMBaseURL(server.URL)}, tt.opts...)
+			client, err := NewLLMClient(tt.provider, "sk-or-test", opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, err := client.TriageItems(`[{"id":"item1","title":"Test"}]`); err != nil {
+				t.Fatalf("TriageItems failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestOpenRouterDefaults(t *testing.T) {
+	client, err := NewLLMClient("openrouter", "sk-or-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.baseURL != "https://openrouter.ai/api/v1/chat/completions" || client.model != "openai/gpt-4o-mini" || client.apiFormat != "openai" {
+		t.Errorf("unexpected openrouter defaults: %s %s %s", client.baseURL, client.model, client.apiFormat)
+	}
+	if _, err := NewLLMClient("openrouter", ""); err == nil {
+		t.Error("expected an API key to be required")
+	}
+}
+
 func TestLLMClientNoAuthForOllama(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if auth := r.Header.Get("Authorization"); auth != "" {
@@ -340,6 +431,37 @@ func TestAutoTriagePromptTemplate(t *testing.T) {
 	}
 }
 
+func TestPromptLang(t *testing.T) {
+	tests := []struct {
+		lang         string
+		export, auto string
+	}{
+		{"", PromptTemplate, AutoTriagePromptTemplate},
+		{"en", PromptTemplate, AutoTriagePromptTemplate},
+		{"zh", PromptTemplateZH, AutoTriagePromptTemplateZH},
+	}
+	for _, tt := range tests {
+		if ExportPrompt(tt.lang) != tt.export {
+			t.Errorf("ExportPrompt(%q) returned the wrong template", tt.lang)
+		}
+		if AutoTriagePrompt(tt.lang) != tt.auto {
+			t.Errorf("AutoTriagePrompt(%q) returned the wrong template", tt.lang)
+		}
+	}
+
+	for name, tmpl := range map[string]string{"PromptTemplateZH": PromptTemplateZH, "AutoTriagePromptTemplateZH": AutoTriagePromptTemplateZH} {
+		if err := ValidatePromptTemplate(tmpl); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if !contains(tmpl, "**待处理的 inbox 条目：**") {
+			t.Errorf("%s is missing the Chinese inbox marker", name)
+		}
+	}
+	if contains(AutoTriagePromptTemplateZH, "credibility_check") {
+		t.Error("Chinese auto prompt should not contain credibility_check")
+	}
+}
+
 func TestLLMClientTriageItemsAnthropic(t *testing.T) {
 	triageResult := []Result{
 		{
@@ -483,3 +605,348 @@ func TestLLMClientAutoAppendEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestLLMClientCustomPromptTemplate(t *testing.T) {
+	var gotPrompt string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ChatRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if len(req.Messages) > 0 {
+			gotPrompt = req.Messages[len(req.Messages)-1].Content
+		}
+		resp := ChatResponse{
+			Choices: []struct {
+				Message ChatMessage `json:"message"`
+			}{
+				{Message: ChatMessage{Role: "assistant", Content: `[{"id":"item1","title":"Test","triage_decision":{"action":"later"}}]`}},
+			},
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	tmpl := "I mostly read about gardening (100%% of the time). Triage these:\n%s"
+	client, err := NewLLMClient("openai", "sk-test", WithLLMBaseURL(server.URL), WithLLMPromptTemplate(tmpl))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.TriageItems(`[{"id":"item1","title":"Test"}]`); err != nil {
+		t.Fatalf("TriageItems failed: %v", err)
+	}
+
+	want := "I mostly read about gardening (100% of the time). Triage these:\n[{\"id\":\"item1\",\"title\":\"Test\"}]"
+	if gotPrompt != want {
+		t.Errorf("expected custom prompt %q, got %q", want, gotPrompt)
+	}
+}
+
+func TestValidatePromptTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    string
+		wantErr string
+	}{
+		{"valid", "Triage these items:\n%s", ""},
+		{"escaped percent", "Be 100%% honest:\n%s", ""},
+		{"missing placeholder", "Triage my items please", "exactly one %s placeholder"},
+		{"two placeholders", "%s and %s", "found 2"},
+		{"other verb", "Top %d items:\n%s", "other than %s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePromptTemplate(tt.tmpl)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if _, err := NewLLMClient("openai", "sk-test", WithLLMPromptTemplate("no placeholder")); err == nil {
+		t.Error("expected NewLLMClient to reject a template without a placeholder")
+	}
+}
+
+func TestLLMClientTriageItemsContextCancel(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, _ := NewLLMClient("openai", "sk-test", WithLLMBaseURL(server.URL))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.TriageItemsContext(ctx, `[{"id":"1","title":"Test"}]`)
+		done <- err
+	}()
+
+	<-started
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request was not aborted by context cancellation")
+	}
+}
+
+func TestCompareTriage(t *testing.T) {
+	newServer := func(action string, status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+			var results []Result
+			for _, id := range []string{"item3", "item1", "item2"} {
+				results = append(results, Result{
+					ID:             id,
+					Title:          "Test Article",
+					TriageDecision: TriageDecision{Action: action, Priority: "medium"},
+				})
+			}
+			content, _ := json.Marshal(results)
+			json.NewEncoder(w).Encode(ChatResponse{
+				Choices: []struct {
+					Message ChatMessage `json:"message"`
+				}{{Message: ChatMessage{Role: "assistant", Content: string(content)}}},
+			})
+		}))
+	}
+	primarySrv := newServer("read_now", http.StatusOK)
+	defer primarySrv.Close()
+	secondarySrv := newServer("archive", http.StatusOK)
+	defer secondarySrv.Close()
+	failingSrv := newServer("", http.StatusBadRequest)
+	defer failingSrv.Close()
+
+	client := func(url string) *LLMClient {
+		c, err := NewLLMClient("openai", "sk-test", WithLLMBaseURL(url))
+		if err != nil {
+			t.Fatalf("NewLLMClient failed: %v", err)
+		}
+		return c
+	}
+	items := `[{"id":"item1","title":"Test"},{"id":"item2","title":"Test"},{"id":"item3","title":"Test"}]`
+
+	results, err := CompareTriage(context.Background(), client(primarySrv.URL), client(secondarySrv.URL), items)
+	if err != nil {
+		t.Fatalf("CompareTriage failed: %v", err)
+	}
+	// Primary results keep the order the LLM returned them in
+	var ids []string
+	for _, r := range results.Primary {
+		ids = append(ids, r.ID)
+		if r.TriageDecision.Action != "read_now" {
+			t.Errorf("primary action for %s = %q, want read_now", r.ID, r.TriageDecision.Action)
+		}
+	}
+	if got := strings.Join(ids, ","); got != "item3,item1,item2" {
+		t.Errorf("primary order = %s, want item3,item1,item2", got)
+	}
+	if got := results.Secondary["item1"].TriageDecision.Action; got != "archive" {
+		t.Errorf("secondary action = %q, want archive", got)
+	}
+
+	// A failing secondary keeps the primary results
+	results, err = CompareTriage(context.Background(), client(primarySrv.URL), client(failingSrv.URL), items)
+	if err != nil {
+		t.Fatalf("expected secondary failure to be non-fatal, got %v", err)
+	}
+	if results.SecondaryErr == nil || len(results.Secondary) != 0 {
+		t.Errorf("expected SecondaryErr and no secondary results, got %+v", results)
+	}
+	if len(results.Primary) != 3 {
+		t.Errorf("expected primary results, got %d", len(results.Primary))
+	}
+
+	// A failing primary fails the run
+	if _, err := CompareTriage(context.Background(), client(failingSrv.URL), client(secondarySrv.URL), items); err == nil {
+		t.Error("expected primary failure to be returned")
+	}
+}
+
+func TestLLMClientStructuredOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    bool
+		format     string
+		wantFormat bool
+	}{
+		{"enabled", true, "", true},
+		{"disabled", false, "", false},
+		{"ignored for anthropic", true, "anthropic", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&body)
+				// Structured output wraps the array in an object
+				content := `{"results":[{"id":"item1","title":"Test","url":"","triage_decision":{"action":"later","priority":"low","reason":"r"},"metadata_enhancement":{"suggested_tags":["go"]}}]}`
+				if tt.format == "anthropic" {
+					json.NewEncoder(w).Encode(map[string]any{"content": []map[string]string{{"type": "text", "text": content}}})
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]any{
+					"choices": []map[string]any{{"message": map[string]string{"role": "assistant", "content": content}}},
+				})
+			}))
+			defer server.Close()
+
+			client, err := NewLLMClient("openai", "sk-test", WithLLMBaseURL(server.URL), WithLLMAPIFormat(tt.format), WithLLMStructuredOutput(tt.enabled))
+			if err != nil {
+				t.Fatalf("NewLLMClient failed: %v", err)
+			}
+			results, err := client.TriageItems(`[{"id":"item1","title":"Test"}]`)
+			if err != nil {
+				t.Fatalf("TriageItems failed: %v", err)
+			}
+			if len(results) != 1 || results[0].TriageDecision.Action != "later" {
+				t.Errorf("expected the wrapped results to parse, got %+v", results)
+			}
+
+			format, ok := body["response_format"].(map[string]any)
+			if ok != tt.wantFormat {
+				t.Fatalf("response_format present = %v, want %v (body %v)", ok, tt.wantFormat, body)
+			}
+			if !ok {
+				return
+			}
+			if format["type"] != "json_schema" {
+				t.Errorf("response_format.type = %v, want json_schema", format["type"])
+			}
+			schema, _ := format["json_schema"].(map[string]any)
+			if schema["name"] != "triage_results" || schema["strict"] != true {
+				t.Errorf("unexpected json_schema %v", schema)
+			}
+		})
+	}
+}
+
+func TestLLMClientPing(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		status    int
+		body      string
+		wantErr   string
+		wantField string
+	}{
+		{"openai ok", "openai", http.StatusOK, `{"choices":[{"message":{"role":"assistant","content":"pong"}}]}`, "", "messages"},
+		{"anthropic ok", "anthropic", http.StatusOK, `{"content":[{"type":"text","text":"pong"}]}`, "", "max_tokens"},
+		{"bad key", "openai", http.StatusUnauthorized, `{"error":{"message":"Incorrect API key"}}`, "Incorrect API key", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got map[string]any
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				json.NewDecoder(r.Body).Decode(&got)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := NewLLMClient("custom", "sk-test", WithLLMBaseURL(server.URL), WithLLMModel("m"), WithLLMAPIFormat(tt.format))
+			if err != nil {
+				t.Fatalf("NewLLMClient: %v", err)
+			}
+			err = client.Ping(context.Background())
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Ping() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("Ping() error = %v, want %q", err, tt.wantErr)
+			}
+			if calls != 1 {
+				t.Errorf("expected a single request, got %d", calls)
+			}
+			if tt.wantField != "" {
+				if _, ok := got[tt.wantField]; !ok || got["model"] != "m" {
+					t.Errorf("unexpected request body %v", got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseAPIErrorClassification(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		kind    ErrorKind
+		message string
+	}{
+		{"openai invalid key", 401, `{"error":{"message":"Incorrect API key provided: sk-xx.","type":"invalid_request_error","code":"invalid_api_key"}}`, ErrorAuth, "Incorrect API key provided: sk-xx."},
+		{"openai quota", 429, `{"error":{"message":"You exceeded your current quota, please check your plan and billing details.","type":"insufficient_quota","code":"insufficient_quota"}}`, ErrorQuota, "You exceeded your current quota, please check your plan and billing details."},
+		{"openai rate limit", 429, `{"error":{"message":"Rate limit reached for gpt-4o-mini on requests per min.","type":"requests","code":"rate_limit_exceeded"}}`, ErrorRateLimit, "Rate limit reached for gpt-4o-mini on requests per min."},
+		{"openai model not found", 404, `{"error":{"message":"The model ` + "`gpt-5-mini`" + ` does not exist or you do not have access to it.","type":"invalid_request_error","code":"model_not_found"}}`, ErrorModelNotFound, ""},
+		{"anthropic auth", 401, `{"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`, ErrorAuth, "invalid x-api-key"},
+		{"anthropic credits", 400, `{"type":"error","error":{"type":"invalid_request_error","message":"Your credit balance is too low to access the Anthropic API."}}`, ErrorQuota, ""},
+		{"anthropic rate limit", 429, `{"type":"error","error":{"type":"rate_limit_error","message":"Number of request tokens has exceeded your per-minute rate limit"}}`, ErrorRateLimit, ""},
+		{"anthropic model not found", 404, `{"type":"error","error":{"type":"not_found_error","message":"model: claude-nope"}}`, ErrorModelNotFound, "model: claude-nope"},
+		{"ollama model not found", 404, `{"error":"model \"llama9\" not found, try pulling it first"}`, ErrorModelNotFound, `model "llama9" not found, try pulling it first`},
+		{"gemini invalid key", 400, `{"error":{"code":400,"message":"API key not valid. Please pass a valid API key.","status":"INVALID_ARGUMENT"}}`, ErrorAuth, ""},
+		{"wrong endpoint", 404, `{"error":{"message":"Invalid URL (POST /v1)","type":"invalid_request_error"}}`, ErrorOther, "Invalid URL (POST /v1)"},
+		{"plain text", 500, `internal error`, ErrorOther, "internal error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := parseAPIError(tt.status, []byte(tt.body))
+			if err.Kind != tt.kind {
+				t.Errorf("expected kind %v, got %v", tt.kind, err.Kind)
+			}
+			if tt.message != "" && err.Message != tt.message {
+				t.Errorf("expected message %q, got %q", tt.message, err.Message)
+			}
+			if (err.Advice() == "") != (tt.kind == ErrorOther) {
+				t.Errorf("unexpected advice %q for kind %v", err.Advice(), err.Kind)
+			}
+		})
+	}
+}
+
+func TestLLMClientAPIErrorAdvice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"type":"error","error":{"type":"not_found_error","message":"model: claude-nope"}}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewLLMClient("anthropic", "sk-test", WithLLMBaseURL(server.URL), WithLLMModel("claude-nope"))
+	_, err := client.TriageItems(`[{"id":"1","title":"Test"}]`)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Kind != ErrorModelNotFound || apiErr.StatusCode != 404 || apiErr.Type != "not_found_error" {
+		t.Errorf("unexpected error %+v", apiErr)
+	}
+	if advice := ErrorAdvice(err); advice != "Model claude-nope not found — check llm.model" {
+		t.Errorf("unexpected advice %q", advice)
+	}
+	if ErrorAdvice(fmt.Errorf("network down")) != "" {
+		t.Error("expected no advice for other errors")
+	}
+}
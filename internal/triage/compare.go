@@ -0,0 +1,55 @@
+package triage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// TriageResults holds the results of a comparison run. Primary results are
+// the ones applied, in the order the primary LLM returned them; Secondary
+// results are keyed by item ID and shown alongside for A/B comparison.
+type TriageResults struct {
+	Primary      []Result
+	Secondary    map[string]Result
+	SecondaryErr error // a failed secondary run doesn't discard primary results
+}
+
+// CompareTriage sends the same items to both clients concurrently.
+// It fails only if the primary client does.
+func CompareTriage(ctx context.Context, primary, secondary *LLMClient, itemsJSON string) (TriageResults, error) {
+	var wg sync.WaitGroup
+	var primaryResults, secondaryResults []Result
+	var primaryErr, secondaryErr error
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		primaryResults, primaryErr = primary.TriageItemsContext(ctx, itemsJSON)
+	}()
+	go func() {
+		defer wg.Done()
+		secondaryResults, secondaryErr = secondary.TriageItemsContext(ctx, itemsJSON)
+	}()
+	wg.Wait()
+
+	if primaryErr != nil {
+		return TriageResults{}, primaryErr
+	}
+	results := TriageResults{
+		Primary:   primaryResults,
+		Secondary: resultsByID(secondaryResults),
+	}
+	if secondaryErr != nil {
+		results.SecondaryErr = fmt.Errorf("secondary LLM: %w", secondaryErr)
+	}
+	return results, nil
+}
+
+func resultsByID(results []Result) map[string]Result {
+	byID := make(map[string]Result, len(results))
+	for _, r := range results {
+		byID[r.ID] = r
+	}
+	return byID
+}
@@ -7,38 +7,71 @@ import (
 	"strings"
 )
 
-// ParseTriageResponse extracts JSON array from LLM response and parses it
+// ParseTriageResponse extracts JSON array from LLM response and parses it.
+// Malformed objects are skipped; see ParseTriageResponsePartial.
 func ParseTriageResponse(content string) ([]Result, error) {
+	results, _, err := ParseTriageResponsePartial(content)
+	return results, err
+}
+
+// ParseTriageResponsePartial extracts and parses the JSON array from an LLM
+// response, tolerating common LLM mistakes. If the array doesn't parse as a
+// whole, it is repaired and parsed object-by-object so one bad object doesn't
+// discard the rest. It returns the valid results and the number of objects
+// that were skipped because they were malformed or missing required fields.
+func ParseTriageResponsePartial(content string) ([]Result, int, error) {
+	var parsed []Result
+	skipped := 0
+
 	// Try to find JSON array in the response
 	jsonStr := extractJSON(content)
-	if jsonStr == "" {
-		preview := content
-		if len(preview) > 500 {
-			preview = preview[:500] + "..."
+	if jsonStr == "" || json.Unmarshal([]byte(fixTrailingCommas(jsonStr)), &parsed) != nil {
+		// Second pass: repair the raw response and parse item-by-item
+		repaired := repairJSON(content)
+		if repaired == "" {
+			preview := content
+			if len(preview) > 500 {
+				preview = preview[:500] + "..."
+			}
+			return nil, 0, fmt.Errorf("no JSON array found in response: %s", preview)
+		}
+		parsed, skipped = parseObjects(repaired)
+		if len(parsed) == 0 && skipped == 0 {
+			return nil, 0, fmt.Errorf("failed to parse triage results: no objects found")
 		}
-		return nil, fmt.Errorf("no JSON array found in response: %s", preview)
 	}
 
+	// Validate required fields, skipping objects that lack them
 	var results []Result
-	jsonStr = fixTrailingCommas(jsonStr)
-	if err := json.Unmarshal([]byte(jsonStr), &results); err != nil {
-		return nil, fmt.Errorf("failed to parse triage results: %w", err)
-	}
-
-	// Validate required fields
-	for i, result := range results {
-		if result.ID == "" {
-			return nil, fmt.Errorf("result %d: missing id", i)
+	var firstErr error
+	for i, result := range parsed {
+		var err error
+		switch {
+		case result.ID == "":
+			err = fmt.Errorf("result %d: missing id", i)
+		case result.Title == "":
+			err = fmt.Errorf("result %d: missing title", i)
+		case result.TriageDecision.Action == "":
+			err = fmt.Errorf("result %d: missing triage_decision.action", i)
 		}
-		if result.Title == "" {
-			return nil, fmt.Errorf("result %d: missing title", i)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			skipped++
+			continue
 		}
-		if result.TriageDecision.Action == "" {
-			return nil, fmt.Errorf("result %d: missing triage_decision.action", i)
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		if firstErr == nil {
+			firstErr = fmt.Errorf("all %d objects were malformed", skipped)
 		}
+		return nil, skipped, fmt.Errorf("failed to parse triage results: %w", firstErr)
 	}
 
-	return results, nil
+	return results, skipped, nil
 }
 
 // extractJSON finds the first valid JSON array in the content
@@ -106,6 +139,166 @@ func fixTrailingCommas(s string) string {
 	return re.ReplaceAllString(s, "$1")
 }
 
+// repairJSON applies a tolerant repair pass to a raw LLM response: it
+// normalizes curly quotes, drops markdown fences and leading/trailing prose,
+// escapes raw newlines inside strings, and removes trailing commas. A
+// truncated response is cut back to its last complete top-level object, so a
+// half-written one isn't mistaken for a result, and its brackets are closed.
+// Returns "" if no array is found.
+func repairJSON(content string) string {
+	replacer := strings.NewReplacer(
+		"\u201c", `"`, "\u201d", `"`,
+		"\u2018", "'", "\u2019", "'",
+	)
+	content = replacer.Replace(content)
+	content = strings.ReplaceAll(content, "```json", "")
+	content = strings.ReplaceAll(content, "```", "")
+
+	start := strings.Index(content, "[")
+	if start == -1 {
+		return ""
+	}
+
+	var buf strings.Builder
+	var stack []byte
+	inString := false
+	lastComplete := -1 // buf length after the last complete top-level object
+	for i := start; i < len(content); i++ {
+		ch := content[i]
+		if inString {
+			switch {
+			case ch == '\\' && i+1 < len(content):
+				buf.WriteByte(ch)
+				buf.WriteByte(content[i+1])
+				i++
+			case ch == '"':
+				inString = false
+				buf.WriteByte(ch)
+			case ch == '\n':
+				buf.WriteString("\\n")
+			case ch == '\t':
+				buf.WriteString("\\t")
+			case ch == '\r':
+				// drop bare carriage returns
+			default:
+				buf.WriteByte(ch)
+			}
+			continue
+		}
+
+		switch ch {
+		case '"':
+			inString = true
+		case '[':
+			stack = append(stack, ']')
+		case '{':
+			stack = append(stack, '}')
+		case ']', '}':
+			if len(stack) > 0 && stack[len(stack)-1] == ch {
+				stack = stack[:len(stack)-1]
+			}
+		}
+		buf.WriteByte(ch)
+		if ch == '}' && len(stack) == 1 {
+			lastComplete = buf.Len()
+		}
+		if len(stack) == 0 {
+			// Outermost array closed; ignore any trailing prose
+			break
+		}
+	}
+
+	if len(stack) == 0 {
+		return fixTrailingCommas(buf.String())
+	}
+
+	// Truncated: keep the complete objects and close the array
+	if lastComplete == -1 {
+		return "[]"
+	}
+	return fixTrailingCommas(buf.String()[:lastComplete] + "]")
+}
+
+// ParsePartialArray decodes the complete top-level objects of a JSON array
+// whose closing bracket may be missing, as in a truncated or still-streaming
+// LLM response. A trailing incomplete object is ignored. It returns the
+// decoded results and the number of complete objects found, which is larger
+// than len(results) when some of them are malformed.
+func ParsePartialArray(content string) ([]Result, int) {
+	start := strings.Index(content, "[")
+	if start == -1 {
+		return nil, 0
+	}
+
+	objects := splitTopLevelObjects(content[start:])
+	var results []Result
+	for _, obj := range objects {
+		var r Result
+		if json.Unmarshal([]byte(obj), &r) == nil {
+			results = append(results, r)
+		}
+	}
+	return results, len(objects)
+}
+
+// parseObjects parses a JSON array of results. If the array as a whole is
+// invalid, each top-level object is decoded separately and the ones that fail
+// are counted as skipped.
+func parseObjects(arr string) ([]Result, int) {
+	var results []Result
+	if json.Unmarshal([]byte(arr), &results) == nil {
+		return results, 0
+	}
+
+	results = nil
+	skipped := 0
+	for _, obj := range splitTopLevelObjects(arr) {
+		var r Result
+		if err := json.Unmarshal([]byte(obj), &r); err != nil {
+			skipped++
+			continue
+		}
+		results = append(results, r)
+	}
+	return results, skipped
+}
+
+// splitTopLevelObjects returns the raw text of each {...} object directly
+// inside the outermost array, respecting string literals.
+func splitTopLevelObjects(arr string) []string {
+	var objects []string
+	depth := 0
+	objStart := -1
+	inString := false
+	for i := 0; i < len(arr); i++ {
+		ch := arr[i]
+		if inString {
+			if ch == '\\' {
+				i++
+			} else if ch == '"' {
+				inString = false
+			}
+			continue
+		}
+		switch ch {
+		case '"':
+			inString = true
+		case '[', '{':
+			if ch == '{' && depth == 1 {
+				objStart = i
+			}
+			depth++
+		case ']', '}':
+			depth--
+			if ch == '}' && depth == 1 && objStart != -1 {
+				objects = append(objects, arr[objStart:i+1])
+				objStart = -1
+			}
+		}
+	}
+	return objects
+}
+
 // IsJSONArray checks if the string starts with [ and ends with ]
 func IsJSONArray(s string) bool {
 	s = strings.TrimSpace(s)
@@ -0,0 +1,61 @@
+package triage
+
+import "sort"
+
+// ResponseFormat asks OpenAI-compatible APIs for structured output.
+type ResponseFormat struct {
+	Type       string      `json:"type"` // "json_schema" or "json_object"
+	JSONSchema *JSONSchema `json:"json_schema,omitempty"`
+}
+
+// JSONSchema names a schema for the "json_schema" response format.
+type JSONSchema struct {
+	Name   string         `json:"name"`
+	Strict bool           `json:"strict"`
+	Schema map[string]any `json:"schema"`
+}
+
+// triageResponseFormat describes the auto-triage result shape. Structured
+// output requires an object at the top level, so the array is wrapped as
+// {"results": [...]}; the parser still finds the array inside it.
+func triageResponseFormat() *ResponseFormat {
+	str := map[string]any{"type": "string"}
+	object := func(props map[string]any) map[string]any {
+		required := make([]string, 0, len(props))
+		for name := range props {
+			required = append(required, name)
+		}
+		sort.Strings(required)
+		return map[string]any{
+			"type":                 "object",
+			"properties":           props,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	}
+
+	result := object(map[string]any{
+		"id":    str,
+		"title": str,
+		"url":   str,
+		"triage_decision": object(map[string]any{
+			"action":   map[string]any{"type": "string", "enum": []string{"delete", "archive", "later", "read_now", "needs_review"}},
+			"priority": map[string]any{"type": "string", "enum": []string{"high", "medium", "low"}},
+			"reason":   str,
+		}),
+		"metadata_enhancement": object(map[string]any{
+			"suggested_tags": map[string]any{"type": "array", "items": str},
+		}),
+	})
+
+	return &ResponseFormat{
+		Type: "json_schema",
+		JSONSchema: &JSONSchema{
+			Name:   "triage_results",
+			Strict: true,
+			Schema: object(map[string]any{
+				"results": map[string]any{"type": "array", "items": result},
+			}),
+		},
+	}
+}
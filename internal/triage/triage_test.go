@@ -1,6 +1,7 @@
 package triage
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -305,3 +306,148 @@ func TestExtractJSONUnmatchedBracket(t *testing.T) {
 		t.Errorf("expected empty string for unmatched bracket, got %q", result)
 	}
 }
+
+func TestParseTriageResponsePartial(t *testing.T) {
+	content := "Sure! Here are the results:\n```json\n[\n" +
+		`  {"id": "1", "title": "One", "triage_decision": {"action": "read_now", "priority": "high"}},` + "\n" +
+		`  {"id": "2", "title": "Two" "triage_decision": {"action": "later"}},` + "\n" +
+		`  {"id": "3", "title": "Three", "triage_decision": {"action": "archive", "priority": "low"}}` + "\n" +
+		"]\n```\nLet me know if you need anything else."
+
+	results, skipped, err := ParseTriageResponsePartial(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 recovered results, got %d", len(results))
+	}
+	if skipped != 1 {
+		t.Errorf("expected 1 skipped object, got %d", skipped)
+	}
+	if results[0].ID != "1" || results[1].ID != "3" {
+		t.Errorf("expected ids 1 and 3, got %q and %q", results[0].ID, results[1].ID)
+	}
+}
+
+func TestParseTriageResponseRepair(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		wantCount int
+	}{
+		{
+			name:      "smart quotes",
+			content:   "[{“id”: “1”, “title”: “Test”, “triage_decision”: {“action”: “later”}}]",
+			wantCount: 1,
+		},
+		{
+			name:      "unescaped newline in string",
+			content:   "[{\"id\": \"1\", \"title\": \"Test\", \"triage_decision\": {\"action\": \"later\", \"reason\": \"line one\nline two\"}}]",
+			wantCount: 1,
+		},
+		{
+			name:      "truncated response",
+			content:   `Results: [{"id": "1", "title": "Test", "triage_decision": {"action": "later"}}, {"id": "2", "title": "Cut`,
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ParseTriageResponse(tt.content)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(results) != tt.wantCount {
+				t.Errorf("expected %d results, got %d", tt.wantCount, len(results))
+			}
+		})
+	}
+}
+
+func TestParsePartialArray(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		wantIDs       []string
+		wantRecovered int
+	}{
+		{
+			name: "missing closing bracket",
+			content: `[{"id": "1", "title": "One", "triage_decision": {"action": "later"}},
+				{"id": "2", "title": "Two", "triage_decision": {"action": "archive"}}`,
+			wantIDs:       []string{"1", "2"},
+			wantRecovered: 2,
+		},
+		{
+			name: "trailing incomplete object",
+			content: "```json\n[{\"id\": \"1\", \"title\": \"One\", \"triage_decision\": {\"action\": \"later\"}},\n" +
+				`{"id": "2", "title": "Two {with} braces", "triage_decision": {"action": "archive"}},` + "\n" +
+				`{"id": "3", "title": "Three", "triage_decision": {"action": "read_now", "reason": "Cut off mid`,
+			wantIDs:       []string{"1", "2"},
+			wantRecovered: 2,
+		},
+		{
+			name:          "malformed complete object counted",
+			content:       `[{"id": "1", "title": "One", "triage_decision": {"action": "later"}}, {"id": 2 "title"}, {"id": "3"`,
+			wantIDs:       []string{"1"},
+			wantRecovered: 2,
+		},
+		{
+			name:          "no array",
+			content:       "I could not triage these items.",
+			wantRecovered: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, recovered := ParsePartialArray(tt.content)
+			if recovered != tt.wantRecovered {
+				t.Errorf("recovered = %d, want %d", recovered, tt.wantRecovered)
+			}
+			var ids []string
+			for _, r := range results {
+				ids = append(ids, r.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestParseTriageResponseDropsTruncatedObject(t *testing.T) {
+	// The cut-off object has every required field, but its reason is incomplete
+	content := `[{"id": "1", "title": "One", "triage_decision": {"action": "later"}},
+		{"id": "2", "title": "Two", "triage_decision": {"action": "read_now", "reason": "Worth reading bec`
+
+	results, skipped, err := ParseTriageResponsePartial(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || results[0].ID != "1" {
+		t.Errorf("expected only the complete object, got %+v", results)
+	}
+	if skipped != 0 {
+		t.Errorf("expected the truncated object ignored rather than skipped, got %d skipped", skipped)
+	}
+
+	// With nothing complete there is nothing to return
+	if _, _, err := ParseTriageResponsePartial(`[{"id": "1", "title": "On`); err == nil {
+		t.Error("expected an error when no object is complete")
+	}
+}
+
+func TestParseTriageResponseSkipsInvalidObjects(t *testing.T) {
+	content := `[
+		{"id": "1", "title": "Good", "triage_decision": {"action": "later"}},
+		{"title": "No ID", "triage_decision": {"action": "later"}}
+	]`
+	results, skipped, err := ParseTriageResponsePartial(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 || skipped != 1 {
+		t.Errorf("expected 1 result and 1 skipped, got %d and %d", len(results), skipped)
+	}
+}
@@ -0,0 +1,131 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// autoPushInterval spaces auto-pushed updates like BatchUpdate does. An item
+// changed again while it waits is still pushed once, with its latest state.
+var autoPushInterval = 1500 * time.Millisecond
+
+// autoPushTickMsg fires when the next queued auto-push may be sent.
+type autoPushTickMsg struct{}
+
+// AutoPushedMsg reports the result of pushing one item's decision.
+type AutoPushedMsg struct {
+	ID  string
+	Err error
+}
+
+// autoPushEnabled reports whether decisions are pushed as they are made.
+// Demo mode never touches Readwise.
+func (m *Model) autoPushEnabled() bool {
+	return m.cfg != nil && m.cfg.AutoPush && !m.demo
+}
+
+// enqueueAutoPush queues an item for auto-push. Deletes are left for a
+// regular push, and an item already waiting keeps its place.
+func (m *Model) enqueueAutoPush(item *Item) {
+	if !m.autoPushEnabled() || item.Action == "" || item.Action == "delete" {
+		return
+	}
+	if !slices.Contains(m.autoPushQueue, item.ID) {
+		m.autoPushQueue = append(m.autoPushQueue, item.ID)
+	}
+}
+
+// startAutoPush schedules the next queued push unless one is already
+// scheduled or in flight.
+func (m *Model) startAutoPush() tea.Cmd {
+	if m.autoPushWaiting || m.autoPushSending != "" || len(m.autoPushQueue) == 0 {
+		return nil
+	}
+	m.autoPushWaiting = true
+	return tea.Tick(autoPushInterval, func(time.Time) tea.Msg { return autoPushTickMsg{} })
+}
+
+// sendAutoPush pushes the first queued item as it is now. Items that were
+// cleared, snoozed, deleted or unloaded in the meantime are skipped.
+func (m *Model) sendAutoPush() tea.Cmd {
+	m.autoPushWaiting = false
+	for len(m.autoPushQueue) > 0 {
+		id := m.autoPushQueue[0]
+		m.autoPushQueue = m.autoPushQueue[1:]
+
+		var updates []readwise.UpdateRequest
+		for _, item := range m.items {
+			if item.ID == id && item.Action != "delete" {
+				updates = m.updateRequestsFor(item)
+				break
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+			m.autoPushErr = "READWISE_TOKEN not configured"
+			return nil
+		}
+
+		m.autoPushSending = id
+		token := m.cfg.ReadwiseToken
+		return func() tea.Msg {
+			client, err := newReadwiseClient(token)
+			if err != nil {
+				return AutoPushedMsg{ID: id, Err: err}
+			}
+			for _, update := range updates {
+				if err := client.UpdateDocument(update); err != nil {
+					return AutoPushedMsg{ID: id, Err: err}
+				}
+			}
+			return AutoPushedMsg{ID: id}
+		}
+	}
+	return nil
+}
+
+// handleAutoPushed records a finished auto-push and moves on to the next.
+// An item changed again while its push was in flight stays queued and
+// unpushed. An item already deleted in Readwise is dropped from the list.
+func (m *Model) handleAutoPushed(msg AutoPushedMsg) tea.Cmd {
+	m.autoPushSending = ""
+	if errors.Is(msg.Err, readwise.ErrDocumentGone) {
+		m.autoPushErr = ""
+		m.autoPushQueue = slices.DeleteFunc(m.autoPushQueue, func(id string) bool { return id == msg.ID })
+		m.removeGoneItems([]string{msg.ID})
+		m.statusMessage = fmt.Sprintf("%s was already deleted in Readwise, removed from the list", msg.ID)
+	} else if msg.Err != nil {
+		m.autoPushErr = fmt.Sprintf("auto-push of %s failed: %v", msg.ID, msg.Err)
+	} else {
+		m.autoPushErr = ""
+		if !slices.Contains(m.autoPushQueue, msg.ID) {
+			m.clearUnpushed(msg.ID)
+		}
+	}
+	return m.startAutoPush()
+}
+
+// autoPushStatus is the header note for pending or failed auto-pushes.
+func (m *Model) autoPushStatus() string {
+	if !m.autoPushEnabled() {
+		return ""
+	}
+	if m.autoPushErr != "" {
+		return m.styles.Error.Render("⇡ "+Truncate(m.autoPushErr, 40)) + "  "
+	}
+	pending := len(m.autoPushQueue)
+	if m.autoPushSending != "" {
+		pending++
+	}
+	if pending == 0 {
+		return ""
+	}
+	return m.styles.HelpDesc.Render(fmt.Sprintf("⇡ pushing %d", pending)) + "  "
+}
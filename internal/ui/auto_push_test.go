@@ -0,0 +1,215 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// autoPushServer records the update requests it receives.
+type autoPushServer struct {
+	mu       sync.Mutex
+	paths    []string
+	payloads []map[string]any
+}
+
+func newAutoPushTestModel(t *testing.T) (*Model, *autoPushServer) {
+	t.Helper()
+	rec := &autoPushServer{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		json.NewDecoder(r.Body).Decode(&payload)
+		rec.mu.Lock()
+		rec.paths = append(rec.paths, r.URL.Path)
+		rec.payloads = append(rec.payloads, payload)
+		rec.mu.Unlock()
+		w.Write([]byte("{}"))
+	}))
+	t.Cleanup(srv.Close)
+
+	origClient, origInterval := newReadwiseClient, autoPushInterval
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	autoPushInterval = 0
+	t.Cleanup(func() { newReadwiseClient, autoPushInterval = origClient, origInterval })
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "token", AutoPush: true}
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One"}, {ID: "2", Title: "Two"}}})
+	return m, rec
+}
+
+// runAutoPush feeds the tick and push results back to the model until the
+// queue is idle.
+func runAutoPush(m *Model, cmd tea.Cmd) {
+	for cmd != nil {
+		_, cmd = m.Update(cmd())
+	}
+}
+
+func TestAutoPushCoalescesChanges(t *testing.T) {
+	m, rec := newAutoPushTestModel(t)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if cmd == nil {
+		t.Fatal("expected a decision to schedule an auto-push")
+	}
+	// Further changes before the push goes out reuse the queued entry
+	m.setItemAction(&m.items[0], "later")
+	m.setItemAction(&m.items[0], "read_now")
+	if len(m.autoPushQueue) != 1 || m.autoPushQueue[0] != "1" {
+		t.Fatalf("expected item 1 queued once, got %v", m.autoPushQueue)
+	}
+	if !strings.Contains(m.View(), "⇡ pushing 1") {
+		t.Error("expected the header to show the pending auto-push")
+	}
+
+	runAutoPush(m, cmd)
+	if len(rec.paths) != 1 || rec.paths[0] != "/update/1/" {
+		t.Fatalf("expected exactly one update for item 1, got %v", rec.paths)
+	}
+	if _, ok := rec.payloads[0]["location"]; ok {
+		t.Errorf("expected the latest decision (read_now, no move), got %v", rec.payloads[0])
+	}
+	if m.unpushedCount() != 0 {
+		t.Errorf("expected the decision marked pushed, got %d unpushed", m.unpushedCount())
+	}
+	if strings.Contains(m.View(), "⇡") {
+		t.Error("expected the header note gone once the queue is empty")
+	}
+}
+
+func TestAutoPushArchiveUntriaged(t *testing.T) {
+	m, rec := newAutoPushTestModel(t)
+	m.state = StateReviewing
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("expected archiving untriaged items to schedule an auto-push")
+	}
+	runAutoPush(m, cmd)
+	if got := strings.Join(rec.paths, " "); got != "/update/1/ /update/2/" {
+		t.Fatalf("expected both archives pushed, got %v", rec.paths)
+	}
+	for i, payload := range rec.payloads {
+		if payload["location"] != "archive" {
+			t.Errorf("update %d = %v, want location archive", i, payload)
+		}
+	}
+	if m.unpushedCount() != 0 {
+		t.Errorf("expected the archives marked pushed, got %d unpushed", m.unpushedCount())
+	}
+}
+
+func TestAutoPushPriority(t *testing.T) {
+	m, rec := newAutoPushTestModel(t)
+	m.state = StateReviewing
+
+	// A priority alone has nothing to push until the item is decided
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")}); cmd != nil || len(m.autoPushQueue) != 0 {
+		t.Fatalf("expected no push for an undecided item, got queue %v", m.autoPushQueue)
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	runAutoPush(m, cmd)
+
+	for _, key := range []string{"1", "-"} {
+		_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		if cmd == nil {
+			t.Fatalf("expected %s on a decided item to schedule an auto-push", key)
+		}
+		runAutoPush(m, cmd)
+	}
+	if len(rec.payloads) != 3 {
+		t.Fatalf("expected three updates, got %v", rec.paths)
+	}
+	for i, want := range []string{"priority:medium", "priority:high", "priority:medium"} {
+		if tags := fmt.Sprint(rec.payloads[i]["tags"]); !strings.Contains(tags, want) {
+			t.Errorf("update %d tags = %s, want %s", i, tags, want)
+		}
+	}
+}
+
+func TestAutoPushChangeDuringRequest(t *testing.T) {
+	m, rec := newAutoPushTestModel(t)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	_, cmd = m.Update(cmd()) // tick: the request for archive is now in flight
+	m.setItemAction(&m.items[0], "later")
+	_, next := m.Update(cmd())
+	if m.unpushedCount() != 1 {
+		t.Error("expected the newer decision to stay unpushed")
+	}
+	runAutoPush(m, next)
+
+	if len(rec.paths) != 2 {
+		t.Fatalf("expected a second update for the newer decision, got %v", rec.paths)
+	}
+	if rec.payloads[0]["location"] != "archive" || rec.payloads[1]["location"] != "later" {
+		t.Errorf("expected archive then later, got %v", rec.payloads)
+	}
+	if m.unpushedCount() != 0 {
+		t.Errorf("expected everything pushed, got %d unpushed", m.unpushedCount())
+	}
+}
+
+func TestAutoPushSkips(t *testing.T) {
+	m, _ := newAutoPushTestModel(t)
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}); cmd != nil || len(m.autoPushQueue) != 0 {
+		t.Errorf("expected deletes left for a regular push, got queue %v", m.autoPushQueue)
+	}
+
+	m.cfg.AutoPush = false
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}); cmd != nil || len(m.autoPushQueue) != 0 {
+		t.Errorf("expected nothing queued with auto_push off, got %v", m.autoPushQueue)
+	}
+}
+
+func TestAutoPushFailure(t *testing.T) {
+	m, _ := newAutoPushTestModel(t)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m.autoPushWaiting = false
+	m.autoPushQueue = nil
+	m.autoPushSending = "1"
+
+	m.Update(AutoPushedMsg{ID: "1", Err: fmt.Errorf("update failed with status 500")})
+	if !strings.Contains(m.View(), "auto-push of 1 failed") {
+		t.Error("expected the failure in the header")
+	}
+	if m.unpushedCount() != 1 {
+		t.Error("expected a failed auto-push to stay unpushed")
+	}
+}
+
+func TestAutoPushDocumentGone(t *testing.T) {
+	m, _ := newAutoPushTestModel(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	runAutoPush(m, cmd)
+
+	if m.autoPushErr != "" {
+		t.Errorf("expected a deleted document not to count as an auto-push error, got %q", m.autoPushErr)
+	}
+	if len(m.items) != 1 || m.items[0].ID != "2" {
+		t.Errorf("expected item 1 removed from the list, got %+v", m.items)
+	}
+	if m.unpushedCount() != 0 {
+		t.Errorf("unpushedCount() = %d, want 0", m.unpushedCount())
+	}
+}
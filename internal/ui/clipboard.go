@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/atotto/clipboard"
+)
+
+// osc52Output receives OSC52 clipboard sequences. Stderr reaches the same
+// terminal as the UI without going through Bubble Tea's renderer.
+var osc52Output io.Writer = os.Stderr
+
+// osc52Sequence encodes s as an OSC52 "set clipboard" escape sequence. Inside
+// tmux the sequence is wrapped in a passthrough so it reaches the outer
+// terminal.
+func osc52Sequence(s string) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(s)) + "\a"
+	if os.Getenv("TMUX") != "" {
+		seq = "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	return seq
+}
+
+// writeClipboardOSC52 asks the terminal to set the clipboard to s. This works
+// over SSH, but the terminal gives no answer, so success can't be confirmed.
+func writeClipboardOSC52(s string) error {
+	if _, err := io.WriteString(osc52Output, osc52Sequence(s)); err != nil {
+		return fmt.Errorf("write OSC52 sequence: %w", err)
+	}
+	return nil
+}
+
+// remoteSession reports whether the program seems to run without a local
+// clipboard: over SSH, or on Linux without a display.
+func remoteSession() bool {
+	if os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != "" {
+		return true
+	}
+	return runtime.GOOS == "linux" && os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == ""
+}
+
+// writeClipboard copies s with the native clipboard, falling back to OSC52
+// when that fails in a remote session or with clipboard_osc52 set.
+func (m *Model) writeClipboard(s string) error {
+	err := clipboard.WriteAll(s)
+	if err == nil {
+		return nil
+	}
+	if (m.cfg != nil && m.cfg.ClipboardOSC52) || remoteSession() {
+		return writeClipboardOSC52(s)
+	}
+	return err
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+func TestOSC52Sequence(t *testing.T) {
+	t.Setenv("TMUX", "")
+	payload := `[{"id":"1","title":"Ünïcode ✓"}]`
+	want := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(payload)) + "\a"
+	if got := osc52Sequence(payload); got != want {
+		t.Errorf("osc52Sequence = %q, want %q", got, want)
+	}
+	if got := osc52Sequence(""); got != "\x1b]52;c;\a" {
+		t.Errorf("expected an empty payload to clear the clipboard, got %q", got)
+	}
+
+	// tmux needs a passthrough with the inner escapes doubled
+	t.Setenv("TMUX", "/tmp/tmux-1000/default,1,0")
+	want = "\x1bPtmux;\x1b\x1b]52;c;aGk=\a\x1b\\"
+	if got := osc52Sequence("hi"); got != want {
+		t.Errorf("osc52Sequence in tmux = %q, want %q", got, want)
+	}
+}
+
+func TestWriteClipboardOSC52(t *testing.T) {
+	t.Setenv("TMUX", "")
+	var buf bytes.Buffer
+	origOutput := osc52Output
+	osc52Output = &buf
+	defer func() { osc52Output = origOutput }()
+
+	if err := writeClipboardOSC52("hello"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := buf.String(); got != "\x1b]52;c;aGVsbG8=\a" {
+		t.Errorf("unexpected output %q", got)
+	}
+}
+
+func TestRemoteSession(t *testing.T) {
+	t.Setenv("DISPLAY", ":0")
+	t.Setenv("WAYLAND_DISPLAY", "")
+	t.Setenv("SSH_CONNECTION", "")
+	t.Setenv("SSH_TTY", "/dev/pts/1")
+	if !remoteSession() {
+		t.Error("expected SSH_TTY to count as a remote session")
+	}
+	t.Setenv("SSH_TTY", "")
+	if remoteSession() {
+		t.Error("expected a local session with a display")
+	}
+}
@@ -0,0 +1,119 @@
+package ui
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// trackingParams are query parameters stripped before comparing URLs.
+var trackingParams = map[string]bool{
+	"fbclid":  true,
+	"gclid":   true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"ref":     true,
+	"ref_src": true,
+}
+
+// normalizeURL reduces a URL to a comparison key: lowercase host without
+// "www.", no fragment, no tracking params, and no trailing slash.
+// Returns "" for URLs that can't be parsed or have no host.
+func normalizeURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+
+	query := u.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") || trackingParams[strings.ToLower(key)] {
+			query.Del(key)
+		}
+	}
+
+	key := host + strings.TrimRight(u.EscapedPath(), "/")
+	if encoded := query.Encode(); encoded != "" { // Encode sorts by key
+		key += "?" + encoded
+	}
+	return key
+}
+
+// dedupeItems collapses items that share a normalized URL. The survivor is the
+// earliest-created item in each group; it takes the group's first position,
+// merges the others' Readwise tags, and records their IDs in DuplicateIDs so
+// updates apply to every copy. Items without a usable URL are kept as-is.
+func dedupeItems(items []Item) []Item {
+	groups := make(map[string]int) // normalized URL → index in result
+	result := make([]Item, 0, len(items))
+
+	for _, item := range items {
+		key := normalizeURL(item.URL)
+		idx, seen := groups[key]
+		if key == "" || !seen {
+			if key != "" {
+				groups[key] = len(result)
+			}
+			result = append(result, item)
+			continue
+		}
+
+		survivor, dup := result[idx], item
+		if dup.CreatedAt.Before(survivor.CreatedAt) {
+			survivor, dup = dup, survivor
+		}
+		survivor.OriginalTags = mergeTags(survivor.OriginalTags, dup.OriginalTags)
+		survivor.DuplicateIDs = append(append(append([]string(nil), survivor.DuplicateIDs...), dup.ID), dup.DuplicateIDs...)
+		dup.DuplicateIDs = nil
+		result[idx] = survivor
+	}
+
+	return result
+}
+
+// mergeTags returns a followed by any tags in b not already present.
+func mergeTags(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	merged := make([]string, 0, len(a)+len(b))
+	for _, tag := range append(append([]string(nil), a...), b...) {
+		if !seen[tag] {
+			seen[tag] = true
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// dedupeUpdates merges updates that target the same document, so a document
+// reached twice (say, as a duplicate of one item and the survivor of another)
+// is only PATCHed once. The first update keeps its position; tags from the
+// later ones are merged in, and their other fields only fill in what the
+// first left empty.
+func dedupeUpdates(updates []readwise.UpdateRequest) []readwise.UpdateRequest {
+	seen := make(map[string]int, len(updates)) // document ID → index in result
+	result := make([]readwise.UpdateRequest, 0, len(updates))
+	for _, update := range updates {
+		idx, ok := seen[update.DocumentID]
+		if !ok {
+			seen[update.DocumentID] = len(result)
+			result = append(result, update)
+			continue
+		}
+
+		merged := &result[idx]
+		merged.Tags = mergeTags(merged.Tags, update.Tags)
+		if merged.Location == "" {
+			merged.Location = update.Location
+		}
+		if merged.Notes == "" {
+			merged.Notes = update.Notes
+		}
+		if merged.ReadingProgress == nil {
+			merged.ReadingProgress = update.ReadingProgress
+		}
+	}
+	return result
+}
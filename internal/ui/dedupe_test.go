@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		same bool
+	}{
+		{"identical", "https://example.com/post", "https://example.com/post", true},
+		{"trailing slash", "https://example.com/post/", "https://example.com/post", true},
+		{"utm params", "https://example.com/post?utm_source=rss&utm_medium=feed", "https://example.com/post", true},
+		{"fbclid and fragment", "https://www.example.com/post?fbclid=abc#comments", "https://example.com/post", true},
+		{"host case", "https://Example.COM/post", "https://example.com/post", true},
+		{"query order", "https://example.com/p?b=2&a=1", "https://example.com/p?a=1&b=2", true},
+		{"meaningful query", "https://example.com/p?id=1", "https://example.com/p?id=2", false},
+		{"different path", "https://example.com/a", "https://example.com/b", false},
+		{"path case", "https://example.com/Post", "https://example.com/post", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := normalizeURL(tt.a), normalizeURL(tt.b)
+			if (a == b) != tt.same {
+				t.Errorf("normalizeURL(%q) = %q, normalizeURL(%q) = %q, want same=%v", tt.a, a, tt.b, b, tt.same)
+			}
+		})
+	}
+
+	if got := normalizeURL(""); got != "" {
+		t.Errorf("expected empty key for empty URL, got %q", got)
+	}
+}
+
+func TestDedupeItems(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		items    []Item
+		wantIDs  []string
+		wantDups map[string][]string
+		wantTags map[string][]string
+	}{
+		{
+			name: "exact duplicate keeps earliest",
+			items: []Item{
+				{ID: "late", URL: "https://example.com/a", CreatedAt: day(3), OriginalTags: []string{"rss"}},
+				{ID: "early", URL: "https://example.com/a", CreatedAt: day(1), OriginalTags: []string{"manual", "rss"}},
+			},
+			wantIDs:  []string{"early"},
+			wantDups: map[string][]string{"early": {"late"}},
+			wantTags: map[string][]string{"early": {"manual", "rss"}},
+		},
+		{
+			name: "tracking param variants",
+			items: []Item{
+				{ID: "1", URL: "https://example.com/a?utm_source=rss", CreatedAt: day(1)},
+				{ID: "2", URL: "https://example.com/other", CreatedAt: day(1)},
+				{ID: "3", URL: "https://www.example.com/a/", CreatedAt: day(2)},
+				{ID: "4", URL: "https://example.com/a#top", CreatedAt: day(3)},
+			},
+			wantIDs:  []string{"1", "2"},
+			wantDups: map[string][]string{"1": {"3", "4"}},
+		},
+		{
+			name: "distinct urls untouched",
+			items: []Item{
+				{ID: "1", URL: "https://example.com/a"},
+				{ID: "2", URL: "https://example.com/b"},
+				{ID: "3", URL: ""},
+				{ID: "4", URL: ""},
+			},
+			wantIDs: []string{"1", "2", "3", "4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dedupeItems(tt.items)
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("expected %d items, got %d", len(tt.wantIDs), len(got))
+			}
+			for i, item := range got {
+				if item.ID != tt.wantIDs[i] {
+					t.Errorf("item %d: expected ID %q, got %q", i, tt.wantIDs[i], item.ID)
+				}
+				if want := tt.wantDups[item.ID]; len(want) != len(item.DuplicateIDs) || (len(want) > 0 && !equalStrings(want, item.DuplicateIDs)) {
+					t.Errorf("item %q: expected duplicates %v, got %v", item.ID, want, item.DuplicateIDs)
+				}
+				if want, ok := tt.wantTags[item.ID]; ok && !equalStrings(want, item.OriginalTags) {
+					t.Errorf("item %q: expected tags %v, got %v", item.ID, want, item.OriginalTags)
+				}
+			}
+		})
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDedupeUpdates(t *testing.T) {
+	zero := 0.0
+	tests := []struct {
+		name    string
+		updates []readwise.UpdateRequest
+		want    []readwise.UpdateRequest
+	}{
+		{
+			name: "exact duplicates",
+			updates: []readwise.UpdateRequest{
+				{DocumentID: "a", Location: "archive", Tags: []string{"go"}},
+				{DocumentID: "b", Location: "later"},
+				{DocumentID: "a", Location: "archive", Tags: []string{"go"}},
+			},
+			want: []readwise.UpdateRequest{
+				{DocumentID: "a", Location: "archive", Tags: []string{"go"}},
+				{DocumentID: "b", Location: "later"},
+			},
+		},
+		{
+			name: "differing duplicates merge tags",
+			updates: []readwise.UpdateRequest{
+				{DocumentID: "a", Location: "later", Tags: []string{"rss", "priority:high"}},
+				{DocumentID: "a", Location: "archive", Tags: []string{"manual", "rss"}, Notes: "note", ReadingProgress: &zero},
+			},
+			want: []readwise.UpdateRequest{
+				{DocumentID: "a", Location: "later", Tags: []string{"rss", "priority:high", "manual"}, Notes: "note", ReadingProgress: &zero},
+			},
+		},
+		{
+			name:    "no duplicates",
+			updates: []readwise.UpdateRequest{{DocumentID: "a"}, {DocumentID: "b"}},
+			want:    []readwise.UpdateRequest{{DocumentID: "a"}, {DocumentID: "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dedupeUpdates(tt.updates)
+			if len(got) != len(tt.want) {
+				t.Fatalf("dedupeUpdates() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				g, w := got[i], tt.want[i]
+				if g.DocumentID != w.DocumentID || g.Location != w.Location || g.Notes != w.Notes || g.ReadingProgress != w.ReadingProgress || !equalStrings(g.Tags, w.Tags) {
+					t.Errorf("update %d = %+v, want %+v", i, g, w)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildUpdatesDedupes(t *testing.T) {
+	m := newTestModel()
+	// A duplicate ID that is also loaded as its own item
+	m.items = []Item{
+		{ID: "a", Action: "archive", DuplicateIDs: []string{"b"}, OriginalTags: []string{"rss"}},
+		{ID: "b", Action: "archive", OriginalTags: []string{"manual"}},
+	}
+	m.listView.SetItems(m.items)
+
+	updates := m.buildUpdates()
+	if len(updates) != 2 {
+		t.Fatalf("expected one update per document, got %+v", updates)
+	}
+	if updates[1].DocumentID != "b" || !equalStrings(updates[1].Tags, []string{"rss", "manual"}) {
+		t.Errorf("expected b's tags merged, got %+v", updates[1])
+	}
+}
@@ -0,0 +1,134 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// demoUpdateDelay paces fake updates so the progress bar is visible.
+const demoUpdateDelay = 150 * time.Millisecond
+
+// NewDemoModel returns a model seeded with sample items for exploring the UI
+// without a Readwise account. It uses an in-memory triage store, never saves
+// config, and fetches/updates succeed without any network calls.
+func NewDemoModel() *Model {
+	cfg := &config.Config{
+		ReadwiseToken: "demo",
+		InboxDaysAgo:  7,
+		FeedDaysAgo:   7,
+	}
+	m := newModel(cfg, config.NewMemTriageStore())
+	m.demo = true
+	m.Update(ItemsLoadedMsg{Items: demoItems()})
+	m.statusMessage = fmt.Sprintf("Demo mode: %d sample items, nothing is sent to Readwise", len(m.items))
+	return m
+}
+
+// demoBatchUpdate stands in for readwise.Client.BatchUpdate in demo mode,
+// reporting every update as successful.
+func demoBatchUpdate(updates []readwise.UpdateRequest, progressChan chan<- readwise.BatchUpdateProgress) (*readwise.BatchUpdateResult, error) {
+	for i, update := range updates {
+		time.Sleep(demoUpdateDelay)
+		progressChan <- readwise.BatchUpdateProgress{
+			Current: i + 1,
+			Total:   len(updates),
+			ItemID:  update.DocumentID,
+			Success: true,
+		}
+	}
+	return &readwise.BatchUpdateResult{Total: len(updates), Success: len(updates)}, nil
+}
+
+// demoItems returns the fixed sample inbox used in demo mode.
+func demoItems() []Item {
+	return []Item{
+		{
+			ID:          "demo-1",
+			Title:       "A Gentle Introduction to Bubble Tea",
+			URL:         "https://example.com/bubbletea-intro",
+			Summary:     "Build terminal apps in Go with the Elm architecture.",
+			Category:    "article",
+			Source:      "rss",
+			SiteName:    "example.com",
+			WordCount:   1800,
+			ReadingTime: "8 min",
+		},
+		{
+			ID:          "demo-2",
+			Title:       "The Case for Slow Reading",
+			URL:         "https://example.org/slow-reading",
+			Summary:     "Why reading fewer things more carefully beats skimming everything.",
+			Category:    "article",
+			SiteName:    "example.org",
+			WordCount:   3200,
+			ReadingTime: "14 min",
+			Progress:    0.4,
+		},
+		{
+			ID:          "demo-3",
+			Title:       "Weekly Tech Digest #142",
+			URL:         "https://newsletter.example.com/142",
+			Summary:     "Links and commentary from the past week.",
+			Category:    "email",
+			Source:      "email",
+			SiteName:    "newsletter.example.com",
+			WordCount:   950,
+			ReadingTime: "4 min",
+		},
+		{
+			ID:           "demo-4",
+			Title:        "Designing Data-Intensive Applications: Chapter Notes",
+			URL:          "https://example.net/ddia-notes",
+			Summary:      "Notes on replication, partitioning, and consistency models.",
+			Category:     "article",
+			SiteName:     "example.net",
+			WordCount:    5400,
+			ReadingTime:  "24 min",
+			OriginalTags: []string{"databases"},
+		},
+		{
+			ID:          "demo-5",
+			Title:       "Ten Keyboard Shortcuts You Should Know",
+			URL:         "https://example.com/shortcuts",
+			Summary:     "Small habits that add up to big time savings.",
+			Category:    "article",
+			SiteName:    "example.com",
+			WordCount:   700,
+			ReadingTime: "3 min",
+			Progress:    0.95,
+		},
+		{
+			ID:          "demo-6",
+			Title:       "Understanding Go Generics in Practice",
+			URL:         "https://example.dev/go-generics",
+			Summary:     "Where type parameters help, and where they just add noise.",
+			Category:    "article",
+			SiteName:    "example.dev",
+			WordCount:   2600,
+			ReadingTime: "11 min",
+		},
+		{
+			ID:          "demo-7",
+			Title:       "Podcast: The Future of Personal Knowledge Management",
+			URL:         "https://podcasts.example.com/pkm",
+			Summary:     "A conversation about note-taking tools and workflows.",
+			Category:    "video",
+			SiteName:    "podcasts.example.com",
+			ReadingTime: "52 min",
+		},
+		{
+			ID:          "demo-8",
+			Title:       "Sponsored: Upgrade Your Productivity Stack Today",
+			URL:         "https://ads.example.com/upgrade",
+			Summary:     "Limited-time offer on a productivity bundle.",
+			Category:    "email",
+			Source:      "email",
+			SiteName:    "ads.example.com",
+			WordCount:   300,
+			ReadingTime: "1 min",
+		},
+	}
+}
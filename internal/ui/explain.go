@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// startExplain opens the overlay showing why the focused item got its
+// decision.
+func (m *Model) startExplain() {
+	item := m.listView.CurrentItem()
+	if item == nil {
+		return
+	}
+	m.explainID = item.ID
+	m.state = StateExplain
+}
+
+// explainReport returns the stored LLM report for an item and the source of
+// its saved decision, "" if it was never triaged.
+func (m *Model) explainReport(id string) (*triage.Result, string) {
+	if m.triageStore == nil {
+		return nil, ""
+	}
+	entry, ok := m.triageStore.GetItem(id)
+	if !ok {
+		return nil, ""
+	}
+	return entry.Report, entry.Source
+}
+
+// noReportMessage explains why an item has no LLM reasoning to show.
+func noReportMessage(source string) string {
+	switch source {
+	case "":
+		return "Not triaged yet, so there is no LLM report."
+	case "manual":
+		return "Manually triaged, no LLM report stored."
+	default:
+		return "Triaged by " + source + ", no LLM report stored."
+	}
+}
+
+func (m *Model) explainView() string {
+	title, reason := m.explainID, ""
+	for _, item := range m.items {
+		if item.ID == m.explainID {
+			title, reason = item.Title, item.Reason
+			break
+		}
+	}
+	width := 70
+	if m.width > 0 {
+		width = max(min(m.width-8, 90), 30)
+	}
+	text := lipgloss.NewStyle().Width(width)
+
+	lines := []string{
+		m.styles.Title.Render("Why this decision"),
+		m.styles.Highlight.Render(Truncate(title, width)),
+		"",
+	}
+	report, source := m.explainReport(m.explainID)
+	if report == nil {
+		lines = append(lines, m.styles.Help.Render(noReportMessage(source)))
+	} else {
+		field := func(label, value string) {
+			if value == "" {
+				value = "—"
+			}
+			lines = append(lines, text.Render(m.styles.HelpKey.Render(label+": ")+m.styles.Normal.Render(value)))
+		}
+		decision := getActionText(report.TriageDecision.Action)
+		if p := report.TriageDecision.Priority; p != "" {
+			decision += " · " + getPriorityText(p)
+		}
+		field("Decision", decision)
+		field("Reason", report.TriageDecision.Reason)
+		field("Type", report.ContentAnalysis.Type)
+		field("Why valuable", report.ReadingGuide.WhyValuable)
+	}
+	if reason != "" {
+		lines = append(lines, "", text.Render(m.styles.HelpKey.Render("Your reason: ")+m.styles.Normal.Render(reason)))
+	}
+
+	content := m.styles.Border.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	help := m.renderHelpLine([]helpEntry{{"any key", "close"}})
+	return lipgloss.JoinVertical(lipgloss.Center, "", content, "", help)
+}
@@ -0,0 +1,57 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+func TestExplainShowsStoredReport(t *testing.T) {
+	m := newTestModel()
+	report := &triage.Result{
+		ID:              "1",
+		TriageDecision:  triage.TriageDecision{Action: "read_now", Priority: "high", Reason: "First-hand benchmark data"},
+		ContentAnalysis: triage.ContentAnalysis{Type: "research"},
+		ReadingGuide:    triage.ReadingGuide{WhyValuable: "Changes how you size caches"},
+	}
+	m.triageStore.SetItem("1", "read_now", "high", "llm", nil, report)
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Cache sizing"}}})
+
+	typeKeys(m, "w")
+	if m.state != StateExplain {
+		t.Fatalf("expected w to open the explanation, got %v", m.state)
+	}
+	view := m.View()
+	for _, want := range []string{"Why this decision", "Cache sizing", "First-hand benchmark data", "research", "Changes how you size caches"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected the explanation to contain %q, got:\n%s", want, view)
+		}
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.state != StateReviewing {
+		t.Errorf("expected any key to close the explanation, got %v", m.state)
+	}
+}
+
+func TestExplainWithoutReport(t *testing.T) {
+	m := newTestModel()
+	m.triageStore.SetItem("1", "archive", "", "manual", nil, nil)
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Manual"}, {ID: "2", Title: "Untouched"}}})
+
+	typeKeys(m, "w")
+	if view := m.View(); !strings.Contains(view, "Manually triaged, no LLM report stored.") {
+		t.Errorf("expected the manual message, got:\n%s", view)
+	}
+
+	typeKeys(m, "q")
+	if m.state != StateReviewing {
+		t.Fatalf("expected q to close the explanation, not quit, got %v", m.state)
+	}
+	typeKeys(m, "jw")
+	if view := m.View(); !strings.Contains(view, "Not triaged yet") {
+		t.Errorf("expected the untriaged message, got:\n%s", view)
+	}
+}
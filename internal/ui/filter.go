@@ -0,0 +1,31 @@
+package ui
+
+import "strings"
+
+// matchesFilter reports whether an item matches a filter query. The query is a
+// space-separated list of terms that must all match: "source:<text>" matches
+// the item's source/site name, "author:<text>" its author, and bare terms
+// match the title. Matching is case-insensitive substring matching.
+func matchesFilter(item Item, query string) bool {
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		field, value, ok := strings.Cut(term, ":")
+		if !ok || value == "" {
+			field, value = "", term
+		}
+
+		var target string
+		switch field {
+		case "source":
+			target = item.SourceName()
+		case "author":
+			target = item.Author
+		default:
+			value = term
+			target = item.Title
+		}
+		if !strings.Contains(strings.ToLower(target), value) {
+			return false
+		}
+	}
+	return true
+}
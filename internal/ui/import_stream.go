@@ -0,0 +1,207 @@
+package ui
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// importChunkSize is the number of results applied per ImportProgressMsg, so
+// the UI redraws between chunks of a large import.
+const importChunkSize = 200
+
+// ImportProgressMsg carries the next chunk of results read from an import
+// file. Done marks the last chunk.
+type ImportProgressMsg struct {
+	Results  []triage.Result
+	Progress float64 // fraction of the file read so far
+	Done     bool
+	Err      error
+	Channel  chan ImportProgressMsg
+}
+
+// importRun accumulates the summary of a chunked import.
+type importRun struct {
+	total     int
+	applied   int
+	errors    []string
+	matches   []string
+	unmatched []triage.Result
+}
+
+// ImportTriageResultsFromFile imports triage results from a file without
+// blocking the UI: the JSON array is stream-parsed in the background and
+// applied a chunk at a time as ImportProgressMsgs arrive, ending with the
+// same summary as a clipboard import.
+func (m *Model) ImportTriageResultsFromFile(filePath string) (tea.Cmd, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	var size int64
+	if info, err := f.Stat(); err == nil {
+		size = info.Size()
+	}
+
+	m.importRun = &importRun{}
+	m.state = StateImporting
+	m.updateProgress = 0
+	m.statusMessage = "Reading results..."
+
+	ch := make(chan ImportProgressMsg)
+	go func() {
+		defer f.Close()
+		streamImportResults(f, size, importChunkSize, ch)
+		close(ch)
+	}()
+	return waitForImportProgress(ch), nil
+}
+
+// waitForImportProgress returns the next chunk from a running import.
+func waitForImportProgress(ch chan ImportProgressMsg) tea.Cmd {
+	return func() tea.Msg {
+		msg, ok := <-ch
+		if !ok {
+			return ImportProgressMsg{Done: true}
+		}
+		msg.Channel = ch
+		return msg
+	}
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// streamImportResults decodes the first JSON array in r one result at a time
+// and sends them in chunks of chunkSize. Text before the array, such as a
+// markdown fence, is skipped. If the array doesn't decode cleanly, the rest
+// of r is read and parsed like pasted input, and the results not yet sent
+// follow.
+func streamImportResults(r io.Reader, size int64, chunkSize int, ch chan<- ImportProgressMsg) {
+	counter := &countingReader{r: r}
+	br := bufio.NewReader(counter)
+	progress := func() float64 {
+		if size <= 0 {
+			return 0
+		}
+		return min(float64(counter.n)/float64(size), 1)
+	}
+
+	var head strings.Builder
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			ch <- ImportProgressMsg{Done: true, Err: fmt.Errorf("no valid JSON array found in input")}
+			return
+		}
+		if b == '[' {
+			br.UnreadByte()
+			break
+		}
+		head.WriteByte(b)
+	}
+
+	// Keep what the decoder consumes in case the fallback needs it
+	var seen strings.Builder
+	dec := json.NewDecoder(io.TeeReader(br, &seen))
+	sent := 0
+	var chunk []triage.Result
+	err := func() error {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		for dec.More() {
+			var result triage.Result
+			if err := dec.Decode(&result); err != nil {
+				return err
+			}
+			chunk = append(chunk, result)
+			if len(chunk) == chunkSize {
+				ch <- ImportProgressMsg{Results: chunk, Progress: progress()}
+				sent += len(chunk)
+				chunk = nil
+			}
+		}
+		return nil
+	}()
+	if err == nil {
+		if sent+len(chunk) == 0 {
+			ch <- ImportProgressMsg{Done: true, Err: fmt.Errorf("empty results array")}
+			return
+		}
+		ch <- ImportProgressMsg{Results: chunk, Progress: 1, Done: true}
+		return
+	}
+
+	rest, readErr := io.ReadAll(br)
+	if readErr != nil {
+		ch <- ImportProgressMsg{Done: true, Err: fmt.Errorf("failed to read file: %w", readErr)}
+		return
+	}
+	results, parseErr := parseImportResults(head.String() + seen.String() + string(rest))
+	if parseErr != nil {
+		ch <- ImportProgressMsg{Done: true, Err: parseErr}
+		return
+	}
+	results = results[min(sent, len(results)):]
+	for len(results) > chunkSize {
+		ch <- ImportProgressMsg{Results: results[:chunkSize], Progress: progress()}
+		results = results[chunkSize:]
+	}
+	ch <- ImportProgressMsg{Results: results, Progress: 1, Done: true}
+}
+
+// handleImportProgress applies a chunk of a file import and waits for the
+// next one, or shows the summary once the last chunk is in.
+func (m *Model) handleImportProgress(msg ImportProgressMsg) tea.Cmd {
+	run := m.importRun
+	if run == nil {
+		return nil
+	}
+
+	applied, errors, matches, unmatched := m.applyImportResults(msg.Results, run.total)
+	run.total += len(msg.Results)
+	run.applied += applied
+	run.errors = append(run.errors, errors...)
+	run.matches = append(run.matches, matches...)
+	run.unmatched = append(run.unmatched, unmatched...)
+
+	if !msg.Done {
+		m.updateProgress = msg.Progress
+		m.statusMessage = fmt.Sprintf("Applied %s of %s results read...", formatCount(run.applied), formatCount(run.total))
+		return tea.Batch(waitForImportProgress(msg.Channel), m.progress.SetPercent(msg.Progress))
+	}
+
+	m.importRun = nil
+	m.pendingImport = run.unmatched
+	err := msg.Err
+	if err == nil {
+		err = m.finishImport(run.applied, run.total, run.errors, run.matches)
+	} else if run.applied > 0 {
+		m.listView.SetItems(m.items)
+		err = fmt.Errorf("%w (%s results were applied before the error)", err, formatCount(run.applied))
+	}
+	if err != nil {
+		m.statusMessage = fmt.Sprintf("Import failed: %v", err)
+		m.messageType = "error"
+	} else {
+		m.messageType = "success"
+	}
+	m.state = StateMessage
+	return nil
+}
@@ -0,0 +1,160 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runImport feeds a file import's chunks to the model until it finishes and
+// returns the progress reported along the way.
+func runImport(t *testing.T, m *Model, cmd tea.Cmd) []float64 {
+	t.Helper()
+	var progress []float64
+	for m.state == StateImporting {
+		if cmd == nil {
+			t.Fatal("import stalled without a command")
+		}
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			// The first command waits for the next chunk; the rest animate
+			msg = batch[0]()
+		}
+		chunk, ok := msg.(ImportProgressMsg)
+		if !ok {
+			t.Fatalf("expected an ImportProgressMsg, got %T", msg)
+		}
+		if !chunk.Done {
+			progress = append(progress, chunk.Progress)
+		}
+		_, cmd = m.Update(chunk)
+	}
+	return progress
+}
+
+func writeImportFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "results.json")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestImportFromFileLarge(t *testing.T) {
+	const n = 5*importChunkSize + 37
+	m := newTestModel()
+	var items []Item
+	var results []string
+	for i := 0; i < n; i++ {
+		items = append(items, Item{ID: fmt.Sprintf("doc-%d", i), Title: fmt.Sprintf("Item %d", i)})
+		results = append(results, fmt.Sprintf(`{"id": "doc-%d", "title": "Item %d", "triage_decision": {"action": "later", "priority": "low", "reason": "%s"}}`, i, i, strings.Repeat("x", 200)))
+	}
+	m.Update(ItemsLoadedMsg{Items: items})
+	path := writeImportFile(t, "```json\n[\n"+strings.Join(results, ",\n")+"\n]\n```\n")
+
+	cmd, err := m.ImportTriageResultsFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.state != StateImporting || !strings.Contains(m.View(), "Importing Results") {
+		t.Fatalf("expected the import progress screen, got state %v", m.state)
+	}
+	progress := runImport(t, m, cmd)
+
+	if len(progress) != 5 {
+		t.Errorf("expected 5 intermediate progress reports, got %d: %v", len(progress), progress)
+	}
+	for i := 1; i < len(progress); i++ {
+		if progress[i] <= progress[i-1] || progress[i] > 1 {
+			t.Errorf("expected progress to increase within (0, 1], got %v", progress)
+			break
+		}
+	}
+	if m.state != StateMessage || m.messageType != "success" {
+		t.Fatalf("expected a success message, got state %v: %q", m.state, m.statusMessage)
+	}
+	if want := fmt.Sprintf("applied triage results to %s items", formatCount(n)); !strings.Contains(m.statusMessage, want) {
+		t.Errorf("statusMessage = %q, want it to contain %q", m.statusMessage, want)
+	}
+	for _, item := range m.items {
+		if item.Action != "later" || item.Priority != "low" {
+			t.Fatalf("expected every item imported, %s has %q/%q", item.ID, item.Action, item.Priority)
+		}
+	}
+	if entry, ok := m.triageStore.GetItem(fmt.Sprintf("doc-%d", n-1)); !ok || entry.Report == nil {
+		t.Error("expected the last result saved with its report")
+	}
+}
+
+func TestImportFromFileWarnings(t *testing.T) {
+	m := newTestModel()
+	var items []Item
+	var results []string
+	for i := 0; i < importChunkSize+1; i++ {
+		items = append(items, Item{ID: fmt.Sprintf("doc-%d", i), Title: fmt.Sprintf("Item %d", i)})
+		results = append(results, fmt.Sprintf(`{"id": "doc-%d", "triage_decision": {"action": "archive"}}`, i))
+	}
+	results = append(results, `{"id": "missing", "triage_decision": {"action": "archive"}}`)
+	m.Update(ItemsLoadedMsg{Items: items})
+
+	cmd, err := m.ImportTriageResultsFromFile(writeImportFile(t, "["+strings.Join(results, ",")+"]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runImport(t, m, cmd)
+
+	want := fmt.Sprintf("Applied %d/%d results", importChunkSize+1, importChunkSize+2)
+	if !strings.Contains(m.statusMessage, want) || !strings.Contains(m.statusMessage, fmt.Sprintf("result %d: id 'missing' not found", importChunkSize+1)) {
+		t.Errorf("expected the summary with a numbered warning, got %q", m.statusMessage)
+	}
+	if len(m.pendingImport) != 1 {
+		t.Errorf("expected the unmatched result kept for retry, got %d", len(m.pendingImport))
+	}
+}
+
+func TestImportFromFileSanitizes(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One"}, {ID: "2", Title: "Two"}}})
+
+	// A trailing comma breaks the streaming decoder and falls back to the
+	// sanitizing parser
+	path := writeImportFile(t, `[{"id": "1", "triage_decision": {"action": "archive"}}, {"id": "2", "triage_decision": {"action": "later"}},]`)
+	cmd, err := m.ImportTriageResultsFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runImport(t, m, cmd)
+	if m.messageType != "success" || m.items[0].Action != "archive" || m.items[1].Action != "later" {
+		t.Errorf("expected both results applied, got %q/%q: %q", m.items[0].Action, m.items[1].Action, m.statusMessage)
+	}
+}
+
+func TestImportFromFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"no array", "no results here", "no valid JSON array"},
+		{"empty", "[]", "empty results array"},
+		{"invalid", `[{"id": }]`, "failed to parse JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			cmd, err := m.ImportTriageResultsFromFile(writeImportFile(t, tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			runImport(t, m, cmd)
+			if m.messageType != "error" || !strings.Contains(m.statusMessage, tt.want) {
+				t.Errorf("statusMessage = %q, want an error containing %q", m.statusMessage, tt.want)
+			}
+		})
+	}
+}
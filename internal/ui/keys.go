@@ -4,23 +4,51 @@ import "github.com/charmbracelet/bubbles/key"
 
 // KeyMap defines the keybindings for the application
 type KeyMap struct {
-	Up         key.Binding
-	Down       key.Binding
-	Left       key.Binding
-	Right      key.Binding
-	Enter      key.Binding
-	Back       key.Binding
-	Quit       key.Binding
-	Help       key.Binding
-	Select     key.Binding
-	Open       key.Binding
-	Update     key.Binding
-	FetchMore  key.Binding
-	Delete     key.Binding
-	ToggleMode key.Binding
-	CycleTheme key.Binding
-	Refresh    key.Binding
-	AutoTriage key.Binding
+	Up           key.Binding
+	Down         key.Binding
+	Left         key.Binding
+	Right        key.Binding
+	Enter        key.Binding
+	Back         key.Binding
+	Quit         key.Binding
+	Help         key.Binding
+	Select       key.Binding
+	Open         key.Binding
+	OpenReader   key.Binding
+	OpenReview   key.Binding
+	OpenReadNow  key.Binding
+	ArchiveRest  key.Binding
+	Update       key.Binding
+	PushNow      key.Binding
+	FetchMore    key.Binding
+	PrevWeek     key.Binding
+	NextWeek     key.Binding
+	Delete       key.Binding
+	ToggleMode   key.Binding
+	CycleTheme   key.Binding
+	Refresh      key.Binding
+	AutoTriage   key.Binding
+	Retriage     key.Binding
+	HideFinished key.Binding
+	Compact      key.Binding
+	CleanTitles  key.Binding
+	Filter       key.Binding
+	Notes        key.Binding
+	RenameTag    key.Binding
+	ExportFile   key.Binding
+	SinceLast    key.Binding
+	FetchLimit   key.Binding
+	Presets      key.Binding
+	Category     key.Binding
+	VerifyToken  key.Binding
+	LLMProvider  key.Binding
+	RetryImport  key.Binding
+	Preview      key.Binding
+	Explain      key.Binding
+	RefreshItem  key.Binding
+	JumpTo       key.Binding
+	Reason       key.Binding
+	CopyItem     key.Binding
 }
 
 // DefaultKeyMap returns the default keybindings
@@ -66,14 +94,42 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("o"),
 			key.WithHelp("o", "open url"),
 		),
+		OpenReader: key.NewBinding(
+			key.WithKeys("O"),
+			key.WithHelp("O", "open in reader"),
+		),
+		OpenReview: key.NewBinding(
+			key.WithKeys("N"),
+			key.WithHelp("N", "open all needs review"),
+		),
+		OpenReadNow: key.NewBinding(
+			key.WithKeys("W"),
+			key.WithHelp("W", "open read now in Reader"),
+		),
+		ArchiveRest: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "archive untriaged"),
+		),
 		Update: key.NewBinding(
 			key.WithKeys("u"),
 			key.WithHelp("u", "update readwise"),
 		),
+		PushNow: key.NewBinding(
+			key.WithKeys("U"),
+			key.WithHelp("U", "update without confirm"),
+		),
 		FetchMore: key.NewBinding(
 			key.WithKeys("f"),
 			key.WithHelp("f", "fetch more"),
 		),
+		PrevWeek: key.NewBinding(
+			key.WithKeys("["),
+			key.WithHelp("[", "previous week"),
+		),
+		NextWeek: key.NewBinding(
+			key.WithKeys("]"),
+			key.WithHelp("]", "next week"),
+		),
 		Delete: key.NewBinding(
 			key.WithKeys("d"),
 			key.WithHelp("d", "delete"),
@@ -94,14 +150,108 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("T"),
 			key.WithHelp("T", "auto-triage with LLM"),
 		),
+		Retriage: key.NewBinding(
+			key.WithKeys("ctrl+t"),
+			key.WithHelp("ctrl+t", "re-triage item"),
+		),
+		HideFinished: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "hide finished"),
+		),
+		Compact: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "compact view"),
+		),
+		CleanTitles: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "short titles"),
+		),
+		Filter: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "filter"),
+		),
+		Notes: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "edit notes"),
+		),
+		RenameTag: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "rename tag"),
+		),
+		ExportFile: key.NewBinding(
+			key.WithKeys("ctrl+e"),
+			key.WithHelp("ctrl+e", "export to file"),
+		),
+		SinceLast: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "since last fetch"),
+		),
+		FetchLimit: key.NewBinding(
+			key.WithKeys("L"),
+			key.WithHelp("L", "item limit"),
+		),
+		Presets: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "fetch presets"),
+		),
+		Category: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "category"),
+		),
+		VerifyToken: key.NewBinding(
+			key.WithKeys("V"),
+			key.WithHelp("V", "verify token"),
+		),
+		LLMProvider: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "llm provider"),
+		),
+		RetryImport: key.NewBinding(
+			key.WithKeys("I"),
+			key.WithHelp("I", "retry unmatched import"),
+		),
+		Preview: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "preview content"),
+		),
+		Explain: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "why this decision"),
+		),
+		RefreshItem: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "re-fetch item"),
+		),
+		JumpTo: key.NewBinding(
+			key.WithKeys("'"),
+			key.WithHelp("'", "jump to title"),
+		),
+		Reason: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "edit reason"),
+		),
+		CopyItem: key.NewBinding(
+			key.WithKeys("Y"),
+			key.WithHelp("Y", "copy item export"),
+		),
 	}
 }
 
 // Keys returns the keys as a slice for matching
 func (k KeyMap) Keys() []key.Binding {
 	return []key.Binding{
-		k.Up, k.Down, k.Left, k.Right,
-		k.Enter, k.Back, k.Quit, k.Help, k.Select, k.Open, k.Update, k.FetchMore,
-		k.Delete, k.ToggleMode, k.CycleTheme, k.Refresh, k.AutoTriage,
+		// Navigation and selection
+		k.Up, k.Down, k.Left, k.Right, k.JumpTo,
+		k.Enter, k.Back, k.Quit, k.Help, k.Select,
+		// Opening items
+		k.Open, k.OpenReader, k.OpenReview, k.OpenReadNow, k.Preview,
+		// Push and fetch
+		k.Update, k.PushNow, k.FetchMore, k.PrevWeek, k.NextWeek, k.Refresh, k.RefreshItem,
+		k.SinceLast, k.FetchLimit, k.Presets, k.Category, k.VerifyToken, k.LLMProvider,
+		// View toggles
+		k.ToggleMode, k.CycleTheme, k.HideFinished, k.Compact, k.CleanTitles, k.Filter,
+		// Item actions
+		k.Delete, k.ArchiveRest, k.AutoTriage, k.Retriage, k.RetryImport, k.Explain,
+		k.Notes, k.Reason, k.RenameTag, k.ExportFile, k.CopyItem,
 	}
 }
@@ -2,22 +2,33 @@ package ui
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
 )
 
 type ListView struct {
 	table       table.Model
 	items       []Item
-	cursor      int
-	selected    map[int]bool
+	visible     []int // indices into items for the rows currently shown
+	filter      func(Item) bool
+	cursor      int          // row position within visible
+	selected    map[int]bool // keyed by item index
 	width       int
 	height      int
-	visibleRows int // number of data rows visible (excluding header)
+	visibleRows int           // number of data rows visible (excluding header)
+	compact     bool          // one dense line per item, no column header or detail pane
+	staleAfter  time.Duration // published age flagged as stale in the detail pane (0 = never)
+	cleanTitles bool          // strip site-name suffixes from titles in the list
+	showAuthor  bool          // show the Author column
 
 	// Styles for custom rendering
 	headerStyle   lipgloss.Style
@@ -26,11 +37,22 @@ type ListView struct {
 	columns       []table.Column
 }
 
-func listColumns(width int) []table.Column {
-	// Each cell has Padding(0,1) adding 2 chars per column (7 columns = 14 extra).
+// authorColumnWidth is the width of the optional Author column.
+const authorColumnWidth = 14
+
+// listColumns lays out the table columns for the given terminal width. The
+// Author column has zero width, and so is skipped, unless showAuthor is set.
+func listColumns(width int, showAuthor bool) []table.Column {
+	// Each cell has Padding(0,1) adding 2 chars per column (8 columns = 16 extra).
 	// Subtract 2 more to avoid hitting exact terminal width (causes implicit wraps).
-	fixedWidth := 2 + 10 + 8 + 10 + 14 + 20 // non-title columns
-	padding := 7*2 + 2                      // 7 columns × 2 chars padding each + 2 safety margin
+	fixedWidth := 2 + 10 + 8 + 10 + 14 + 14 + 20 // non-title columns
+	padding := 8*2 + 2                           // 8 columns × 2 chars padding each + 2 safety margin
+	authorWidth := 0
+	if showAuthor {
+		authorWidth = authorColumnWidth
+		fixedWidth += authorWidth
+		padding += 2
+	}
 	titleWidth := width - fixedWidth - padding
 	if titleWidth < 20 {
 		titleWidth = 20
@@ -40,6 +62,8 @@ func listColumns(width int) []table.Column {
 		{Title: "Action", Width: 10},
 		{Title: "Priority", Width: 8},
 		{Title: "Category", Width: 10},
+		{Title: "Source", Width: 14},
+		{Title: "Author", Width: authorWidth},
 		{Title: "Info", Width: 14},
 		{Title: "Tags", Width: 20},
 		{Title: "Title", Width: titleWidth},
@@ -47,7 +71,7 @@ func listColumns(width int) []table.Column {
 }
 
 func NewListView(width, height int) ListView {
-	columns := listColumns(width)
+	columns := listColumns(width, false)
 
 	headerStyle := lipgloss.NewStyle().
 		BorderStyle(lipgloss.NormalBorder()).
@@ -60,13 +84,7 @@ func NewListView(width, height int) ListView {
 		Bold(false)
 	cellStyle := lipgloss.NewStyle().Padding(0, 1)
 
-	// Reserve space for: header(2) + divider(1) + detail pane(4) + status(1) + footer(4)
-	visibleRows := height - 12
-	// Subtract 2 for the table header (text + border)
-	visibleRows -= 2
-	if visibleRows < 3 {
-		visibleRows = 3
-	}
+	visibleRows := listRows(height, false)
 
 	// Still create the table for compatibility but we won't use its View()
 	t := table.New(
@@ -121,9 +139,72 @@ func (lv *ListView) SetItems(items []Item) {
 	lv.updateRows()
 }
 
-func (lv *ListView) updateRows() {
-	rows := make([]table.Row, len(lv.items))
+// SetFilter sets a predicate that decides which items are shown. Items for
+// which keep returns false are hidden and deselected. A nil filter shows all.
+func (lv *ListView) SetFilter(keep func(Item) bool) {
+	lv.filter = keep
+	lv.updateRows()
+}
+
+// refreshVisible recomputes the visible item indices and clamps the cursor.
+func (lv *ListView) refreshVisible() {
+	lv.visible = lv.visible[:0]
 	for i, item := range lv.items {
+		if lv.filter == nil || lv.filter(item) {
+			lv.visible = append(lv.visible, i)
+		} else {
+			delete(lv.selected, i)
+		}
+	}
+	if lv.cursor >= len(lv.visible) {
+		lv.cursor = len(lv.visible) - 1
+	}
+	if lv.cursor < 0 {
+		lv.cursor = 0
+	}
+}
+
+// VisibleCount returns the number of rows currently shown.
+func (lv ListView) VisibleCount() int {
+	return len(lv.visible)
+}
+
+// ItemIndex returns the item index for a visible row, or -1 if out of range.
+func (lv ListView) ItemIndex(row int) int {
+	if row >= 0 && row < len(lv.visible) {
+		return lv.visible[row]
+	}
+	return -1
+}
+
+// FindTitlePrefix returns the first visible row at or after from, wrapping
+// around, whose list title starts with prefix (ignoring case), or -1.
+func (lv ListView) FindTitlePrefix(prefix string, from int) int {
+	prefix = strings.ToLower(prefix)
+	n := len(lv.visible)
+	for i := 0; i < n; i++ {
+		row := ((from+i)%n + n) % n
+		title := lv.items[lv.visible[row]].Title
+		if lv.cleanTitles {
+			title = cleanTitle(title)
+		}
+		if strings.HasPrefix(strings.ToLower(title), prefix) {
+			return row
+		}
+	}
+	return -1
+}
+
+// CurrentItem returns the item under the cursor, or nil if there are no rows.
+func (lv ListView) CurrentItem() *Item {
+	return lv.GetItem(lv.ItemIndex(lv.cursor))
+}
+
+func (lv *ListView) updateRows() {
+	lv.refreshVisible()
+	rows := make([]table.Row, len(lv.visible))
+	for row, i := range lv.visible {
+		item := lv.items[i]
 		sel := " "
 		if lv.selected[i] {
 			sel = "●"
@@ -132,11 +213,13 @@ func (lv *ListView) updateRows() {
 		actionText := runewidth.FillRight(getActionText(item.Action), 10)
 		priorityText := runewidth.FillRight(getPriorityText(item.Priority), 8)
 		category := Truncate(item.Category, 10)
+		source := Truncate(item.SourceName(), 14)
+		author := Truncate(item.Author, authorColumnWidth)
 		info := formatInfo(item.ReadingTime, item.WordCount)
 		tags := Truncate(strings.Join(item.Tags, ", "), 20)
-		title := Truncate(item.Title, lv.width-80)
+		title := Truncate(lv.listTitle(item), lv.width-96)
 
-		rows[i] = table.Row{sel, actionText, priorityText, category, info, tags, title}
+		rows[row] = table.Row{sel, actionText, priorityText, category, source, author, info, tags, title}
 	}
 	lv.table.SetRows(rows)
 }
@@ -154,11 +237,88 @@ func formatInfo(readingTime string, wordCount int) string {
 	return ""
 }
 
+// formatProgress renders a 0.0–1.0 reading progress as a whole percentage.
+func formatProgress(progress float64) string {
+	return fmt.Sprintf("%d%%", int(progress*100+0.5))
+}
+
+// formatCount renders a count with thousands separators, e.g. 1234 → "1,234".
+func formatCount(n int) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
+// staleTriageAge is how old a saved decision gets before it is shown dimmed.
+const staleTriageAge = 30 * 24 * time.Hour
+
+// humanizeTime renders an RFC3339 timestamp relative to now, e.g. "2d ago".
+// Empty input gives "", and unparseable input is returned unchanged.
+func humanizeTime(t string) string {
+	if t == "" {
+		return ""
+	}
+	at, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return t
+	}
+	d := time.Since(at)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d/time.Minute))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d/time.Hour))
+	case d < 14*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d/(24*time.Hour)))
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("%dw ago", int(d/(7*24*time.Hour)))
+	default:
+		return fmt.Sprintf("%dy ago", int(d/(365*24*time.Hour)))
+	}
+}
+
+// stalePublished reports whether content published at t is older than after.
+// An unknown date or a zero threshold is never stale.
+func stalePublished(t, now time.Time, after time.Duration) bool {
+	return !t.IsZero() && after > 0 && now.Sub(t) > after
+}
+
+// staleTriage reports whether a saved decision is older than staleTriageAge.
+func staleTriage(t string, now time.Time) bool {
+	at, err := time.Parse(time.RFC3339, t)
+	return err == nil && now.Sub(at) > staleTriageAge
+}
+
+// Truncate shortens s to at most maxLen terminal columns, ending it with "…"
+// when something was cut. It cuts between grapheme clusters, so flags, ZWJ
+// emoji sequences and combining marks are dropped whole, never split.
 func Truncate(s string, maxLen int) string {
-	if runewidth.StringWidth(s) > maxLen {
-		return runewidth.Truncate(s, maxLen, "…")
+	if uniseg.StringWidth(s) <= maxLen {
+		return s
 	}
-	return s
+	if maxLen < 1 {
+		return ""
+	}
+	var b strings.Builder
+	width := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		// Leave one column for the ellipsis
+		if width+g.Width() > maxLen-1 {
+			break
+		}
+		b.WriteString(g.Str())
+		width += g.Width()
+	}
+	return b.String() + "…"
 }
 
 func getActionText(action string) string {
@@ -196,7 +356,7 @@ const detailPaneHeight = 4
 
 // DetailView renders a detail pane for the given item, padded to a fixed height.
 func (lv *ListView) DetailView(width int, styles Styles) string {
-	item := lv.GetItem(lv.cursor)
+	item := lv.CurrentItem()
 	if item == nil {
 		return ""
 	}
@@ -215,8 +375,26 @@ func (lv *ListView) DetailView(width int, styles Styles) string {
 	}
 
 	var meta []string
-	if item.Source != "" {
-		meta = append(meta, "src:"+item.Source)
+	metaStyle := styles.Normal
+	if age := humanizeTime(item.TriagedAt); age != "" {
+		if staleTriage(item.TriagedAt, time.Now()) {
+			// Dim old decisions so they get a second look
+			meta = append(meta, "triaged "+age+" (stale)")
+			metaStyle = styles.Help
+		} else {
+			meta = append(meta, "triaged "+age)
+		}
+	}
+	publishedAt := -1
+	if !item.PublishedAt.IsZero() {
+		publishedAt = len(meta)
+		meta = append(meta, "published "+item.PublishedAt.Format("Jan 2, 2006"))
+	}
+	if src := item.SourceName(); src != "" {
+		meta = append(meta, "src:"+src)
+	}
+	if item.Author != "" {
+		meta = append(meta, "by "+item.Author)
 	}
 	if item.Category != "" {
 		meta = append(meta, "cat:"+item.Category)
@@ -227,11 +405,52 @@ func (lv *ListView) DetailView(width int, styles Styles) string {
 	if item.WordCount > 0 {
 		meta = append(meta, fmt.Sprintf("%d words", item.WordCount))
 	}
-	if len(item.Tags) > 0 {
-		meta = append(meta, "tags:"+strings.Join(item.Tags, ","))
+	if item.Progress > 0 {
+		meta = append(meta, formatProgress(item.Progress)+" read")
+	}
+	if item.StartFresh {
+		meta = append(meta, "start fresh")
+	}
+	if item.Starred {
+		meta = append(meta, "★ starred")
 	}
-	if len(meta) > 0 {
-		lines = append(lines, styles.Normal.Render(Truncate(strings.Join(meta, " · "), maxWidth)))
+	if item.AltAction != "" {
+		alt := "alt:" + item.AltAction
+		if item.AltPriority != "" {
+			alt += "/" + item.AltPriority
+		}
+		meta = append(meta, alt)
+	}
+	tagsAt := len(meta)
+	if n := len(item.DuplicateIDs); n > 0 {
+		meta = append(meta, fmt.Sprintf("+%d duplicate(s)", n))
+	}
+	if item.Notes != "" {
+		meta = append(meta, "notes:"+strings.Join(strings.Fields(item.Notes), " "))
+	}
+	if item.Reason != "" {
+		meta = append(meta, "why:"+item.Reason)
+	}
+	fields := make([][]metaSpan, 0, len(meta)+1)
+	for _, text := range meta {
+		fields = append(fields, []metaSpan{{text, metaStyle}})
+	}
+	if publishedAt >= 0 && stalePublished(item.PublishedAt, time.Now(), lv.staleAfter) {
+		// Old content is a common delete candidate; make it stand out
+		fields[publishedAt] = append(fields[publishedAt], metaSpan{" ⚠ stale", styles.Error})
+	}
+	if tags := tagSpans(item, metaStyle, styles); len(tags) > 0 {
+		fields = slices.Insert(fields, tagsAt, tags)
+	}
+	var spans []metaSpan
+	for i, field := range fields {
+		if i > 0 {
+			spans = append(spans, metaSpan{" · ", metaStyle})
+		}
+		spans = append(spans, field...)
+	}
+	if len(spans) > 0 {
+		lines = append(lines, renderSpans(spans, maxWidth))
 	}
 
 	if item.Summary != "" {
@@ -245,12 +464,68 @@ func (lv *ListView) DetailView(width int, styles Styles) string {
 	return strings.Join(lines, "\n")
 }
 
+// metaSpan is a piece of the detail pane's metadata line with its own style.
+type metaSpan struct {
+	text  string
+	style lipgloss.Style
+}
+
+// renderSpans renders spans as one line, truncated to maxWidth like Truncate.
+func renderSpans(spans []metaSpan, maxWidth int) string {
+	total := 0
+	for _, s := range spans {
+		total += runewidth.StringWidth(s.text)
+	}
+	remaining, tail := maxWidth, ""
+	if total > maxWidth {
+		// Leave room for the ellipsis
+		remaining, tail = maxWidth-1, "…"
+	}
+
+	var b strings.Builder
+	for _, s := range spans {
+		w := runewidth.StringWidth(s.text)
+		if w > remaining {
+			b.WriteString(s.style.Render(runewidth.Truncate(s.text, remaining, "") + tail))
+			break
+		}
+		b.WriteString(s.style.Render(s.text))
+		remaining -= w
+	}
+	return b.String()
+}
+
+// tagSpans renders the item's tags with Readwise's original tags muted and
+// tags added during triage highlighted and marked with +.
+func tagSpans(item *Item, metaStyle lipgloss.Style, styles Styles) []metaSpan {
+	original := make(map[string]bool, len(item.OriginalTags))
+	var spans []metaSpan
+	add := func(text string, style lipgloss.Style) {
+		if len(spans) == 0 {
+			spans = append(spans, metaSpan{"tags:", metaStyle})
+		} else {
+			spans = append(spans, metaSpan{",", metaStyle})
+		}
+		spans = append(spans, metaSpan{text, style})
+	}
+	for _, tag := range item.OriginalTags {
+		original[tag] = true
+		add(tag, styles.Help)
+	}
+	for _, tag := range item.Tags {
+		if !original[tag] {
+			add("+"+tag, styles.Highlight)
+		}
+	}
+	return spans
+}
+
 func (lv ListView) Cursor() int {
 	return lv.cursor
 }
 
 func (lv *ListView) SetCursor(pos int) {
-	if pos >= 0 && pos < len(lv.items) {
+	if pos >= 0 && pos < len(lv.visible) {
 		lv.cursor = pos
 		lv.table.SetCursor(pos)
 	}
@@ -258,7 +533,7 @@ func (lv *ListView) SetCursor(pos int) {
 
 func (lv *ListView) MoveCursor(delta int) {
 	newPos := lv.cursor + delta
-	if newPos >= 0 && newPos < len(lv.items) {
+	if newPos >= 0 && newPos < len(lv.visible) {
 		lv.cursor = newPos
 		lv.table.SetCursor(newPos)
 	}
@@ -276,8 +551,8 @@ func (lv *ListView) SyncCursor() int {
 }
 
 func (lv *ListView) ToggleSelection() {
-	if lv.cursor < len(lv.items) {
-		lv.selected[lv.cursor] = !lv.selected[lv.cursor]
+	if idx := lv.ItemIndex(lv.cursor); idx != -1 {
+		lv.selected[idx] = !lv.selected[idx]
 		lv.updateRows()
 	}
 }
@@ -286,6 +561,7 @@ func (lv ListView) IsSelected(index int) bool {
 	return lv.selected[index]
 }
 
+// GetSelected returns the item indices of the selected items in ascending order.
 func (lv ListView) GetSelected() []int {
 	var indices []int
 	for i, selected := range lv.selected {
@@ -293,9 +569,19 @@ func (lv ListView) GetSelected() []int {
 			indices = append(indices, i)
 		}
 	}
+	sort.Ints(indices)
 	return indices
 }
 
+// SetSelection replaces the selection with the given item indices.
+func (lv *ListView) SetSelection(indices []int) {
+	lv.selected = make(map[int]bool, len(indices))
+	for _, idx := range indices {
+		lv.selected[idx] = true
+	}
+	lv.updateRows()
+}
+
 func (lv ListView) GetItem(index int) *Item {
 	if index >= 0 && index < len(lv.items) {
 		return &lv.items[index]
@@ -306,12 +592,15 @@ func (lv ListView) GetItem(index int) *Item {
 // renderCell renders a single cell value with the given column width.
 func (lv *ListView) renderCell(value string, colWidth int) string {
 	style := lipgloss.NewStyle().Width(colWidth).MaxWidth(colWidth).Inline(true)
-	return lv.cellStyle.Render(style.Render(runewidth.Truncate(value, colWidth, "…")))
+	return lv.cellStyle.Render(style.Render(Truncate(value, colWidth)))
 }
 
 // View renders the table with our own scrolling logic, bypassing the
 // bubbles table viewport which has broken YOffset calculations.
 func (lv ListView) View() string {
+	if lv.compact {
+		return lv.compactView()
+	}
 	rows := lv.table.Rows()
 
 	// Render header
@@ -321,7 +610,7 @@ func (lv ListView) View() string {
 			continue
 		}
 		style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Inline(true)
-		cell := style.Render(runewidth.Truncate(col.Title, col.Width, "…"))
+		cell := style.Render(Truncate(col.Title, col.Width))
 		headerCells = append(headerCells, lv.headerStyle.Render(lv.cellStyle.Render(cell)))
 	}
 	header := lipgloss.JoinHorizontal(lipgloss.Top, headerCells...)
@@ -332,17 +621,10 @@ func (lv ListView) View() string {
 		visibleRows = 10
 	}
 
-	start := 0
-	if lv.cursor >= visibleRows {
-		start = lv.cursor - visibleRows + 1
-	}
+	start := lv.scrollStart()
 	end := start + visibleRows
 	if end > len(rows) {
 		end = len(rows)
-		start = end - visibleRows
-		if start < 0 {
-			start = 0
-		}
 	}
 
 	// Render visible rows
@@ -370,24 +652,200 @@ func (lv ListView) View() string {
 	return header + "\n" + strings.Join(renderedRows, "\n")
 }
 
+// compactView renders one line per item: selection, action and priority
+// glyphs, and as much of the title as fits.
+func (lv ListView) compactView() string {
+	visibleRows := lv.visibleRows
+	if visibleRows <= 0 {
+		visibleRows = 10
+	}
+
+	start := lv.scrollStart()
+	end := min(start+visibleRows, len(lv.visible))
+
+	lines := make([]string, 0, visibleRows)
+	for row := start; row < end; row++ {
+		i := lv.visible[row]
+		item := lv.items[i]
+		sel := " "
+		if lv.selected[i] {
+			sel = "●"
+		}
+		prefix := fmt.Sprintf(" %s %s %s ", sel,
+			runewidth.FillRight(glyph(getActionText(item.Action)), 2),
+			runewidth.FillRight(glyph(getPriorityText(item.Priority)), 2))
+		line := prefix + Truncate(lv.listTitle(item), max(lv.width-runewidth.StringWidth(prefix)-2, 1))
+		line = runewidth.FillRight(line, max(lv.width-1, 0))
+		if row == lv.cursor {
+			line = lv.selectedStyle.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	for len(lines) < visibleRows {
+		lines = append(lines, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// listTitle is the title shown in the list: simplified when clean titles
+// are on, and prefixed with a star for starred items.
+func (lv *ListView) listTitle(item Item) string {
+	title := item.Title
+	if lv.cleanTitles {
+		title = cleanTitle(title)
+	}
+	if item.Starred {
+		return "★ " + title
+	}
+	return title
+}
+
+// titleSeparators split a title from a trailing site name, e.g.
+// "Headline | The New York Times".
+var titleSeparators = []string{" | ", " — ", " - "}
+
+// maxSiteNameWords is the longest trailing segment cleanTitle treats as a
+// site name rather than part of the headline.
+const maxSiteNameWords = 4
+
+// cleanTitle drops a trailing site-name segment from a title. Only the last
+// segment goes, and only when it is a few words long, so titles like
+// "Rust - why the borrow checker is your friend" are kept intact.
+func cleanTitle(s string) string {
+	for _, sep := range titleSeparators {
+		i := strings.LastIndex(s, sep)
+		if i < 0 {
+			continue
+		}
+		head := strings.TrimSpace(s[:i])
+		tail := strings.Fields(s[i+len(sep):])
+		if head != "" && len(tail) > 0 && len(tail) <= maxSiteNameWords {
+			return head
+		}
+	}
+	return s
+}
+
+// glyph returns the leading symbol of an action or priority label.
+func glyph(label string) string {
+	if fields := strings.Fields(label); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// tableHeaderHeight is the number of lines the column header occupies (text + border).
+const tableHeaderHeight = 2
+
+// scrollStart returns the first visible row, keeping the cursor on screen.
+func (lv ListView) scrollStart() int {
+	visibleRows := lv.visibleRows
+	if visibleRows <= 0 {
+		visibleRows = 10
+	}
+
+	start := 0
+	if lv.cursor >= visibleRows {
+		start = lv.cursor - visibleRows + 1
+	}
+	if start+visibleRows > len(lv.visible) {
+		start = len(lv.visible) - visibleRows
+		if start < 0 {
+			start = 0
+		}
+	}
+	return start
+}
+
+// RowAtY maps a screen Y coordinate to a row index, given the number of lines
+// rendered above the list. It returns -1 if y is not on a row.
+func (lv ListView) RowAtY(y, headerOffset int) int {
+	visibleRows := lv.visibleRows
+	if visibleRows <= 0 {
+		visibleRows = 10
+	}
+
+	line := y - headerOffset - lv.headerHeight()
+	if line < 0 || line >= visibleRows {
+		return -1
+	}
+	row := lv.scrollStart() + line
+	if row >= len(lv.visible) {
+		return -1
+	}
+	return row
+}
+
 func (lv *ListView) SetWidthHeight(width, height int) {
 	lv.width = width
 	lv.height = height
-	lv.columns = listColumns(width)
+	lv.columns = listColumns(width, lv.showAuthor)
 
-	// Reserve space for: header(2) + divider(1) + detail pane(4) + status(1) + footer(4)
-	visibleRows := height - 12
-	// Subtract 2 for the table header (text + border)
-	visibleRows -= 2
-	if visibleRows < 3 {
-		visibleRows = 3
-	}
-	lv.visibleRows = visibleRows
+	lv.visibleRows = listRows(height, lv.compact)
 
-	lv.table.SetHeight(visibleRows + 2)
+	lv.table.SetHeight(lv.visibleRows + 2)
 	lv.table.SetColumns(lv.columns)
 }
 
+// SetCompact switches between the table and the one-line-per-item layout.
+func (lv *ListView) SetCompact(compact bool) {
+	lv.compact = compact
+	lv.visibleRows = listRows(lv.height, compact)
+	lv.table.SetHeight(lv.visibleRows + 2)
+}
+
+// SetStaleAfter sets the published age past which the detail pane flags an
+// item as stale; 0 turns the flag off.
+func (lv *ListView) SetStaleAfter(after time.Duration) {
+	lv.staleAfter = after
+}
+
+// SetCleanTitles switches between full and simplified titles in the list.
+// It takes effect on the next SetItems.
+func (lv *ListView) SetCleanTitles(clean bool) {
+	lv.cleanTitles = clean
+}
+
+// SetShowAuthor shows or hides the Author column.
+func (lv *ListView) SetShowAuthor(show bool) {
+	lv.showAuthor = show
+	lv.columns = listColumns(lv.width, show)
+	lv.table.SetColumns(lv.columns)
+}
+
+// CleanTitles reports whether list titles are simplified.
+func (lv ListView) CleanTitles() bool {
+	return lv.cleanTitles
+}
+
+// Compact reports whether the one-line-per-item layout is active.
+func (lv ListView) Compact() bool {
+	return lv.compact
+}
+
+// listRows returns how many item rows fit in a screen of the given height.
+func listRows(height int, compact bool) int {
+	// Reserve space for: header(2) + status(1) + footer(4), plus in table
+	// mode divider(1) + detail pane(4) + column header(2)
+	rows := height - 7
+	if !compact {
+		rows -= 1 + detailPaneHeight + tableHeaderHeight
+	}
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// headerHeight is the number of lines rendered above the first row.
+func (lv ListView) headerHeight() int {
+	if lv.compact {
+		return 0
+	}
+	return tableHeaderHeight
+}
+
 func (lv ListView) Init() tea.Cmd {
 	return nil
 }
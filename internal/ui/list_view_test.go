@@ -1,11 +1,14 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
 )
 
 func TestListView_SetItems(t *testing.T) {
@@ -75,6 +78,55 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestTruncateGraphemes(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		max   int
+	}{
+		{"flags", "🇯🇵🇫🇷🇩🇪 travel notes", 5},
+		{"flag at the cut", "ab🇯🇵cd", 3},
+		{"family ZWJ", "👨‍👩‍👧‍👦👨‍👩‍👧‍👦 family", 3},
+		{"skin tone", "👍🏽👍🏽👍🏽", 5},
+		{"combining marks", "cafe\u0301 e\u0301le\u0301gant", 5},
+		{"CJK", "日本語のタイトル", 7},
+		{"width one", "日本語", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.input, tt.max)
+			if w := uniseg.StringWidth(got); w > tt.max {
+				t.Errorf("Truncate(%q, %d) = %q, width %d exceeds max", tt.input, tt.max, got, w)
+			}
+			kept, ok := strings.CutSuffix(got, "…")
+			if !ok {
+				t.Fatalf("Truncate(%q, %d) = %q, expected an ellipsis", tt.input, tt.max, got)
+			}
+			// What's kept must be whole leading clusters of the input
+			want := graphemes(tt.input)[:len(graphemes(kept))]
+			if strings.Join(graphemes(kept), "|") != strings.Join(want, "|") {
+				t.Errorf("Truncate(%q, %d) = %q, split a grapheme cluster", tt.input, tt.max, got)
+			}
+		})
+	}
+
+	if got := Truncate("🇯🇵 Japan", 10); got != "🇯🇵 Japan" {
+		t.Errorf("expected short text unchanged, got %q", got)
+	}
+	if got := Truncate("日本", 0); got != "" {
+		t.Errorf("expected nothing at width 0, got %q", got)
+	}
+}
+
+func graphemes(s string) []string {
+	var out []string
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		out = append(out, g.Str())
+	}
+	return out
+}
+
 func TestGetActionText(t *testing.T) {
 	if !strings.Contains(getActionText("read_now"), "Read") {
 		t.Error("read_now should contain 'Read'")
@@ -106,6 +158,9 @@ func TestActionTextAlignment(t *testing.T) {
 		if width > 10 {
 			t.Errorf("action '%s' text '%s' has width %d, exceeds column width 10", action, text, width)
 		}
+		if got := Truncate(text, 10); got != text {
+			t.Errorf("action '%s' text '%s' was truncated to %q in its column", action, text, got)
+		}
 	}
 }
 
@@ -219,6 +274,29 @@ func TestGetPriorityText(t *testing.T) {
 	}
 }
 
+func TestFormatCount(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "0"},
+		{7, "7"},
+		{999, "999"},
+		{1000, "1,000"},
+		{1234, "1,234"},
+		{99999, "99,999"},
+		{100000, "100,000"},
+		{1234567, "1,234,567"},
+		{-1234, "-1,234"},
+		{-999, "-999"},
+	}
+	for _, tt := range tests {
+		if got := formatCount(tt.n); got != tt.want {
+			t.Errorf("formatCount(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
 func TestFormatInfo(t *testing.T) {
 	tests := []struct {
 		readingTime string
@@ -281,6 +359,22 @@ func TestDetailView(t *testing.T) {
 	}
 }
 
+func TestDetailViewReadingProgress(t *testing.T) {
+	lv := NewListView(80, 24)
+	styles := DefaultStyles()
+
+	lv.SetItems([]Item{{ID: "1", Title: "Half read", Progress: 0.456}})
+	detail := lv.DetailView(80, styles)
+	if !strings.Contains(detail, "46% read") {
+		t.Errorf("expected detail to contain reading progress, got %q", detail)
+	}
+
+	lv.SetItems([]Item{{ID: "2", Title: "Unread"}})
+	if detail := lv.DetailView(80, styles); strings.Contains(detail, "% read") {
+		t.Errorf("expected no progress for unread item, got %q", detail)
+	}
+}
+
 func TestDetailViewEmpty(t *testing.T) {
 	lv := NewListView(80, 24)
 	styles := DefaultStyles()
@@ -311,3 +405,299 @@ func TestUpdateTableStyles(t *testing.T) {
 	lv.UpdateTableStyles(Themes["dracula"])
 	lv.UpdateTableStyles(Themes["nord"])
 }
+
+func TestRowAtY(t *testing.T) {
+	items := make([]Item, 10)
+	for i := range items {
+		items[i] = Item{ID: fmt.Sprintf("%d", i), Title: fmt.Sprintf("Item %d", i)}
+	}
+
+	// Height 17 leaves room for 3 visible rows; rows start below the
+	// 2-line review header and the 2-line column header.
+	tests := []struct {
+		name   string
+		items  int
+		cursor int
+		y      int
+		want   int
+	}{
+		{"first row", 10, 0, 4, 0},
+		{"third row", 10, 0, 6, 2},
+		{"column header", 10, 0, 3, -1},
+		{"review header", 10, 0, 0, -1},
+		{"below list", 10, 0, 7, -1},
+		{"scrolled first row", 10, 5, 4, 3},
+		{"scrolled last row", 10, 5, 6, 5},
+		{"scrolled to end", 10, 9, 6, 9},
+		{"past last item", 2, 0, 6, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lv := NewListView(80, 17)
+			lv.SetWidthHeight(80, 17)
+			lv.SetItems(items[:tt.items])
+			lv.SetCursor(tt.cursor)
+
+			if got := lv.RowAtY(tt.y, 2); got != tt.want {
+				t.Errorf("RowAtY(%d) = %d, want %d", tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListViewSourceColumn(t *testing.T) {
+	lv := NewListView(140, 24)
+	lv.SetWidthHeight(140, 24)
+	lv.SetItems([]Item{
+		{ID: "1", Title: "With site", Source: "rss", SiteName: "Stratechery"},
+		{ID: "2", Title: "Source only", Source: "substack.com"},
+	})
+
+	rows := lv.table.Rows()
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if rows[0][4] != "Stratechery" {
+		t.Errorf("expected site name in source column, got %q", rows[0][4])
+	}
+	if rows[1][4] != "substack.com" {
+		t.Errorf("expected source fallback in source column, got %q", rows[1][4])
+	}
+	if view := lv.View(); !strings.Contains(view, "Source") || !strings.Contains(view, "Stratechery") {
+		t.Error("expected rendered table to include the source column")
+	}
+}
+
+func TestListViewAuthorColumn(t *testing.T) {
+	lv := NewListView(160, 24)
+	lv.SetWidthHeight(160, 24)
+	lv.SetItems([]Item{{ID: "1", Title: "Essay", Source: "rss", Author: "Paul Graham"}})
+
+	if view := lv.View(); strings.Contains(view, "Author") || strings.Contains(view, "Paul Graham") {
+		t.Error("expected the author column to be hidden by default")
+	}
+	lv.SetShowAuthor(true)
+	if view := lv.View(); !strings.Contains(view, "Author") || !strings.Contains(view, "Paul Graham") {
+		t.Errorf("expected the author column, got:\n%s", view)
+	}
+	if detail := lv.DetailView(160, NewStyles(Themes["default"])); !strings.Contains(detail, "by Paul Graham") {
+		t.Errorf("expected the author in the detail pane, got:\n%s", detail)
+	}
+}
+
+func TestAuthorFilter(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Startups", Author: "Paul Graham"},
+		{ID: "2", Title: "Stratechery update", Author: "Ben Thompson"},
+		{ID: "3", Title: "No byline"},
+	}})
+	if !matchesFilter(m.items[0], "author:GRAHAM") || matchesFilter(m.items[2], "author:graham") {
+		t.Error("expected author: to match the author case-insensitively")
+	}
+
+	typeKeys(m, "/author:thompson")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.listView.VisibleCount(); got != 1 {
+		t.Fatalf("expected author:thompson to leave 1 item, got %d", got)
+	}
+	if item := m.listView.CurrentItem(); item == nil || item.ID != "2" {
+		t.Errorf("expected the Ben Thompson item, got %+v", item)
+	}
+}
+
+func TestMatchesFilter(t *testing.T) {
+	item := Item{Title: "Why Go Generics Matter", Source: "rss", SiteName: "Substack.com"}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"source:substack", true},
+		{"source:SUBSTACK.COM", true},
+		{"source:medium", false},
+		{"generics", true},
+		{"source:substack generics", true},
+		{"source:substack rust", false},
+		{"source:", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := matchesFilter(item, tt.query); got != tt.want {
+				t.Errorf("matchesFilter(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListViewCompactMode(t *testing.T) {
+	var items []Item
+	for i := 0; i < 50; i++ {
+		items = append(items, Item{ID: fmt.Sprintf("%d", i), Title: fmt.Sprintf("Article %d", i), Action: "later", Priority: "high"})
+	}
+
+	for _, height := range []int{12, 24, 40} {
+		lv := NewListView(80, height)
+		lv.SetItems(items)
+		tableRows := lv.visibleRows
+
+		lv.SetCompact(true)
+		if lv.visibleRows <= tableRows {
+			t.Errorf("height %d: compact shows %d rows, table shows %d", height, lv.visibleRows, tableRows)
+		}
+		view := lv.View()
+		if got := strings.Count(view, "Article"); got != lv.visibleRows {
+			t.Errorf("height %d: expected %d compact rows rendered, got %d", height, lv.visibleRows, got)
+		}
+		if strings.Contains(view, "Priority") {
+			t.Errorf("height %d: compact view should not render the column header", height)
+		}
+		if !strings.Contains(view, "⏰") || !strings.Contains(view, "🔴") {
+			t.Errorf("height %d: expected action and priority glyphs in compact rows", height)
+		}
+
+		// The first line is the first row, since there is no header
+		if row := lv.RowAtY(5, 5); row != 0 {
+			t.Errorf("height %d: RowAtY in compact mode = %d, want 0", height, row)
+		}
+
+		lv.SetCompact(false)
+		if lv.visibleRows != tableRows {
+			t.Errorf("height %d: expected table rows restored to %d, got %d", height, tableRows, lv.visibleRows)
+		}
+	}
+}
+
+func TestHumanizeTime(t *testing.T) {
+	ago := func(d time.Duration) string {
+		return time.Now().Add(-d).Format(time.RFC3339)
+	}
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"empty", "", ""},
+		{"just now", ago(10 * time.Second), "just now"},
+		{"minutes", ago(5*time.Minute + 10*time.Second), "5m ago"},
+		{"hours", ago(3*time.Hour + time.Minute), "3h ago"},
+		{"days", ago(2*24*time.Hour + time.Hour), "2d ago"},
+		{"weeks", ago(45 * 24 * time.Hour), "6w ago"},
+		{"years", ago(800 * 24 * time.Hour), "2y ago"},
+		{"future clock skew", time.Now().Add(time.Hour).Format(time.RFC3339), "just now"},
+		{"malformed", "last tuesday", "last tuesday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := humanizeTime(tt.in); got != tt.want {
+				t.Errorf("humanizeTime(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetailViewTriagedAt(t *testing.T) {
+	lv := NewListView(120, 24)
+	styles := DefaultStyles()
+
+	lv.SetItems([]Item{{ID: "1", Title: "Fresh", Action: "later", TriagedAt: time.Now().Add(-2 * 24 * time.Hour).Format(time.RFC3339)}})
+	detail := lv.DetailView(120, styles)
+	if !strings.Contains(detail, "triaged 2d ago") || strings.Contains(detail, "stale") {
+		t.Errorf("expected a fresh triage age, got %q", detail)
+	}
+
+	lv.SetItems([]Item{{ID: "2", Title: "Old", Action: "later", TriagedAt: time.Now().Add(-60 * 24 * time.Hour).Format(time.RFC3339)}})
+	if detail := lv.DetailView(120, styles); !strings.Contains(detail, "(stale)") {
+		t.Errorf("expected an old decision to be flagged stale, got %q", detail)
+	}
+
+	lv.SetItems([]Item{{ID: "3", Title: "Untouched"}})
+	if detail := lv.DetailView(120, styles); strings.Contains(detail, "triaged") {
+		t.Errorf("expected no triage age for an untriaged item, got %q", detail)
+	}
+}
+
+func TestDetailViewPublishedDate(t *testing.T) {
+	lv := NewListView(120, 24)
+	lv.SetStaleAfter(730 * 24 * time.Hour)
+	styles := DefaultStyles()
+
+	recent := time.Now().AddDate(0, -3, 0)
+	lv.SetItems([]Item{{ID: "1", Title: "Recent", PublishedAt: recent}})
+	detail := lv.DetailView(120, styles)
+	if !strings.Contains(detail, "published "+recent.Format("Jan 2, 2006")) || strings.Contains(detail, "stale") {
+		t.Errorf("expected the published date without a stale flag, got %q", detail)
+	}
+
+	lv.SetItems([]Item{{ID: "2", Title: "Ancient", PublishedAt: time.Date(2015, time.March, 4, 0, 0, 0, 0, time.UTC)}})
+	if detail := lv.DetailView(120, styles); !strings.Contains(detail, "published Mar 4, 2015 ⚠ stale") {
+		t.Errorf("expected an old item to be flagged stale, got %q", detail)
+	}
+
+	// The flag can be turned off, and undated items show nothing
+	lv.SetStaleAfter(0)
+	if detail := lv.DetailView(120, styles); strings.Contains(detail, "stale") {
+		t.Errorf("expected no stale flag when turned off, got %q", detail)
+	}
+	lv.SetItems([]Item{{ID: "3", Title: "Undated"}})
+	if detail := lv.DetailView(120, styles); strings.Contains(detail, "published") {
+		t.Errorf("expected no published date for an undated item, got %q", detail)
+	}
+}
+
+func TestDetailViewTagDiff(t *testing.T) {
+	lv := NewListView(80, 24)
+	styles := DefaultStyles()
+
+	lv.SetItems([]Item{{
+		ID:           "1",
+		Title:        "Tagged",
+		OriginalTags: []string{"go", "news"},
+		Tags:         []string{"go", "ai"},
+		Notes:        "check later",
+	}})
+	detail := lv.DetailView(120, styles)
+	if !strings.Contains(detail, "tags:go,news,+ai · notes:check later") {
+		t.Errorf("expected original tags plain and added tags marked with +, got %q", detail)
+	}
+	if strings.Contains(detail, "+go") || strings.Contains(detail, "+news") {
+		t.Errorf("expected original tags not marked as added, got %q", detail)
+	}
+
+	// Only Readwise tags, nothing added
+	lv.SetItems([]Item{{ID: "2", Title: "Untouched", OriginalTags: []string{"go"}}})
+	if detail := lv.DetailView(120, styles); !strings.Contains(detail, "tags:go") || strings.Contains(detail, "+") {
+		t.Errorf("expected plain original tags, got %q", detail)
+	}
+
+	// A narrow pane truncates across the styled tags
+	lv.SetItems([]Item{{ID: "3", Title: "Narrow", Category: "article", OriginalTags: []string{"golang"}, Tags: []string{"distributed-systems"}}})
+	lines := strings.Split(lv.DetailView(34, styles), "\n")
+	if len(lines) < 2 || lines[1] != "cat:article · tags:golang,+di…" {
+		t.Errorf("expected the meta line truncated to 30 columns, got %q", lines)
+	}
+}
+
+func TestCleanTitle(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Rates rise again | The New York Times", "Rates rise again"},
+		{"Release notes - Go Blog", "Release notes"},
+		{"The long goodbye — The Atlantic", "The long goodbye"},
+		{"A - B | Site", "A - B"},
+		{"No separators here", "No separators here"},
+		{"Rust - why the borrow checker is your friend", "Rust - why the borrow checker is your friend"},
+		{"| Site only", "| Site only"},
+		{"Dangling | ", "Dangling | "},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := cleanTitle(tt.in); got != tt.want {
+			t.Errorf("cleanTitle(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
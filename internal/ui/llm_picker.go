@@ -0,0 +1,130 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// customProvider is the picker entry for an endpoint that is not a known
+// provider; its base URL and model are typed in.
+const customProvider = "custom"
+
+// llmPickerProviders lists the known providers followed by the custom entry.
+func llmPickerProviders() []string {
+	return append(triage.Providers(), customProvider)
+}
+
+// handleLLMPickerKeys drives the provider picker opened with P on the config
+// screen: a number picks a provider, and the custom entry asks for a base URL
+// and then a model.
+func (m *Model) handleLLMPickerKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.llmInputField != "" {
+		switch msg.Type {
+		case tea.KeyEnter:
+			if m.llmInput == "" {
+				return m, nil
+			}
+			if m.llmInputField == "base_url" {
+				m.llmBaseURL = m.llmInput
+				m.llmInputField = "model"
+				m.llmInput = ""
+				if m.cfg.LLM.Provider == customProvider {
+					m.llmInput = m.cfg.LLM.Model
+				}
+				return m, nil
+			}
+			m.setLLMProvider(customProvider, m.llmBaseURL, m.llmInput)
+			m.llmInputField = ""
+			m.llmInput = ""
+		case tea.KeyEsc:
+			m.llmInputField = ""
+			m.llmInput = ""
+		case tea.KeyBackspace:
+			if r := []rune(m.llmInput); len(r) > 0 {
+				m.llmInput = string(r[:len(r)-1])
+			}
+		case tea.KeyRunes:
+			m.llmInput += string(msg.Runes)
+		}
+		return m, nil
+	}
+
+	m.choosingLLM = false
+	providers := llmPickerProviders()
+	n, err := strconv.Atoi(msg.String())
+	if err != nil || n < 1 || n > len(providers) {
+		return m, nil
+	}
+	provider := providers[n-1]
+	if provider == customProvider {
+		m.llmInputField = "base_url"
+		m.llmInput = ""
+		if m.cfg.LLM.Provider == customProvider {
+			m.llmInput = m.cfg.LLM.BaseURL
+		}
+		return m, nil
+	}
+	m.setLLMProvider(provider, "", triage.DefaultModel(provider))
+	return m, nil
+}
+
+// setLLMProvider switches auto-triage to the given provider and saves it.
+// Known providers use their default endpoint and wire format.
+func (m *Model) setLLMProvider(provider, baseURL, model string) {
+	m.cfg.LLM.Provider = provider
+	m.cfg.LLM.BaseURL = baseURL
+	m.cfg.LLM.Model = model
+	m.cfg.LLM.APIFormat = ""
+	m.cfg.WriteLLM = true
+	m.saveConfig()
+
+	m.statusMessage = fmt.Sprintf("Auto-triage provider set to %s (%s)", provider, model)
+	if m.cfgFallback {
+		m.statusMessage += " for this session; config.yaml couldn't be loaded, so it isn't saved"
+	}
+	if provider != "ollama" && m.cfg.GetLLMConfig().APIKey == "" {
+		m.statusMessage += "; set llm.api_key or LLM_API_KEY to use it"
+	}
+}
+
+// llmLabel describes the effective LLM provider and model.
+func (m *Model) llmLabel() string {
+	llm := m.cfg.GetLLMConfig()
+	provider := llm.Provider
+	if provider == "" {
+		provider = "openai"
+	}
+	model := llm.Model
+	if model == "" {
+		model = triage.DefaultModel(provider)
+	}
+	if model == "" {
+		return provider
+	}
+	return fmt.Sprintf("%s (%s)", provider, model)
+}
+
+// llmPickerLines renders the open provider picker or custom provider input
+// for the config screen.
+func (m *Model) llmPickerLines() []string {
+	switch {
+	case m.llmInputField == "base_url":
+		return []string{"", m.styles.Normal.Render("  Custom provider base URL: " + m.llmInput + "▌")}
+	case m.llmInputField == "model":
+		return []string{"", m.styles.Normal.Render("  Custom provider model: " + m.llmInput + "▌")}
+	case m.choosingLLM:
+		lines := []string{"", m.styles.Normal.Render("  Providers for auto-triage:")}
+		for i, provider := range llmPickerProviders() {
+			desc := triage.DefaultModel(provider)
+			if provider == customProvider {
+				desc = "enter a base URL and model"
+			}
+			lines = append(lines, fmt.Sprintf("   %s  %s  %s", m.styles.HelpKey.Render(strconv.Itoa(i+1)), m.styles.Normal.Render(provider), m.styles.Help.Render(desc)))
+		}
+		return lines
+	}
+	return nil
+}
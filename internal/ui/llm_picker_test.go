@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+)
+
+func clearLLMEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{"LLM_API_KEY", "LLM_PROVIDER", "LLM_BASE_URL", "LLM_MODEL", "LLM_API_FORMAT"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestLLMPickerKnownProvider(t *testing.T) {
+	clearLLMEnv(t)
+	m := newTestModel()
+	m.cfg.LLM.BaseURL = "https://old.example.com"
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	if !m.choosingLLM {
+		t.Fatal("expected P to open the provider picker")
+	}
+	view := m.View()
+	for _, want := range []string{"1  anthropic", "3  openai  gpt-4o-mini", "6  custom"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in picker, got:\n%s", want, view)
+		}
+	}
+
+	// 2 is ollama in the sorted list
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if m.choosingLLM {
+		t.Error("expected picker closed after choosing")
+	}
+	if m.cfg.LLM.Provider != "ollama" || m.cfg.LLM.Model != "llama3" || m.cfg.LLM.BaseURL != "" {
+		t.Errorf("expected ollama with its default model and endpoint, got %+v", m.cfg.LLM)
+	}
+	if !strings.Contains(m.View(), "Provider: ollama (llama3)") {
+		t.Error("expected the config screen to show the new provider")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if llm := cfg.GetLLMConfig(); llm.Provider != "ollama" || llm.Model != "llama3" {
+		t.Errorf("GetLLMConfig() after reload = %+v, want ollama/llama3", llm)
+	}
+
+	// A provider that needs a key says so when none is set
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	if !strings.Contains(m.statusMessage, "LLM_API_KEY") {
+		t.Errorf("expected a missing key hint, got %q", m.statusMessage)
+	}
+}
+
+func TestLLMPickerCustomProvider(t *testing.T) {
+	clearLLMEnv(t)
+	m := newTestModel()
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	if m.llmInputField != "base_url" {
+		t.Fatalf("expected base URL input, got %q", m.llmInputField)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("http://localhost:1234x")})
+	m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	// q is typed, not quit
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); cmd != nil {
+		t.Fatal("expected q to be typed into the model name")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("wen2")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.llmInputField != "" {
+		t.Errorf("expected input closed, got %q", m.llmInputField)
+	}
+	want := config.LLMConfig{Provider: "custom", BaseURL: "http://localhost:1234", Model: "qwen2"}
+	if got := m.cfg.LLM; got.Provider != want.Provider || got.BaseURL != want.BaseURL || got.Model != want.Model {
+		t.Errorf("LLM config = %+v, want %+v", got, want)
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if llm := cfg.GetLLMConfig(); llm.Provider != "custom" || llm.BaseURL != "http://localhost:1234" || llm.Model != "qwen2" {
+		t.Errorf("GetLLMConfig() after reload = %+v", llm)
+	}
+
+	// Esc abandons the input without changing anything
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("6")})
+	if m.llmInput != "http://localhost:1234" {
+		t.Errorf("expected the current base URL pre-filled, got %q", m.llmInput)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.llmInputField != "" || m.cfg.LLM.Model != "qwen2" {
+		t.Errorf("expected esc to cancel, got field %q and %+v", m.llmInputField, m.cfg.LLM)
+	}
+}
+
+func TestLLMPickerFallbackConfig(t *testing.T) {
+	clearLLMEnv(t)
+	m := newTestModel()
+	m.cfgFallback = true
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if m.cfg.LLM.Provider != "ollama" {
+		t.Errorf("expected the provider used for this session, got %+v", m.cfg.LLM)
+	}
+	if !strings.Contains(m.statusMessage, "isn't saved") {
+		t.Errorf("expected a note that the choice isn't saved, got %q", m.statusMessage)
+	}
+	if _, err := os.Stat(os.Getenv("READWISE_TRIAGE_CONFIG")); !os.IsNotExist(err) {
+		t.Errorf("expected no config written from the fallback, got %v", err)
+	}
+}
@@ -28,24 +28,19 @@ var validPriorities = map[string]bool{
 	"low":    true,
 }
 
-// ExportItemsToJSON exports only untriaged items with triage prompt for manual LLM triage
+// ExportItemsToJSON exports only untriaged items with triage prompt for manual LLM triage.
+// Without a selection, at most export_max_items items are exported and
+// exportWarning says how many were left out.
 func (m *Model) ExportItemsToJSON() (string, error) {
-	type exportItem struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		URL         string `json:"url"`
-		Summary     string `json:"summary"`
-		Category    string `json:"category"`
-		Source      string `json:"source"`
-		WordCount   int    `json:"word_count"`
-		ReadingTime string `json:"reading_time"`
-	}
-
 	var items []exportItem
 	selectedIndices := m.listView.GetSelected()
 	useSelection := len(selectedIndices) > 0
+	m.exportWarning = ""
 
 	for i, item := range m.items {
+		if item.Action == "snooze" {
+			continue
+		}
 		if useSelection {
 			isSelected := false
 			for _, idx := range selectedIndices {
@@ -61,7 +56,132 @@ func (m *Model) ExportItemsToJSON() (string, error) {
 			continue
 		}
 
-		items = append(items, exportItem{
+		items = append(items, newExportItem(item))
+	}
+
+	if len(items) == 0 {
+		return "", fmt.Errorf("all items have already been triaged")
+	}
+
+	note := ""
+	if limit := m.exportMaxItems(); !useSelection && limit > 0 && len(items) > limit {
+		total := len(items)
+		items = items[:limit]
+		note = fmt.Sprintf("This is a partial batch: %d of %d untriaged items. Triage only the items below; the rest will follow in a later batch.", limit, total)
+		m.exportWarning = fmt.Sprintf("⚠️ Exported the first %s of %s untriaged items (export_max_items)", formatCount(limit), formatCount(total))
+	}
+
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal items: %w", err)
+	}
+
+	return m.exportOutput(note, data), nil
+}
+
+// ExportFocusedItemToJSON exports just the focused item, in the same shape
+// and prompt as e, whether or not it is triaged or selected.
+func (m *Model) ExportFocusedItemToJSON() (string, error) {
+	item := m.listView.CurrentItem()
+	if item == nil {
+		return "", fmt.Errorf("no item focused")
+	}
+	data, err := json.MarshalIndent([]exportItem{newExportItem(*item)}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal item: %w", err)
+	}
+	return m.exportOutput("", data), nil
+}
+
+// exportItem is one item in an export for manual triage.
+type exportItem struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Summary     string `json:"summary"`
+	Category    string `json:"category"`
+	Source      string `json:"source"`
+	WordCount   int    `json:"word_count"`
+	ReadingTime string `json:"reading_time"`
+	Starred     bool   `json:"starred,omitempty"`
+}
+
+func newExportItem(item Item) exportItem {
+	return exportItem{
+		ID:          item.ID,
+		Title:       item.Title,
+		URL:         item.URL,
+		Summary:     item.Summary,
+		Category:    item.Category,
+		Source:      item.Source,
+		WordCount:   item.WordCount,
+		ReadingTime: item.ReadingTime,
+		Starred:     item.Starred,
+	}
+}
+
+// exportOutput wraps exported items JSON in the triage prompt, or returns
+// the bare array with export_raw_json.
+func (m *Model) exportOutput(note string, data []byte) string {
+	if m.cfg != nil && m.cfg.ExportRawJSON {
+		return string(data)
+	}
+	return exportWithPrompt(triage.ExportPrompt(m.promptLang()), note, data)
+}
+
+// promptLang is the configured language of the built-in prompts.
+func (m *Model) promptLang() string {
+	if m.cfg == nil {
+		return ""
+	}
+	return m.cfg.PromptLang
+}
+
+// exportMaxItems returns the configured export cap, or 0 for no cap.
+func (m *Model) exportMaxItems() int {
+	if m.cfg == nil || m.cfg.ExportMaxItems < 0 {
+		return 0
+	}
+	return m.cfg.ExportMaxItems
+}
+
+// withExportWarning appends the warning from the last export, if any.
+func (m *Model) withExportWarning(msg string) string {
+	if m.exportWarning == "" {
+		return msg
+	}
+	return msg + " " + m.exportWarning
+}
+
+// ExportAllWithDecisions exports every item, triaged or not, with its current
+// decision so an LLM can give a second opinion. The results import with i
+// like any other export.
+func (m *Model) ExportAllWithDecisions() (string, error) {
+	type decision struct {
+		Action   string   `json:"action,omitempty"`
+		Priority string   `json:"priority,omitempty"`
+		Tags     []string `json:"tags,omitempty"`
+		Reason   string   `json:"reason,omitempty"`
+	}
+	type exportItem struct {
+		ID              string    `json:"id"`
+		Title           string    `json:"title"`
+		URL             string    `json:"url"`
+		Summary         string    `json:"summary"`
+		Category        string    `json:"category"`
+		Source          string    `json:"source"`
+		WordCount       int       `json:"word_count"`
+		ReadingTime     string    `json:"reading_time"`
+		Starred         bool      `json:"starred,omitempty"`
+		CurrentDecision *decision `json:"current_decision,omitempty"`
+	}
+
+	var items []exportItem
+	for _, item := range m.items {
+		if item.Action == "snooze" {
+			continue
+		}
+		export := exportItem{
 			ID:          item.ID,
 			Title:       item.Title,
 			URL:         item.URL,
@@ -70,11 +190,25 @@ func (m *Model) ExportItemsToJSON() (string, error) {
 			Source:      item.Source,
 			WordCount:   item.WordCount,
 			ReadingTime: item.ReadingTime,
-		})
+			Starred:     item.Starred,
+		}
+		if item.Action != "" {
+			d := &decision{Action: item.Action, Priority: item.Priority, Tags: item.Tags}
+			if m.triageStore != nil {
+				if entry, ok := m.triageStore.GetItem(item.ID); ok && entry.Report != nil {
+					d.Reason = entry.Report.TriageDecision.Reason
+				}
+			}
+			if item.Reason != "" {
+				d.Reason = item.Reason
+			}
+			export.CurrentDecision = d
+		}
+		items = append(items, export)
 	}
 
 	if len(items) == 0 {
-		return "", fmt.Errorf("all items have already been triaged")
+		return "", fmt.Errorf("no items to export")
 	}
 
 	data, err := json.MarshalIndent(items, "", "  ")
@@ -82,7 +216,14 @@ func (m *Model) ExportItemsToJSON() (string, error) {
 		return "", fmt.Errorf("failed to marshal items: %w", err)
 	}
 
-	promptPart := triage.PromptTemplate
+	note := "Items with a `current_decision` have already been triaged. Review each one: keep the decision if you agree, otherwise change it and explain why in the reason. Return every item."
+	return m.exportOutput(note, data), nil
+}
+
+// exportWithPrompt puts the items JSON after the manual triage prompt,
+// preceded by an optional note. Without a recognizable prompt it returns the
+// bare JSON.
+func exportWithPrompt(promptPart, note string, data []byte) string {
 	markers := []string{
 		"**Inbox items to process:**",
 		"**待处理的 inbox 条目：**",
@@ -97,15 +238,18 @@ func (m *Model) ExportItemsToJSON() (string, error) {
 		}
 	}
 	if idx == -1 {
-		return string(data), nil
+		return string(data)
 	}
 
 	output := promptPart[:idx+len(marker)+2]
+	if note != "" {
+		output += note + "\n\n"
+	}
 	output += "```json\n"
 	output += string(data)
 	output += "\n```"
 
-	return output, nil
+	return output
 }
 
 // ExportItemsToClipboard exports items to clipboard
@@ -115,14 +259,43 @@ func (m *Model) ExportItemsToClipboard() error {
 		return err
 	}
 
-	if err := clipboard.WriteAll(jsonData); err != nil {
+	if err := m.writeClipboard(jsonData); err != nil {
 		return fmt.Errorf("failed to copy to clipboard: %w", err)
 	}
 
 	return nil
 }
 
-// ExportItemsToFile exports items to a temp file and returns the path
+// CopyFocusedItem copies the focused item's export to the clipboard.
+func (m *Model) CopyFocusedItem() error {
+	jsonData, err := m.ExportFocusedItemToJSON()
+	if err != nil {
+		return err
+	}
+
+	if err := m.writeClipboard(jsonData); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+
+	return nil
+}
+
+// ExportAllToClipboard copies every item with its current decision to the clipboard.
+func (m *Model) ExportAllToClipboard() error {
+	jsonData, err := m.ExportAllWithDecisions()
+	if err != nil {
+		return err
+	}
+
+	if err := m.writeClipboard(jsonData); err != nil {
+		return fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+
+	return nil
+}
+
+// ExportItemsToFile writes the same prompt and items as the clipboard export
+// to a temp file and returns the path, for exports too large to paste.
 func (m *Model) ExportItemsToFile() (string, error) {
 	jsonData, err := m.ExportItemsToJSON()
 	if err != nil {
@@ -130,14 +303,14 @@ func (m *Model) ExportItemsToFile() (string, error) {
 	}
 
 	tmpDir := os.TempDir()
-	tmpFile := filepath.Join(tmpDir, "readwise-export.json")
+	tmpFile := filepath.Join(tmpDir, "readwise-export.md")
 
 	counter := 1
 	for {
 		if _, err := os.Stat(tmpFile); os.IsNotExist(err) {
 			break
 		}
-		tmpFile = filepath.Join(tmpDir, fmt.Sprintf("readwise-export-%d.json", counter))
+		tmpFile = filepath.Join(tmpDir, fmt.Sprintf("readwise-export-%d.md", counter))
 		counter++
 	}
 
@@ -162,22 +335,28 @@ func (m *Model) ImportTriageResultsFromClipboard() (int, error) {
 	return m.ImportTriageResults(data)
 }
 
-// ImportTriageResultsFromFile reads from a file and imports triage results
-func (m *Model) ImportTriageResultsFromFile(filePath string) (int, error) {
-	data, err := os.ReadFile(filePath)
+// ImportTriageResults parses and validates triage results JSON and applies to items
+func (m *Model) ImportTriageResults(jsonData string) (int, error) {
+	results, err := parseImportResults(jsonData)
 	if err != nil {
-		return 0, fmt.Errorf("failed to read file: %w", err)
+		return 0, err
 	}
 
-	return m.ImportTriageResults(string(data))
+	applied, errors, matches, unmatched := m.applyImportResults(results, 0)
+	m.pendingImport = unmatched
+	if err := m.finishImport(applied, len(results), errors, matches); err != nil {
+		return 0, err
+	}
+	return applied, nil
 }
 
-// ImportTriageResults parses and validates triage results JSON and applies to items
-func (m *Model) ImportTriageResults(jsonData string) (int, error) {
+// parseImportResults extracts the JSON array of results from pasted or
+// file content, sanitizing common LLM JSON mistakes if it doesn't parse.
+func parseImportResults(jsonData string) ([]triage.Result, error) {
 	// Extract JSON array from the content (handle markdown code blocks)
 	jsonStr := extractJSONArray(jsonData)
 	if jsonStr == "" {
-		return 0, fmt.Errorf("no valid JSON array found in input")
+		return nil, fmt.Errorf("no valid JSON array found in input")
 	}
 
 	var results []triage.Result
@@ -196,19 +375,82 @@ func (m *Model) ImportTriageResults(jsonData string) (int, error) {
 				if end > len(jsonStr) {
 					end = len(jsonStr)
 				}
-				return 0, fmt.Errorf("failed to parse JSON at offset %d: %w\nContext: ...%s...", pos, err, jsonStr[start:end])
+				return nil, fmt.Errorf("failed to parse JSON at offset %d: %w\nContext: ...%s...", pos, err, jsonStr[start:end])
 			}
-			return 0, fmt.Errorf("failed to parse JSON: %w", err)
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
 		}
 	}
 
 	if len(results) == 0 {
-		return 0, fmt.Errorf("empty results array")
+		return nil, fmt.Errorf("empty results array")
+	}
+	return results, nil
+}
+
+// finishImport sets the import summary for total results of which applied
+// were applied, and refreshes the list. It fails when nothing applied and
+// there were validation errors.
+func (m *Model) finishImport(applied, total int, errors, matches []string) error {
+	if applied == 0 && len(errors) > 0 {
+		return fmt.Errorf("validation failed:\n%s%s", strings.Join(errors, "\n"), m.retryImportHint())
+	}
+
+	if len(errors) > 0 {
+		m.statusMessage = fmt.Sprintf("Applied %d/%d results. Warnings:\n%s%s", applied, total, strings.Join(append(errors, matches...), "\n"), m.retryImportHint())
+	} else {
+		m.statusMessage = fmt.Sprintf("Successfully applied triage results to %s items", formatCount(applied))
+		if len(matches) > 0 {
+			m.statusMessage += "\n" + strings.Join(matches, "\n")
+		}
+	}
+
+	m.listView.SetItems(m.items)
+	m.sortUncertainFirst()
+	return nil
+}
+
+// retryImportHint tells the user how to apply results whose items weren't loaded.
+func (m *Model) retryImportHint() string {
+	if len(m.pendingImport) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("\nFetch more items, then press I to retry the %d unmatched results", len(m.pendingImport))
+}
+
+// RetryImport re-applies the imported results whose items were not found,
+// e.g. after fetching more. Results that still don't match stay pending.
+func (m *Model) RetryImport() (int, error) {
+	if len(m.pendingImport) == 0 {
+		return 0, fmt.Errorf("no unmatched import results to retry")
+	}
+
+	total := len(m.pendingImport)
+	applied, _, matches, unmatched := m.applyImportResults(m.pendingImport, 0)
+	m.pendingImport = unmatched
+
+	m.statusMessage = fmt.Sprintf("Applied %d of %d unmatched import results", applied, total)
+	if len(unmatched) > 0 {
+		m.statusMessage += fmt.Sprintf("; %d still not found", len(unmatched))
+	}
+	if len(matches) > 0 {
+		m.statusMessage += "\n" + strings.Join(matches, "\n")
 	}
 
-	// Validate and apply results
+	m.listView.SetItems(m.items)
+	m.sortUncertainFirst()
+
+	return applied, nil
+}
+
+// applyImportResults validates each result and applies it to its item. It
+// returns the number applied, validation errors, notes on fallback matches,
+// and the valid results whose item isn't loaded, which can be retried later.
+// Messages number results from first, so chunks of one import line up.
+func (m *Model) applyImportResults(results []triage.Result, first int) (int, []string, []string, []triage.Result) {
 	applied := 0
 	errors := []string{}
+	matches := []string{} // results matched by a fallback strategy
+	var unmatched []triage.Result
 
 	// Create a map for quick lookup
 	itemMap := make(map[string]*Item)
@@ -216,7 +458,8 @@ func (m *Model) ImportTriageResults(jsonData string) (int, error) {
 		itemMap[m.items[i].ID] = &m.items[i]
 	}
 
-	for i, result := range results {
+	for n, result := range results {
+		i := first + n
 		// Validate required fields
 		if result.ID == "" {
 			errors = append(errors, fmt.Sprintf("result %d: missing id", i))
@@ -245,11 +488,18 @@ func (m *Model) ImportTriageResults(jsonData string) (int, error) {
 			continue
 		}
 
-		// Find and update the item
+		// Find and update the item, falling back to looser matches when an
+		// LLM has mangled the ID
 		item, ok := itemMap[result.ID]
 		if !ok {
-			errors = append(errors, fmt.Sprintf("result %d: id '%s' not found in items", i, result.ID))
-			continue
+			var strategy string
+			item, strategy = m.matchImportResult(result)
+			if item == nil {
+				errors = append(errors, fmt.Sprintf("result %d: id '%s' not found in items", i, result.ID))
+				unmatched = append(unmatched, result)
+				continue
+			}
+			matches = append(matches, fmt.Sprintf("result %d: id '%s' matched %s by %s", i, result.ID, item.ID, strategy))
 		}
 
 		// Apply the triage decision
@@ -267,27 +517,53 @@ func (m *Model) ImportTriageResults(jsonData string) (int, error) {
 			item.Tags = filtered
 		}
 
-		// Save to triage store
-		if m.triageStore != nil {
-			m.triageStore.SetItem(item.ID, item.Action, item.Priority, "llm", item.Tags, &result)
-		}
+		// Save to triage store with the full report, same as auto-triage
+		m.saveLLMTriage(item.ID, item.Action, item.Priority, item.Tags, &result)
 
 		applied++
 	}
 
-	if applied == 0 && len(errors) > 0 {
-		return 0, fmt.Errorf("validation failed:\n%s", strings.Join(errors, "\n"))
-	}
+	return applied, errors, matches, unmatched
+}
 
-	if len(errors) > 0 {
-		m.statusMessage = fmt.Sprintf("Applied %d/%d results. Warnings:\n%s", applied, len(results), strings.Join(errors, "\n"))
-	} else {
-		m.statusMessage = fmt.Sprintf("Successfully applied triage results to %d items", applied)
+// matchImportResult finds the item for a result whose ID has no exact match,
+// trying a trimmed case-insensitive ID, then the URL, then the title. Each
+// fallback only counts when it picks out a single item. It returns the item
+// and the strategy that matched, or nil.
+func (m *Model) matchImportResult(result triage.Result) (*Item, string) {
+	id := strings.TrimSpace(result.ID)
+	strategies := []struct {
+		name  string
+		match func(item *Item) bool
+	}{
+		{"trimmed id", func(item *Item) bool { return id != "" && strings.EqualFold(item.ID, id) }},
+		{"url", func(item *Item) bool {
+			return result.URL != "" && item.URL != "" && normalizeURL(item.URL) == normalizeURL(result.URL)
+		}},
+		{"title", func(item *Item) bool {
+			return normalizeTitle(result.Title) != "" && normalizeTitle(item.Title) == normalizeTitle(result.Title)
+		}},
+	}
+
+	for _, s := range strategies {
+		var found *Item
+		count := 0
+		for i := range m.items {
+			if s.match(&m.items[i]) {
+				found = &m.items[i]
+				count++
+			}
+		}
+		if count == 1 {
+			return found, s.name
+		}
 	}
+	return nil, ""
+}
 
-	m.listView.SetItems(m.items)
-
-	return applied, nil
+// normalizeTitle lowercases a title and collapses its whitespace.
+func normalizeTitle(title string) string {
+	return strings.Join(strings.Fields(strings.ToLower(title)), " ")
 }
 
 func extractJSONArray(content string) string {
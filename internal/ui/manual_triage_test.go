@@ -2,7 +2,13 @@ package ui
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/triage"
 )
 
 func TestExtractJSONArray(t *testing.T) {
@@ -154,6 +160,245 @@ func TestExportItemsToJSON(t *testing.T) {
 	}
 }
 
+func TestExportItemsToJSONMaxItems(t *testing.T) {
+	exportedIDs := func(t *testing.T, export string) []string {
+		t.Helper()
+		var items []map[string]interface{}
+		if err := json.Unmarshal([]byte(extractJSONArray(export)), &items); err != nil {
+			t.Fatalf("failed to parse exported JSON: %v", err)
+		}
+		var ids []string
+		for _, item := range items {
+			ids = append(ids, item["id"].(string))
+		}
+		return ids
+	}
+
+	m := newTestModel()
+	m.cfg.ExportMaxItems = 2
+	for i := 1; i <= 5; i++ {
+		m.items = append(m.items, Item{ID: fmt.Sprintf("%d", i), Title: fmt.Sprintf("Item %d", i)})
+	}
+	m.listView.SetItems(m.items)
+
+	export, err := m.ExportItemsToJSON()
+	if err != nil {
+		t.Fatalf("ExportItemsToJSON() unexpected error: %v", err)
+	}
+	if ids := exportedIDs(t, export); !equalStrings(ids, []string{"1", "2"}) {
+		t.Errorf("exported %v, want the first 2 items", ids)
+	}
+	if !strings.Contains(export, "partial batch: 2 of 5 untriaged items") {
+		t.Error("expected the prompt to say the batch is partial")
+	}
+	if !strings.Contains(m.exportWarning, "first 2 of 5") {
+		t.Errorf("expected a warning, got %q", m.exportWarning)
+	}
+	if got := m.withExportWarning("Exported."); !strings.Contains(got, "Exported. ⚠️ Exported the first 2 of 5") {
+		t.Errorf("expected warning appended to the status, got %q", got)
+	}
+
+	// A selection is exported exactly, ignoring the cap
+	for i := 0; i < 3; i++ {
+		m.listView.SetCursor(i)
+		m.listView.ToggleSelection()
+	}
+	export, err = m.ExportItemsToJSON()
+	if err != nil {
+		t.Fatalf("ExportItemsToJSON() unexpected error: %v", err)
+	}
+	if ids := exportedIDs(t, export); len(ids) != 3 {
+		t.Errorf("exported %v, want the 3 selected items", ids)
+	}
+	if m.exportWarning != "" || strings.Contains(export, "partial batch") {
+		t.Errorf("expected no cap on a selection, got warning %q", m.exportWarning)
+	}
+}
+
+func TestExportStarredUntriaged(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Standout"}, {ID: "2", Title: "Other"}}})
+
+	// A star is not a decision, so the item is still exported for triage
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	if !m.items[0].Starred {
+		t.Fatal("expected * to star the focused item")
+	}
+	export, err := m.ExportItemsToJSON()
+	if err != nil {
+		t.Fatalf("ExportItemsToJSON() unexpected error: %v", err)
+	}
+	var items []map[string]interface{}
+	if err := json.Unmarshal([]byte(extractJSONArray(export)), &items); err != nil {
+		t.Fatalf("failed to parse exported JSON: %v", err)
+	}
+	if len(items) != 2 || items[0]["id"] != "1" {
+		t.Errorf("expected both items exported, got %v", items)
+	}
+}
+
+func TestExportPromptLang(t *testing.T) {
+	m := newTestModel()
+	m.cfg.PromptLang = "zh"
+	m.items = []Item{{ID: "1", Title: "Item 1"}, {ID: "2", Title: "Item 2"}}
+	m.listView.SetItems(m.items)
+
+	export, err := m.ExportItemsToJSON()
+	if err != nil {
+		t.Fatalf("ExportItemsToJSON() unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(export, "你是我的私人阅读助理") || !strings.Contains(export, "**待处理的 inbox 条目：**") {
+		t.Errorf("expected the Chinese prompt, got:\n%s", export)
+	}
+	if strings.Contains(export, "**Inbox items to process:**") {
+		t.Error("expected no English marker in a zh export")
+	}
+
+	var items []map[string]any
+	if err := json.Unmarshal([]byte(extractJSONArray(export)), &items); err != nil {
+		t.Fatalf("failed to parse exported JSON: %v", err)
+	}
+	if len(items) != 2 || items[0]["id"] != "1" {
+		t.Errorf("exported items = %v, want items 1 and 2", items)
+	}
+}
+
+func TestExportFocusedItemToJSON(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{
+		{ID: "1", Title: "Item 1"},
+		{ID: "2", Title: "Item 2", Action: "archive", URL: "https://example.com/2", WordCount: 300},
+		{ID: "3", Title: "Item 3"},
+	}
+	m.listView.SetItems(m.items)
+	m.triageStore.SetItem("2", "archive", "", "manual", nil, nil)
+	// Another item is selected; the focused one is exported anyway, triaged or not
+	m.listView.MoveCursor(2)
+	m.listView.ToggleSelection()
+	m.listView.MoveCursor(-1)
+
+	export, err := m.ExportFocusedItemToJSON()
+	if err != nil {
+		t.Fatalf("ExportFocusedItemToJSON() unexpected error: %v", err)
+	}
+	if !strings.Contains(export, "**Inbox items to process:**") {
+		t.Error("expected the export prompt")
+	}
+	var items []map[string]any
+	if err := json.Unmarshal([]byte(extractJSONArray(export)), &items); err != nil {
+		t.Fatalf("failed to parse exported JSON: %v", err)
+	}
+	if len(items) != 1 || items[0]["id"] != "2" || items[0]["url"] != "https://example.com/2" || items[0]["word_count"] != float64(300) {
+		t.Errorf("exported items = %v, want just item 2", items)
+	}
+
+	m.cfg.ExportRawJSON = true
+	export, _ = m.ExportFocusedItemToJSON()
+	if err := json.Unmarshal([]byte(export), &items); err != nil || len(items) != 1 || items[0]["id"] != "2" {
+		t.Errorf("expected a raw one-item array, got %q (%v)", export, err)
+	}
+
+	m.items = nil
+	m.listView.SetItems(m.items)
+	if _, err := m.ExportFocusedItemToJSON(); err == nil {
+		t.Error("expected an error without a focused item")
+	}
+}
+
+func TestExportRawJSON(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{{ID: "1", Title: "Item 1"}, {ID: "2", Title: "Item 2", Action: "later"}}
+	m.listView.SetItems(m.items)
+
+	// The default keeps the prompt around the JSON
+	export, err := m.ExportItemsToJSON()
+	if err != nil {
+		t.Fatalf("ExportItemsToJSON() unexpected error: %v", err)
+	}
+	if !strings.Contains(export, "**Inbox items to process:**") || triage.IsJSONArray(export) {
+		t.Errorf("expected the prompt-wrapped export by default, got:\n%s", export)
+	}
+
+	m.cfg.ExportRawJSON = true
+	for name, export := range map[string]func() (string, error){
+		"ExportItemsToJSON":      m.ExportItemsToJSON,
+		"ExportAllWithDecisions": m.ExportAllWithDecisions,
+	} {
+		data, err := export()
+		if err != nil {
+			t.Fatalf("%s() unexpected error: %v", name, err)
+		}
+		if !triage.IsJSONArray(data) || strings.Contains(data, "**") {
+			t.Errorf("%s() with export_raw_json = %q, want a bare JSON array", name, data)
+		}
+		var items []map[string]any
+		if err := json.Unmarshal([]byte(data), &items); err != nil || len(items) == 0 {
+			t.Errorf("%s() raw export does not parse: %v", name, err)
+		}
+	}
+}
+
+func TestExportAllWithDecisions(t *testing.T) {
+	store := config.NewMemTriageStore()
+	store.SetItem("123", "later", "low", "llm", []string{"go"}, &triage.Result{
+		ID:             "123",
+		TriageDecision: triage.TriageDecision{Action: "later", Priority: "low", Reason: "Long but useful"},
+	})
+	m := &Model{
+		triageStore: store,
+		items: []Item{
+			{ID: "123", Title: "Triaged", Action: "later", Priority: "low", Tags: []string{"go"}},
+			{ID: "456", Title: "Untriaged"},
+			{ID: "789", Title: "Snoozed", Action: "snooze"},
+		},
+	}
+
+	// The existing export still skips triaged items
+	if data, err := m.ExportItemsToJSON(); err != nil || strings.Contains(data, `"Triaged"`) {
+		t.Errorf("expected ExportItemsToJSON to skip triaged items, err %v", err)
+	}
+
+	exportData, err := m.ExportAllWithDecisions()
+	if err != nil {
+		t.Fatalf("ExportAllWithDecisions() unexpected error: %v", err)
+	}
+	if !strings.Contains(exportData, "current_decision") || !strings.Contains(exportData, "Return every item") {
+		t.Error("expected the prompt to explain current decisions")
+	}
+
+	var items []struct {
+		ID              string `json:"id"`
+		CurrentDecision *struct {
+			Action   string   `json:"action"`
+			Priority string   `json:"priority"`
+			Tags     []string `json:"tags"`
+			Reason   string   `json:"reason"`
+		} `json:"current_decision"`
+	}
+	if err := json.Unmarshal([]byte(extractJSONArray(exportData)), &items); err != nil {
+		t.Fatalf("failed to parse exported JSON: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items (snoozed excluded), got %d", len(items))
+	}
+	d := items[0].CurrentDecision
+	if items[0].ID != "123" || d == nil || d.Action != "later" || d.Priority != "low" || !equalStrings(d.Tags, []string{"go"}) {
+		t.Errorf("expected the triaged item with its decision, got %+v", items[0])
+	}
+	if d != nil && d.Reason != "Long but useful" {
+		t.Errorf("expected the stored reason, got %q", d.Reason)
+	}
+	if items[1].ID != "456" || items[1].CurrentDecision != nil {
+		t.Errorf("expected the untriaged item without a decision, got %+v", items[1])
+	}
+
+	// Only an empty list is an error
+	m.items = nil
+	if _, err := m.ExportAllWithDecisions(); err == nil {
+		t.Error("expected an error with no items")
+	}
+}
+
 func TestImportTriageResults_WithDelete(t *testing.T) {
 	m := &Model{
 		items: []Item{
@@ -187,6 +432,75 @@ func TestImportTriageResults_WithDelete(t *testing.T) {
 	}
 }
 
+func TestRetryImport(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Item 1"}}})
+
+	jsonData := `[
+		{"id": "1", "title": "Item 1", "triage_decision": {"action": "later"}},
+		{"id": "2", "title": "Item 2", "triage_decision": {"action": "archive", "priority": "low"}},
+		{"id": "3", "title": "Item 3", "triage_decision": {"action": "bogus"}}
+	]`
+	applied, err := m.ImportTriageResults(jsonData)
+	if err != nil || applied != 1 {
+		t.Fatalf("ImportTriageResults() = %d, %v; want 1 applied", applied, err)
+	}
+	if len(m.pendingImport) != 1 || m.pendingImport[0].ID != "2" {
+		t.Fatalf("expected only the unmatched result kept for retry, got %+v", m.pendingImport)
+	}
+	if !strings.Contains(m.statusMessage, "press I to retry the 1 unmatched results") {
+		t.Errorf("expected a retry hint, got %q", m.statusMessage)
+	}
+
+	// Fetching more brings in item 2; I applies the pending result
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Item 1", Action: "later"}, {ID: "2", Title: "Item 2"}}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'I'}})
+	if m.state != StateMessage || m.messageType != "success" {
+		t.Fatalf("expected a success message, got %v %q", m.state, m.messageType)
+	}
+	if !strings.Contains(m.statusMessage, "Applied 1 of 1 unmatched import results") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+	if m.items[1].Action != "archive" || m.items[1].Priority != "low" {
+		t.Errorf("expected item 2 triaged by the retry, got %+v", m.items[1])
+	}
+	if entry, ok := m.triageStore.GetItem("2"); !ok || entry.Source != "llm" || entry.Report == nil {
+		t.Errorf("expected the retried result saved with its report, got %+v", entry)
+	}
+	if len(m.pendingImport) != 0 {
+		t.Errorf("expected nothing left to retry, got %+v", m.pendingImport)
+	}
+
+	// Nothing left to retry
+	m.state = StateReviewing
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'I'}})
+	if m.messageType != "error" || !strings.Contains(m.statusMessage, "no unmatched import results") {
+		t.Errorf("expected an error with nothing pending, got %q", m.statusMessage)
+	}
+}
+
+func TestRetryImportStillMissing(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{{ID: "1", Title: "Item 1"}}
+	m.listView.SetItems(m.items)
+	m.pendingImport = []triage.Result{
+		{ID: "2", Title: "Item 2", TriageDecision: triage.TriageDecision{Action: "archive"}},
+		{ID: "3", Title: "Item 3", TriageDecision: triage.TriageDecision{Action: "later"}},
+	}
+	m.items = append(m.items, Item{ID: "3", Title: "Item 3"})
+
+	applied, err := m.RetryImport()
+	if err != nil || applied != 1 {
+		t.Fatalf("RetryImport() = %d, %v; want 1 applied", applied, err)
+	}
+	if !strings.Contains(m.statusMessage, "1 still not found") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+	if len(m.pendingImport) != 1 || m.pendingImport[0].ID != "2" {
+		t.Errorf("expected item 2 still pending, got %+v", m.pendingImport)
+	}
+}
+
 func TestImportTriageResults_MissingTitle(t *testing.T) {
 	m := &Model{
 		items: []Item{
@@ -490,3 +804,108 @@ func TestImportTriageResults_InvalidAction(t *testing.T) {
 		t.Errorf("expected 0 items applied, got %d", applied)
 	}
 }
+
+func TestImportTriageResults_PersistsReport(t *testing.T) {
+	store := config.NewMemTriageStore()
+
+	m := &Model{
+		items:       []Item{{ID: "1", Title: "Rich Article"}},
+		triageStore: store,
+	}
+	m.listView = NewListView(80, 20)
+	m.listView.SetItems(m.items)
+
+	jsonData := `[
+		{
+			"id": "1",
+			"title": "Rich Article",
+			"triage_decision": {
+				"action": "later",
+				"priority": "medium",
+				"reason": "Deep dive worth a focused session"
+			},
+			"content_analysis": {
+				"type": "analysis",
+				"key_topics": ["databases"]
+			},
+			"metadata_enhancement": {
+				"suggested_tags": ["sqlite", "later"]
+			}
+		}
+	]`
+
+	if _, err := m.ImportTriageResults(jsonData); err != nil {
+		t.Fatalf("ImportTriageResults failed: %v", err)
+	}
+
+	if len(m.items[0].Tags) != 1 || m.items[0].Tags[0] != "sqlite" {
+		t.Errorf("expected tags [sqlite], got %v", m.items[0].Tags)
+	}
+
+	entry, ok := store.GetItem("1")
+	if !ok {
+		t.Fatal("expected imported item in store")
+	}
+	if entry.Source != "llm" {
+		t.Errorf("expected source 'llm', got %q", entry.Source)
+	}
+	if len(entry.Tags) != 1 || entry.Tags[0] != "sqlite" {
+		t.Errorf("expected stored tags [sqlite], got %v", entry.Tags)
+	}
+	if entry.Report == nil {
+		t.Fatal("expected stored report, got nil")
+	}
+	if entry.Report.TriageDecision.Reason != "Deep dive worth a focused session" {
+		t.Errorf("expected reason preserved, got %q", entry.Report.TriageDecision.Reason)
+	}
+	if entry.Report.ContentAnalysis.Type != "analysis" {
+		t.Errorf("expected content analysis preserved, got %q", entry.Report.ContentAnalysis.Type)
+	}
+}
+
+func TestImportTriageResults_FuzzyMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    string
+		wantIndex int // -1 when nothing should match
+		wantNote  string
+	}{
+		{"trailing whitespace", `{"id": "abc123 ", "title": "Other", "triage_decision": {"action": "archive"}}`, 0, "by trimmed id"},
+		{"case changed", `{"id": "ABC123", "title": "Other", "triage_decision": {"action": "archive"}}`, 0, "by trimmed id"},
+		{"url only", `{"id": "mangled", "title": "Different", "url": "https://www.example.com/post/?utm_source=x", "triage_decision": {"action": "archive"}}`, 1, "by url"},
+		{"title only", `{"id": "mangled", "title": "  third   ARTICLE ", "triage_decision": {"action": "archive"}}`, 2, "by title"},
+		{"ambiguous title", `{"id": "mangled", "title": "Dup", "triage_decision": {"action": "archive"}}`, -1, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Model{
+				items: []Item{
+					{ID: "abc123", Title: "First Article", URL: "https://example.com/first"},
+					{ID: "def456", Title: "Second Article", URL: "https://example.com/post"},
+					{ID: "ghi789", Title: "Third Article", URL: "https://example.com/third"},
+					{ID: "dup-1", Title: "Dup"},
+					{ID: "dup-2", Title: "Dup"},
+				},
+			}
+			m.listView = NewListView(80, 20)
+			m.listView.SetItems(m.items)
+
+			applied, err := m.ImportTriageResults("[" + tt.result + "]")
+			if tt.wantIndex < 0 {
+				if err == nil || applied != 0 {
+					t.Fatalf("expected no match, applied %d, err %v", applied, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ImportTriageResults failed: %v", err)
+			}
+			if m.items[tt.wantIndex].Action != "archive" {
+				t.Errorf("expected item %d to be archived, got %q", tt.wantIndex, m.items[tt.wantIndex].Action)
+			}
+			if !strings.Contains(m.statusMessage, tt.wantNote) {
+				t.Errorf("expected status to note the %q match, got %q", tt.wantNote, m.statusMessage)
+			}
+		})
+	}
+}
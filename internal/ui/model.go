@@ -1,12 +1,17 @@
 package ui
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -19,6 +24,27 @@ import (
 	"github.com/mcao2/readwise-triage/internal/triage"
 )
 
+// snoozeDuration is how long a snoozed item stays hidden from review.
+const snoozeDuration = 7 * 24 * time.Hour
+
+// maxLookbackDays caps the lookback window typed on the config screen.
+const maxLookbackDays = 365
+
+// doubleClickInterval is the maximum gap between two clicks on the same row
+// for them to count as a double-click.
+const doubleClickInterval = 400 * time.Millisecond
+
+// reviewHeaderHeight is the number of lines the review header bar occupies
+// above the list (text + bottom border).
+const reviewHeaderHeight = 2
+
+// finishedProgress is the reading progress above which an item counts as finished.
+const finishedProgress = 0.9
+
+// autoArchiveProgress is the reading progress above which a fetched item
+// without a decision is pre-marked archive.
+const autoArchiveProgress = 0.95
+
 type State int
 
 const (
@@ -30,6 +56,11 @@ const (
 	StateUpdating
 	StateDone
 	StateMessage
+	StateTagReview
+	StatePreview
+	StateExplain
+	StateImporting
+	StateSummary
 )
 
 func (s State) String() string {
@@ -50,6 +81,16 @@ func (s State) String() string {
 		return "Done"
 	case StateMessage:
 		return "Message"
+	case StateTagReview:
+		return "TagReview"
+	case StatePreview:
+		return "Preview"
+	case StateExplain:
+		return "Explain"
+	case StateImporting:
+		return "Importing"
+	case StateSummary:
+		return "Summary"
 	default:
 		return "Unknown"
 	}
@@ -73,53 +114,205 @@ type Model struct {
 	spinner  spinner.Model
 	progress progress.Model
 
-	updateProgress float64
-	statusMessage  string
-	messageType    string
-	batchMode      bool
+	updateProgress   float64
+	updateFailures   []UpdateFailure    // failures from the last update run
+	failureOffset    int                // scroll offset into updateFailures
+	pendingOpen      []string           // URLs awaiting confirmation to open
+	pendingOpenTitle string             // heading for the pendingOpen confirmation
+	pendingArchive   []string           // untriaged item IDs awaiting confirmation to archive
+	pendingQuit      bool               // q was pressed with unpushed decisions; awaiting y/n
+	pendingRefresh   bool               // R was pressed with local edits; awaiting merge or replace
+	refreshEdits     map[string]Item    // items with local edits to re-apply after a merging refresh
+	quitFrom         State              // state to return to if the quit is cancelled
+	unpushed         map[string]bool    // item IDs decided this session and not yet pushed
+	pushed           map[string]bool    // document IDs pushed this session, for the summary
+	pushing          []string           // document IDs sent in the running update
+	exportWarning    string             // set when the last export hit export_max_items
+	pendingImport    []triage.Result    // imported results whose items weren't loaded, retried with I
+	importRun        *importRun         // file import in progress, see ImportTriageResultsFromFile
+	autoPushQueue    []string           // item IDs waiting for auto_push, each at most once
+	autoPushWaiting  bool               // the tick for the next auto-push is scheduled
+	autoPushSending  string             // item whose auto-push request is in flight
+	autoPushErr      string             // last auto-push failure, shown in the header
+	tagReview        []tagSuggestion    // LLM-suggested tags awaiting accept/reject
+	tagReviewCursor  int                // row in the flattened tag review list
+	repeatCount      int                // pending vim-style count prefix in review mode, 0 for none
+	countLead        bool               // # was pressed, so the next digits are a count even if they start with 0-3
+	typeAhead        string             // title prefix typed after ' to jump to an item
+	typeAheadActive  bool               // ' was pressed and letters jump instead of acting
+	typeAheadSeq     int                // bumped per type-ahead key so stale idle ticks are ignored
+	contentCache     map[string]string  // item ID → plain-text content fetched with v, kept for the session
+	previewID        string             // item shown in the content preview
+	previewOffset    int                // first line shown in the content preview
+	explainID        string             // item whose stored LLM reasoning is shown
+	triageCancel     context.CancelFunc // aborts the in-flight LLM request
+	triageStarted    time.Time
+	triageRun        int // identifies the current triage so abandoned results are dropped
+	statusMessage    string
+	messageType      string
+	batchMode        bool
+	demo             bool      // sample data only; never touches Readwise or the config file
+	cfgFallback      bool      // config.Load failed; the defaults in use are never saved over config.yaml
+	skipPushCheck    bool      // push without the token check first; for tests
+	hideFinished     bool      // hide items with reading progress above finishedProgress
+	filterQuery      string    // active filter, see matchesFilter
+	editingFilter    bool      // filter prompt is open
+	filterInput      string    // filter prompt contents while editing
+	lastClickRow     int       // row of the previous left-click, for double-click detection
+	lastClickAt      time.Time // time of the previous left-click
 
 	cfg         *config.Config
-	triageStore *config.TriageStore
-
-	inboxLookback int
-	feedLookback  int
-	fetchLocation string
-	editingDays   bool
-	daysInput     string
-	editingTags   bool
-	tagsInput     string
-	tagsCursor    int
+	triageStore config.TriageStore
+
+	inboxLookback  int
+	feedLookback   int
+	category       string          // Readwise category filter, "" for all
+	lookbacks      map[string]*int // lookback per "location/category" while a category is set
+	fetchLocation  string
+	sinceLast      bool             // fetch items updated since the previous visit instead of the last N days
+	weekWindow     int              // with [ and ], fetch only the Nth most recent week (1 = last 7 days); 0 fetches the last N days
+	nextPage       *readwise.Cursor // where the last fetch stopped at fetch_limit; f continues from it
+	presetName     string           // fetch preset applied with p; its category and tag filter fetches
+	choosingPreset bool             // the preset picker is open on the config screen
+	choosingLLM    bool             // the LLM provider picker is open on the config screen
+	llmInputField  string           // "base_url" or "model" while typing a custom provider
+	llmInput       string
+	llmBaseURL     string // custom provider base URL entered before the model
+	tokenStatus    string // result of the last V token check, shown on the config screen
+	tokenOK        bool
+	lastVisit      map[string]time.Time // per-location last fetch time as of session start
+	resumeSession  *config.Session      // session left by a crashed run, offered on the config screen
+	resumeCursorID string               // item to focus once the resumed session's fetch loads
+	sessionSaved   config.Session       // last session snapshot written
+	editingDays    bool
+	daysInput      string
+	editingTags    bool
+	editingNotes   bool   // the tag editor popup is editing notes instead
+	editingReason  bool   // the tag editor popup is editing the triage reason instead
+	renamingTag    bool   // the tag editor popup is renaming a tag across all items
+	tagsInput      string // text buffer for the tag/notes editor
+	tagsCursor     int
 }
 
 type Item struct {
-	ID           string
-	Title        string
-	Action       string
-	Priority     string
-	URL          string
-	Summary      string
-	Category     string
-	Source       string
-	WordCount    int
-	ReadingTime  string
-	Tags         []string // LLM-suggested tags
-	OriginalTags []string // tags fetched from Readwise (preserved on update)
+	ID             string
+	Title          string
+	Action         string
+	Defaulted      bool // Action was pre-filled from default_action rather than decided
+	Priority       string
+	URL            string
+	ReaderURL      string // Readwise Reader app URL for the document
+	Summary        string
+	Category       string
+	Source         string
+	SiteName       string
+	Author         string
+	WordCount      int
+	ReadingTime    string
+	Notes          string   // document notes, pushed to Readwise on update when non-empty
+	NotesEdited    bool     // notes were changed this session and not pushed yet
+	Progress       float64  // reading progress, 0.0–1.0
+	StartFresh     bool     // reset reading progress to 0 on update
+	Starred        bool     // flagged as a standout with *, independent of the action
+	Reason         string   // why it was triaged this way, entered with y; saved locally, never pushed
+	Tags           []string // LLM-suggested tags
+	OriginalTags   []string // tags fetched from Readwise (preserved on update), minus any priority tag
+	RemotePriority string   // priority from a priority:X tag on Readwise
+	TriagedAt      string   // RFC3339 time of the last saved decision, empty if never triaged
+	CreatedAt      time.Time
+	PublishedAt    time.Time // zero when Readwise has no published date
+	DuplicateIDs   []string  // IDs of same-URL copies collapsed into this item
+	AltAction      string    // secondary LLM's action when comparing providers
+	AltPriority    string
+}
+
+// SourceName returns the site name when Readwise provides one, else the source.
+func (i Item) SourceName() string {
+	if i.SiteName != "" {
+		return i.SiteName
+	}
+	return i.Source
+}
+
+// newItem converts a fetched Readwise document. The priority comes from a
+// priority:X tag, if any; the action is left empty.
+func newItem(doc readwise.Item) Item {
+	priority, tags := splitPriorityTag(doc.Tags)
+	item := Item{
+		ID:             doc.ID,
+		Title:          doc.Title,
+		Priority:       priority,
+		URL:            doc.URL,
+		ReaderURL:      doc.ReaderURL,
+		Summary:        doc.Summary,
+		Category:       doc.Category,
+		Source:         doc.Source,
+		SiteName:       doc.SiteName,
+		Author:         doc.Author,
+		WordCount:      doc.WordCount,
+		ReadingTime:    doc.ReadingTime,
+		Notes:          doc.Notes,
+		Progress:       doc.ReadingProgress,
+		OriginalTags:   tags,
+		RemotePriority: priority,
+		CreatedAt:      doc.CreatedAt.Time,
+	}
+	if doc.PublishedDate != nil {
+		item.PublishedAt = doc.PublishedDate.Time
+	}
+	return item
 }
 
 func NewModel() *Model {
-	cfg, err := config.Load()
-	if err != nil {
+	cfg, cfgErr := config.Load()
+	if cfgErr != nil {
 		cfg = &config.Config{InboxDaysAgo: 7}
 	}
 
-	triageStore, err := config.LoadTriageStore()
-	if err != nil {
-		triageStore = nil // will be nil-checked by callers
+	// A locked or corrupt database falls back to an in-memory store so the
+	// session still works; the warning says decisions won't be kept.
+	var triageStore config.TriageStore
+	store, storeErr := config.LoadTriageStore()
+	if storeErr == nil {
+		triageStore = store
+	} else {
+		triageStore = config.NewMemTriageStore()
+	}
+
+	m := newModel(cfg, triageStore)
+	m.cfgFallback = cfgErr != nil
+
+	// A session file left behind means the last run didn't exit cleanly
+	if session, err := config.LoadSession(); err == nil && session != nil {
+		m.resumeSession = session
+	}
+
+	// Surface config errors (e.g. unknown profile) on the config screen
+	var warnings []string
+	if cfgErr != nil {
+		warnings = append(warnings, cfgErr.Error())
 	}
+	if storeErr != nil {
+		warnings = append(warnings, storeWarning(storeErr))
+	}
+	m.statusMessage = strings.Join(warnings, "; ")
+	return m
+}
+
+// storeWarning explains that triage decisions won't persist this session.
+func storeWarning(err error) string {
+	return fmt.Sprintf("⚠️ Triage history unavailable, decisions won't be saved (%v). Close other readwise-triage instances or move the file aside.", err)
+}
 
+func newModel(cfg *config.Config, triageStore config.TriageStore) *Model {
 	themeNames := GetThemeNames()
 	themeIndex := -1
 	themeName := cfg.Theme
+	// A valid READWISE_TRIAGE_THEME wins for this session only; unknown
+	// names are ignored.
+	if _, ok := Themes[cfg.ThemeOverride]; ok {
+		themeName = cfg.ThemeOverride
+	}
 
 	for i, name := range themeNames {
 		if name == themeName {
@@ -172,47 +365,131 @@ func NewModel() *Model {
 		inboxLookback: cfg.InboxDaysAgo,
 		feedLookback:  cfg.FeedDaysAgo,
 		fetchLocation: "new",
+		category:      cfg.Category,
+		lookbacks:     make(map[string]*int, len(cfg.Lookbacks)),
+		lastVisit:     make(map[string]time.Time, len(cfg.LastFetchAt)),
+	}
+	for key, days := range cfg.Lookbacks {
+		m.lookbacks[key] = &days
+	}
+	// Snapshot last-fetch times so refreshes this session keep the same baseline
+	for loc, at := range cfg.LastFetchAt {
+		m.lastVisit[loc] = at
 	}
 
 	// Restore last-used location from config
 	if cfg.Location == "feed" {
 		m.fetchLocation = "feed"
 	}
-	m.listView = NewListView(80, 24)
+
+	// Lay out for the last known terminal size so the first frame doesn't
+	// reflow when the real WindowSizeMsg arrives
+	width, height := 80, 24
+	if cfg.WindowWidth > 0 && cfg.WindowHeight > 0 {
+		width, height = cfg.WindowWidth, cfg.WindowHeight
+		m.width, m.height = width, height
+		m.progress.Width = width - 8
+	}
+	m.listView = NewListView(width, height)
+	m.listView.SetCompact(cfg.Compact)
+	m.listView.SetCleanTitles(cfg.CleanTitles)
+	m.listView.SetShowAuthor(cfg.ShowAuthor)
+	m.listView.SetStaleAfter(cfg.StaleAfter())
+	m.listView.SetFilter(m.itemVisible)
 	m.listView.UpdateTableStyles(Themes[themeName])
 	return m
 }
 
-func (m *Model) activeLookback() int {
-	if m.fetchLocation == "feed" {
-		return m.feedLookback
-	}
-	return m.inboxLookback
+// lookbackKey identifies the lookback remembered for the current location
+// and category, e.g. "feed/video".
+func (m *Model) lookbackKey() string {
+	return m.fetchLocation + "/" + m.category
 }
 
-func (m *Model) activeLookbackPtr() *int {
+// locationLookback is the lookback for the current location without a
+// category filter.
+func (m *Model) locationLookback() *int {
 	if m.fetchLocation == "feed" {
 		return &m.feedLookback
 	}
 	return &m.inboxLookback
 }
 
+// activeLookback returns the lookback for the current location and category.
+// A category without a remembered value uses the location's lookback.
+func (m *Model) activeLookback() int {
+	if m.category != "" {
+		if days, ok := m.lookbacks[m.lookbackKey()]; ok {
+			return *days
+		}
+	}
+	return *m.locationLookback()
+}
+
+// activeLookbackPtr returns the lookback to change for the current location
+// and category, starting a category from the location's value.
+func (m *Model) activeLookbackPtr() *int {
+	if m.category == "" {
+		return m.locationLookback()
+	}
+	key := m.lookbackKey()
+	if _, ok := m.lookbacks[key]; !ok {
+		days := *m.locationLookback()
+		m.lookbacks[key] = &days
+	}
+	return m.lookbacks[key]
+}
+
 func (m *Model) saveLookback() {
 	if m.cfg != nil {
-		if m.fetchLocation == "feed" {
+		switch {
+		case m.category != "":
+			if m.cfg.Lookbacks == nil {
+				m.cfg.Lookbacks = make(map[string]int)
+			}
+			m.cfg.Lookbacks[m.lookbackKey()] = m.activeLookback()
+		case m.fetchLocation == "feed":
 			m.cfg.FeedDaysAgo = m.feedLookback
-		} else {
+		default:
 			m.cfg.InboxDaysAgo = m.inboxLookback
 		}
-		_ = m.cfg.Save()
+		m.saveConfig()
+	}
+}
+
+// fetchCategories are the Readwise document categories cycled with c.
+var fetchCategories = []string{"", "article", "email", "rss", "pdf", "epub", "tweet", "video"}
+
+// cycleCategory moves to the next category filter and saves it.
+func (m *Model) cycleCategory() {
+	next := 0
+	for i, c := range fetchCategories {
+		if c == m.category {
+			next = (i + 1) % len(fetchCategories)
+			break
+		}
+	}
+	m.category = fetchCategories[next]
+	if m.cfg != nil {
+		m.cfg.Category = m.category
+		m.saveConfig()
 	}
 }
 
 func (m *Model) saveLocation() {
 	if m.cfg != nil {
 		m.cfg.Location = m.fetchLocation
-		_ = m.cfg.Save()
+		m.saveConfig()
+	}
+}
+
+// saveConfig persists preferences to config.yaml, except in demo mode or
+// when the file couldn't be loaded and the defaults would replace it.
+func (m *Model) saveConfig() {
+	if m.demo || m.cfgFallback {
+		return
 	}
+	_ = m.cfg.Save()
 }
 
 func (m *Model) cycleTheme() {
@@ -225,12 +502,12 @@ func (m *Model) cycleTheme() {
 
 	if m.cfg != nil {
 		m.cfg.Theme = newTheme
-		_ = m.cfg.Save()
+		m.saveConfig()
 	}
 }
 
 func (m *Model) Init() tea.Cmd {
-	return m.spinner.Tick
+	return tea.Batch(m.spinner.Tick, m.sessionSaveTick())
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -254,6 +531,12 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
+	case tea.MouseMsg:
+		if m.state == StateReviewing && !m.editingText() {
+			m.handleReviewingMouse(msg)
+		}
+		return m, nil
+
 	case StateChangeMsg:
 		m.state = msg.State
 
@@ -261,38 +544,135 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateProgress = msg.Progress
 		m.statusMessage = msg.Message
 		cmd := m.progress.SetPercent(msg.Progress)
-		return m, tea.Batch(cmd, m.waitForUpdateProgress(msg.Channel, msg.Success, msg.Failed))
+		return m, tea.Batch(cmd, m.waitForUpdateProgress(msg.Channel, msg.Success, msg.Failed, msg.Failures, msg.Gone))
 
 	case ItemsLoadedMsg:
 		m.items = msg.Items
+		m.nextPage = msg.Next
 		m.applySavedTriages()
+		m.reapplyLocalEdits()
+		autoArchived := m.archiveRead()
+		shortArchived := m.archiveShort()
 		m.listView.SetItems(m.items)
+		m.restoreSessionCursor()
 		locationLabel := "inbox"
 		if m.fetchLocation == "feed" {
 			locationLabel = "feed"
 		}
-		m.statusMessage = fmt.Sprintf("Loaded %d %s items from the last %d days", len(m.items), locationLabel, m.activeLookback())
+		if !msg.Until.IsZero() {
+			m.statusMessage = fmt.Sprintf("Loaded %s %s items from %s ([ earlier, ] later)", formatCount(len(m.items)), locationLabel, formatWindow(msg.Since, msg.Until))
+		} else if !msg.Since.IsZero() {
+			m.statusMessage = fmt.Sprintf("Loaded %s new %s items since %s", formatCount(len(m.items)), locationLabel, msg.Since.Local().Format("Jan 2 15:04"))
+		} else {
+			m.statusMessage = fmt.Sprintf("Loaded %s %s items from the last %d days", formatCount(len(m.items)), locationLabel, m.activeLookback())
+		}
+		if category := m.fetchCategory(); category != "" {
+			m.statusMessage += fmt.Sprintf(" in category %q", category)
+		}
+		if tag := m.fetchTag(); tag != "" {
+			m.statusMessage += fmt.Sprintf(" tagged %q", tag)
+		}
+		if limit := m.fetchLimit(); limit > 0 && msg.Fetched >= limit {
+			if m.nextPage != nil {
+				m.statusMessage += fmt.Sprintf(" (limit %d reached, f for the next page)", limit)
+			} else {
+				m.statusMessage += fmt.Sprintf(" (limit %d reached, L on the config screen to change)", limit)
+			}
+		}
+		if autoArchived > 0 {
+			m.statusMessage += fmt.Sprintf("; %d already read, marked archive", autoArchived)
+		}
+		if shortArchived > 0 {
+			m.statusMessage += fmt.Sprintf("; %d under %d words, marked archive", shortArchived, m.cfg.AutoArchiveUnderWords)
+		}
+		m.recordFetch(msg.Location, msg.FetchedAt)
 		m.state = StateReviewing
 
 	case UpdateFinishedMsg:
-		m.statusMessage = fmt.Sprintf("Successfully updated %d items (%d failed)", msg.Success, msg.Failed)
+		m.markPushed(msg.Failures)
+		m.removeGoneItems(msg.Gone)
+		m.statusMessage = fmt.Sprintf("Successfully updated %s items (%s failed)", formatCount(msg.Success), formatCount(msg.Failed))
+		if len(msg.Gone) > 0 {
+			m.statusMessage += fmt.Sprintf("; %s already deleted in Readwise, removed from the list", formatCount(len(msg.Gone)))
+		}
+		m.updateFailures = msg.Failures
+		m.failureOffset = 0
 		m.state = StateDone
 
+	case autoPushTickMsg:
+		return m, m.sendAutoPush()
+
+	case sessionSaveMsg:
+		m.saveSession()
+		return m, m.sessionSaveTick()
+
+	case typeAheadIdleMsg:
+		m.handleTypeAheadIdle(msg)
+
+	case AutoPushedMsg:
+		return m, m.handleAutoPushed(msg)
+
+	case PageLoadedMsg:
+		m.handlePageLoaded(msg)
+
+	case ItemRefreshedMsg:
+		m.handleItemRefreshed(msg)
+
+	case ImportProgressMsg:
+		return m, m.handleImportProgress(msg)
+
+	case PushCheckedMsg:
+		return m, m.handlePushChecked(msg)
+
+	case ContentLoadedMsg:
+		m.handleContentLoaded(msg)
+
+	case TokenVerifiedMsg:
+		m.tokenOK = msg.Valid && msg.Err == nil
+		switch {
+		case msg.Err != nil:
+			m.tokenStatus = fmt.Sprintf("could not verify token: %v", msg.Err)
+		case msg.Valid:
+			m.tokenStatus = "token valid"
+		default:
+			m.tokenStatus = "token invalid"
+		}
+
 	case ErrorMsg:
 		m.statusMessage = msg.Error.Error()
 		m.state = StateConfig
 
 	case TriageFinishedMsg:
+		if msg.run != m.triageRun {
+			return m, nil // canceled with esc; drop the late result
+		}
+		if m.triageCancel != nil {
+			m.triageCancel()
+			m.triageCancel = nil
+		}
 		if msg.Err != nil {
 			m.statusMessage = fmt.Sprintf("LLM triage failed: %v", msg.Err)
+			if advice := triage.ErrorAdvice(msg.Err); advice != "" {
+				m.statusMessage = fmt.Sprintf("LLM triage failed: %s (%v)", advice, msg.Err)
+			}
 			m.messageType = "error"
 			m.state = StateMessage
 			return m, nil
 		}
 		applied := m.applyTriageResults(msg.Results)
-		m.statusMessage = fmt.Sprintf("LLM auto-triaged %d items", applied)
+		m.statusMessage = fmt.Sprintf("LLM auto-triaged %s items", formatCount(applied))
+		if msg.Alt != nil || msg.AltErr != nil {
+			compared, disagree := m.applyAltResults(msg.Alt)
+			m.statusMessage += fmt.Sprintf("; secondary LLM decided %d (%d disagree)", compared, disagree)
+			if msg.AltErr != nil {
+				m.statusMessage += fmt.Sprintf("; %v", msg.AltErr)
+			}
+		}
 		m.messageType = "success"
 		m.state = StateMessage
+		if len(m.tagReview) > 0 {
+			m.state = StateTagReview
+		}
 	}
 
 	return m, nil
@@ -314,12 +694,21 @@ func (m *Model) View() string {
 		centered = false
 	case StateConfirming:
 		content = m.confirmingView()
-	case StateUpdating:
+	case StateUpdating, StateImporting:
 		content = m.updatingView()
 	case StateDone:
 		content = m.doneView()
 	case StateMessage:
 		content = m.messageView()
+	case StateTagReview:
+		content = m.tagReviewView()
+	case StatePreview:
+		content = m.previewView()
+		centered = false
+	case StateExplain:
+		content = m.explainView()
+	case StateSummary:
+		content = m.summaryView()
 	default:
 		return "Unknown state"
 	}
@@ -337,11 +726,27 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleDoneKeys(msg)
 	case StateMessage:
 		return m.handleMessageKeys(msg)
+	case StateExplain:
+		m.state = StateReviewing
+		return m, nil
+	case StateSummary:
+		return m, m.handleSummaryKeys(msg)
+	}
+
+	// Text prompts consume every key, including q and ?
+	if m.state == StateReviewing && m.editingFilter {
+		return m.handleFilterKeys(msg)
+	}
+	if m.state == StateReviewing && m.editingText() {
+		return m.handleReviewingKeys(msg)
+	}
+	if m.state == StateConfig && m.llmInputField != "" {
+		return m.handleLLMPickerKeys(msg)
 	}
 
 	switch {
 	case keyMatches(msg, m.keys.Quit):
-		return m, tea.Quit
+		return m, m.requestQuit(msg)
 	case keyMatches(msg, m.keys.Help):
 		m.showHelp = !m.showHelp
 		return m, nil
@@ -350,10 +755,19 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch m.state {
 	case StateConfig:
 		return m.handleConfigKeys(msg)
+	case StateTriaging:
+		if keyMatches(msg, m.keys.Back) {
+			m.cancelTriaging()
+		}
+		return m, nil
 	case StateReviewing:
 		return m.handleReviewingKeys(msg)
 	case StateConfirming:
 		return m.handleConfirmingKeys(msg)
+	case StateTagReview:
+		return m.handleTagReviewKeys(msg)
+	case StatePreview:
+		return m.handlePreviewKeys(msg)
 	}
 
 	return m, nil
@@ -368,11 +782,25 @@ type ProgressMsg struct {
 	Message  string
 	Success  int
 	Failed   int
+	Failures []UpdateFailure
+	Gone     []string // documents already deleted in Readwise
 	Channel  chan readwise.BatchUpdateProgress
 }
 
+// UpdateFailure records a single document that failed to update.
+type UpdateFailure struct {
+	ID    string
+	Error string
+}
+
 type ItemsLoadedMsg struct {
-	Items []Item
+	Items     []Item
+	Location  string
+	FetchedAt time.Time        // zero for fetches that should not be recorded
+	Since     time.Time        // incremental baseline or week window start, zero when fetching the last N days
+	Until     time.Time        // week window end, zero unless paging by week
+	Fetched   int              // items returned by Readwise, before deduplication
+	Next      *readwise.Cursor // the rest of the query when fetch_limit stopped paging
 }
 
 type ErrorMsg struct {
@@ -380,18 +808,30 @@ type ErrorMsg struct {
 }
 
 type UpdateFinishedMsg struct {
-	Success int
-	Failed  int
+	Success  int
+	Failed   int
+	Failures []UpdateFailure
+	Gone     []string // documents already deleted in Readwise, neither updated nor failed
 }
 
 func (m *Model) handleConfigKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.resumeSession != nil {
+		return m, m.handleResumeKeys(msg)
+	}
+
 	// Days input editing mode
 	if m.editingDays {
 		switch msg.Type {
 		case tea.KeyEnter:
-			if days, err := strconv.Atoi(m.daysInput); err == nil && days >= 1 {
+			if m.daysInput != "" {
+				days, hint := parseDaysInput(m.daysInput)
+				m.weekWindow = 0
+				m.presetName = ""
 				*m.activeLookbackPtr() = days
 				m.saveLookback()
+				if hint != "" {
+					m.statusMessage = fmt.Sprintf("Lookback set to %d days (%s)", days, hint)
+				}
 			}
 			m.editingDays = false
 			m.daysInput = ""
@@ -410,12 +850,48 @@ func (m *Model) handleConfigKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	if m.choosingLLM || m.llmInputField != "" {
+		return m.handleLLMPickerKeys(msg)
+	}
+
+	// Preset picker: a number applies that preset, any other key closes it
+	if m.choosingPreset {
+		m.choosingPreset = false
+		if n, err := strconv.Atoi(msg.String()); err == nil {
+			if names := m.cfg.PresetNames(); n >= 1 && n <= len(names) {
+				return m, m.applyPreset(names[n-1])
+			}
+		}
+		return m, nil
+	}
+
 	switch {
 	case keyMatches(msg, m.keys.Enter):
 		return m, m.startFetching()
 	case keyMatches(msg, m.keys.CycleTheme):
 		m.cycleTheme()
+	case keyMatches(msg, m.keys.SinceLast):
+		m.sinceLast = !m.sinceLast
+		m.weekWindow = 0
+		m.presetName = ""
+	case keyMatches(msg, m.keys.FetchLimit):
+		m.cycleFetchLimit()
+	case keyMatches(msg, m.keys.Category):
+		m.cycleCategory()
+		m.weekWindow = 0
+		m.presetName = ""
+	case keyMatches(msg, m.keys.Presets):
+		if m.cfg == nil || len(m.cfg.Presets) == 0 {
+			m.statusMessage = "No fetch presets configured; add presets: to config.yaml"
+			return m, nil
+		}
+		m.choosingPreset = true
+	case keyMatches(msg, m.keys.VerifyToken):
+		return m, m.verifyToken()
+	case keyMatches(msg, m.keys.LLMProvider):
+		m.choosingLLM = true
 	case keyMatches(msg, m.keys.Left), keyMatches(msg, m.keys.Right):
+		m.presetName = ""
 		if m.fetchLocation == "new" {
 			m.fetchLocation = "feed"
 		} else {
@@ -423,9 +899,13 @@ func (m *Model) handleConfigKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		m.saveLocation()
 	case keyMatches(msg, m.keys.Up):
+		m.weekWindow = 0
+		m.presetName = ""
 		*m.activeLookbackPtr() += 7
 		m.saveLookback()
 	case keyMatches(msg, m.keys.Down):
+		m.weekWindow = 0
+		m.presetName = ""
 		if m.activeLookback() > 1 {
 			*m.activeLookbackPtr() -= 7
 			if m.activeLookback() < 1 {
@@ -448,218 +928,863 @@ func (m *Model) startFetching() tea.Cmd {
 	m.state = StateFetching
 	m.statusMessage = "Loading from Readwise..."
 
+	if m.demo {
+		return func() tea.Msg {
+			return ItemsLoadedMsg{Items: demoItems()}
+		}
+	}
+
+	since := m.fetchSince()
+	var until time.Time
+	if m.weekWindow > 0 {
+		since, until = weekBounds(time.Now(), m.weekWindow)
+	}
+	opts := m.fetchOptions(since, until)
 	return func() tea.Msg {
 		if m.cfg == nil || m.cfg.ReadwiseToken == "" {
 			return ErrorMsg{Error: fmt.Errorf("READWISE_TOKEN not configured. Set it via environment variable or config file")}
 		}
 
-		client, err := readwise.NewClient(m.cfg.ReadwiseToken)
+		client, err := newReadwiseClient(m.cfg.ReadwiseToken)
 		if err != nil {
 			return ErrorMsg{Error: err}
 		}
 
-		opts := readwise.FetchOptions{
-			DaysAgo:  m.activeLookback(),
-			Location: m.fetchLocation,
+		fetchedAt := time.Now()
+		if !until.IsZero() {
+			// An older week says nothing about what changed since the last visit
+			fetchedAt = time.Time{}
 		}
-		items, err := client.GetInboxItems(opts)
+		items, next, err := client.GetInboxItemsCursor(opts)
 		if err != nil {
 			return ErrorMsg{Error: err}
 		}
 
-		uiItems := make([]Item, len(items))
-		for i, item := range items {
-			uiItems[i] = Item{
-				ID:           item.ID,
-				Title:        item.Title,
-				Action:       "",
-				Priority:     "",
-				URL:          item.URL,
-				Summary:      item.Summary,
-				Category:     item.Category,
-				Source:       item.Source,
-				WordCount:    item.WordCount,
-				ReadingTime:  item.ReadingTime,
-				OriginalTags: []string(item.Tags),
-			}
+		uiItems := m.fetchedItems(items)
+		fetched := len(uiItems)
+		if m.cfg.Deduplicate {
+			uiItems = dedupeItems(uiItems)
 		}
 
-		return ItemsLoadedMsg{Items: uiItems}
+		return ItemsLoadedMsg{Items: uiItems, Location: opts.Location, FetchedAt: fetchedAt, Since: since, Until: until, Fetched: fetched, Next: next}
 	}
 }
 
-// TriageFinishedMsg is sent when LLM auto-triage completes
-type TriageFinishedMsg struct {
-	Results []triage.Result
-	Err     error
+// fetchedItems converts fetched documents to items with the configured
+// default action and priority.
+func (m *Model) fetchedItems(docs []readwise.Item) []Item {
+	defaultAction, defaultPriority := m.fetchDefaults()
+	items := make([]Item, len(docs))
+	for i, doc := range docs {
+		items[i] = newItem(doc)
+		items[i].Action = defaultAction
+		items[i].Defaulted = defaultAction != ""
+		if items[i].Priority == "" {
+			items[i].Priority = defaultPriority
+		}
+	}
+	return items
 }
 
-func (m *Model) startTriaging() tea.Cmd {
-	m.state = StateTriaging
-
-	return func() tea.Msg {
-		if m.cfg == nil {
-			return TriageFinishedMsg{Err: fmt.Errorf("configuration not loaded")}
-		}
+// fetchOptions builds the Readwise query for the current config screen
+// settings and active preset.
+func (m *Model) fetchOptions(since, until time.Time) readwise.FetchOptions {
+	return readwise.FetchOptions{
+		DaysAgo:      m.activeLookback(),
+		Location:     m.fetchLocation,
+		UpdatedAfter: since,
+		Before:       until,
+		Limit:        m.fetchLimit(),
+		Tag:          m.fetchTag(),
+		Category:     m.fetchCategory(),
+	}
+}
 
-		llmCfg := m.cfg.GetLLMConfig()
-		if llmCfg.Provider == "" && llmCfg.APIKey == "" {
-			return TriageFinishedMsg{Err: fmt.Errorf("LLM not configured. Set llm.provider and llm.api_key in config.yaml or via LLM_API_KEY env var")}
-		}
+// activePreset returns the fetch preset applied with p, if any.
+func (m *Model) activePreset() (config.FetchPreset, bool) {
+	if m.presetName == "" || m.cfg == nil {
+		return config.FetchPreset{}, false
+	}
+	p, ok := m.cfg.Presets[m.presetName]
+	return p, ok
+}
 
-		client, err := triage.NewLLMClient(
-			llmCfg.Provider,
-			llmCfg.APIKey,
-			triage.WithLLMBaseURL(llmCfg.BaseURL),
-			triage.WithLLMModel(llmCfg.Model),
-			triage.WithLLMAPIFormat(llmCfg.APIFormat),
-		)
-		if err != nil {
-			return TriageFinishedMsg{Err: fmt.Errorf("failed to create LLM client: %w", err)}
-		}
+// fetchTag is the active preset's tag, else the configured fetch_tag.
+func (m *Model) fetchTag() string {
+	if p, ok := m.activePreset(); ok && p.Tag != "" {
+		return p.Tag
+	}
+	if m.cfg == nil {
+		return ""
+	}
+	return m.cfg.FetchTag
+}
 
-		// Build the items JSON (same logic as export)
-		itemsJSON, err := m.buildTriageItemsJSON()
-		if err != nil {
-			return TriageFinishedMsg{Err: err}
-		}
+// fetchCategory is the category filter for fetches, "" for all.
+func (m *Model) fetchCategory() string {
+	return m.category
+}
 
-		results, err := client.TriageItems(itemsJSON)
-		return TriageFinishedMsg{Results: results, Err: err}
+// applyPreset switches to the named preset's location and lookback for this
+// session and starts fetching with its filters.
+func (m *Model) applyPreset(name string) tea.Cmd {
+	p := m.cfg.Presets[name]
+	m.presetName = name
+	m.weekWindow = 0
+	m.sinceLast = false
+	switch p.Location {
+	case "feed":
+		m.fetchLocation = "feed"
+	case "new", "inbox":
+		m.fetchLocation = "new"
+	}
+	if p.Category != "" {
+		m.category = p.Category
+	}
+	if p.Days > 0 {
+		*m.activeLookbackPtr() = p.Days
 	}
+	return m.startFetching()
 }
 
-func (m *Model) startUpdating() tea.Cmd {
-	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
-		return func() tea.Msg {
-			return ErrorMsg{Error: fmt.Errorf("READWISE_TOKEN not configured")}
-		}
+// describePreset summarizes a preset for the picker, e.g. "feed · 3 days · video".
+func describePreset(p config.FetchPreset) string {
+	var parts []string
+	switch p.Location {
+	case "feed":
+		parts = append(parts, "feed")
+	case "new", "inbox":
+		parts = append(parts, "inbox")
+	}
+	if p.Days > 0 {
+		parts = append(parts, fmt.Sprintf("%d days", p.Days))
+	}
+	if p.Category != "" {
+		parts = append(parts, p.Category)
 	}
+	if p.Tag != "" {
+		parts = append(parts, "tag "+p.Tag)
+	}
+	if len(parts) == 0 {
+		return "current settings"
+	}
+	return strings.Join(parts, " · ")
+}
 
-	selectedIndices := m.listView.GetSelected()
-	useSelection := len(selectedIndices) > 0
+// fetchDefaults returns the configured default_action and default_priority
+// for freshly fetched items. Unknown values are ignored.
+func (m *Model) fetchDefaults() (action, priority string) {
+	if m.cfg == nil {
+		return "", ""
+	}
+	if validActions[m.cfg.DefaultAction] {
+		action = m.cfg.DefaultAction
+	}
+	if validPriorities[m.cfg.DefaultPriority] {
+		priority = m.cfg.DefaultPriority
+	}
+	return action, priority
+}
 
-	var updates []readwise.UpdateRequest
-	for i, item := range m.items {
-		if useSelection {
-			isSelected := false
-			for _, idx := range selectedIndices {
-				if idx == i {
-					isSelected = true
-					break
-				}
-			}
-			if !isSelected {
-				continue
-			}
-		}
+// parseDaysInput clamps typed lookback days to 1..maxLookbackDays and
+// returns a hint describing the clamp, or "" when the input is in range.
+func parseDaysInput(input string) (int, string) {
+	days, err := strconv.Atoi(input)
+	switch {
+	case errors.Is(err, strconv.ErrRange) || (err == nil && days > maxLookbackDays):
+		return maxLookbackDays, fmt.Sprintf("capped at %d", maxLookbackDays)
+	case err != nil || days < 1:
+		return 1, "min 1 day"
+	}
+	return days, ""
+}
 
-		if item.Action != "" {
-			update := readwise.UpdateRequest{
-				DocumentID: item.ID,
-			}
+// TokenVerifiedMsg reports the result of checking the Readwise token.
+type TokenVerifiedMsg struct {
+	Valid bool
+	Err   error
+}
 
-			switch item.Action {
-			case "read_now":
-				if m.fetchLocation == "feed" {
-					update.Location = "new"
-				}
-			case "later":
-				update.Location = "later"
-			case "archive", "delete":
-				update.Location = "archive"
-			case "needs_review":
-				if m.fetchLocation == "feed" {
-					update.Location = "new"
-				}
-			}
+// PushCheckedMsg reports the token check made before a push, carrying the
+// updates to send if it passes.
+type PushCheckedMsg struct {
+	Updates []readwise.UpdateRequest
+	Valid   bool
+	Err     error
+}
 
-			// Start with original Readwise tags to preserve them
-			update.Tags = append(update.Tags, item.OriginalTags...)
+// verifyToken checks the configured Readwise token without fetching items.
+func (m *Model) verifyToken() tea.Cmd {
+	if m.demo {
+		m.tokenOK = true
+		m.tokenStatus = "demo mode, no token needed"
+		return nil
+	}
+	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+		m.tokenOK = false
+		m.tokenStatus = "no token configured"
+		return nil
+	}
+	m.tokenOK = false
+	m.tokenStatus = "checking token..."
+	token := m.cfg.ReadwiseToken
+	return func() tea.Msg {
+		client, err := newReadwiseClient(token)
+		if err != nil {
+			return TokenVerifiedMsg{Err: err}
+		}
+		valid, err := client.VerifyToken()
+		return TokenVerifiedMsg{Valid: valid, Err: err}
+	}
+}
 
-			if item.Priority != "" {
-				update.Tags = append(update.Tags, "priority:"+item.Priority)
-			}
+// fetchSince returns the incremental fetch baseline for the current location,
+// or the zero time when fetching the last N days.
+func (m *Model) fetchSince() time.Time {
+	if !m.sinceLast {
+		return time.Time{}
+	}
+	return m.lastVisit[m.fetchLocation]
+}
 
-			// Add LLM-suggested tags
-			if len(item.Tags) > 0 {
-				update.Tags = append(update.Tags, item.Tags...)
-			}
+// fetchLimitSteps are the item limits L cycles through on the config screen.
+var fetchLimitSteps = []int{0, 25, 50, 100, 200}
 
-			updates = append(updates, update)
-		}
+// fetchLimit returns the configured maximum number of items to fetch, 0 for all.
+func (m *Model) fetchLimit() int {
+	if m.cfg == nil || m.cfg.FetchLimit < 0 {
+		return 0
 	}
+	return m.cfg.FetchLimit
+}
 
-	if len(updates) == 0 {
-		return func() tea.Msg {
-			return UpdateFinishedMsg{Success: 0, Failed: 0}
+// cycleFetchLimit moves to the next limit step and saves it. A custom
+// fetch_limit from config.yaml moves to the next larger step.
+func (m *Model) cycleFetchLimit() {
+	if m.cfg == nil {
+		return
+	}
+	current := m.fetchLimit()
+	next := 0
+	for _, step := range fetchLimitSteps {
+		if step > current {
+			next = step
+			break
 		}
 	}
+	m.cfg.FetchLimit = next
+	m.saveConfig()
+}
 
-	m.state = StateUpdating
-	m.updateProgress = 0
-	m.statusMessage = "Preparing updates..."
-
-	progressChan := make(chan readwise.BatchUpdateProgress)
+// weekBounds returns the start and end of the nth most recent 7-day window
+// ending at now.
+func weekBounds(now time.Time, n int) (time.Time, time.Time) {
+	end := now.AddDate(0, 0, -7*(n-1))
+	return end.AddDate(0, 0, -7), end
+}
 
-	go func() {
-		client, err := readwise.NewClient(m.cfg.ReadwiseToken)
-		if err == nil {
-			client.BatchUpdate(updates, progressChan)
-		}
-		close(progressChan)
-	}()
+// formatWindow renders a week window for status and config lines.
+func formatWindow(start, end time.Time) string {
+	return start.Local().Format("Jan 2") + " – " + end.Local().Format("Jan 2")
+}
 
-	return m.waitForUpdateProgress(progressChan, 0, 0)
+// recordFetch stores the time of a successful fetch for the next visit.
+func (m *Model) recordFetch(location string, at time.Time) {
+	if m.cfg == nil || at.IsZero() {
+		return
+	}
+	if m.cfg.LastFetchAt == nil {
+		m.cfg.LastFetchAt = make(map[string]time.Time)
+	}
+	m.cfg.LastFetchAt[location] = at.UTC().Truncate(time.Second)
+	m.saveConfig()
 }
 
-func (m *Model) waitForUpdateProgress(ch chan readwise.BatchUpdateProgress, success, failed int) tea.Cmd {
-	return func() tea.Msg {
-		progress, ok := <-ch
-		if !ok {
-			return UpdateFinishedMsg{Success: success, Failed: failed}
-		}
+// TriageFinishedMsg is sent when LLM auto-triage completes
+type TriageFinishedMsg struct {
+	Results []triage.Result
+	Err     error
+	run     int
 
-		newSuccess := success
-		newFailed := failed
-		if progress.Success {
-			newSuccess++
-		} else {
-			newFailed++
-		}
+	// Secondary provider results when comparing, keyed by item ID
+	Alt    map[string]triage.Result
+	AltErr error
+}
 
-		return ProgressMsg{
-			Progress: float64(progress.Current) / float64(progress.Total),
-			Message:  fmt.Sprintf("Updated %d/%d items", progress.Current, progress.Total),
-			Success:  newSuccess,
-			Failed:   newFailed,
-			Channel:  ch,
-		}
+func (m *Model) startTriaging() tea.Cmd {
+	return m.startTriage(m.buildTriageItemsJSON)
+}
+
+// startRetriage asks the LLM about just the focused item, triaged or not.
+func (m *Model) startRetriage() tea.Cmd {
+	item := m.listView.CurrentItem()
+	if item == nil {
+		return nil
 	}
+	focused := *item
+	return m.startTriage(func() (string, error) {
+		return triageItemsJSON([]Item{focused})
+	})
 }
 
-func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Tag editing mode intercept
-	if m.editingTags {
+// startTriage runs LLM triage on the items JSON produced by buildJSON.
+func (m *Model) startTriage(buildJSON func() (string, error)) tea.Cmd {
+	m.state = StateTriaging
+	m.triageRun++
+	m.triageStarted = time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.triageCancel = cancel
+	run := m.triageRun
+
+	return func() tea.Msg {
+		msg := m.runTriage(ctx, buildJSON)
+		msg.run = run
+		return msg
+	}
+}
+
+// cancelTriaging abandons the in-flight LLM request and returns to review.
+func (m *Model) cancelTriaging() {
+	if m.triageCancel != nil {
+		m.triageCancel()
+		m.triageCancel = nil
+	}
+	m.triageRun++
+	m.statusMessage = fmt.Sprintf("Auto-triage canceled after %s", time.Since(m.triageStarted).Round(time.Second))
+	m.state = StateReviewing
+}
+
+// runTriage sends the items JSON from buildJSON to the configured LLM.
+func (m *Model) runTriage(ctx context.Context, buildJSON func() (string, error)) TriageFinishedMsg {
+	if m.cfg == nil {
+		return TriageFinishedMsg{Err: fmt.Errorf("configuration not loaded")}
+	}
+
+	llmCfg := m.cfg.GetLLMConfig()
+	if llmCfg.Provider == "" && llmCfg.APIKey == "" {
+		return TriageFinishedMsg{Err: fmt.Errorf("LLM not configured. Set llm.provider and llm.api_key in config.yaml or via LLM_API_KEY env var")}
+	}
+
+	client, err := newLLMClient(llmCfg, m.cfg.PromptLang)
+	if err != nil {
+		return TriageFinishedMsg{Err: err}
+	}
+
+	itemsJSON, err := buildJSON()
+	if err != nil {
+		return TriageFinishedMsg{Err: err}
+	}
+
+	if !m.cfg.HasSecondaryLLM() {
+		results, err := client.TriageItemsContext(ctx, itemsJSON)
+		return TriageFinishedMsg{Results: results, Err: err}
+	}
+
+	secondary, err := newLLMClient(m.cfg.SecondaryLLM, m.cfg.PromptLang)
+	if err != nil {
+		return TriageFinishedMsg{Err: fmt.Errorf("secondary LLM: %w", err)}
+	}
+	compared, err := triage.CompareTriage(ctx, client, secondary, itemsJSON)
+	if err != nil {
+		return TriageFinishedMsg{Err: err}
+	}
+	return TriageFinishedMsg{Results: compared.Primary, Alt: compared.Secondary, AltErr: compared.SecondaryErr}
+}
+
+// newLLMClient builds an LLM client from config, loading any custom prompt.
+// Without one it uses the built-in prompt in lang.
+func newLLMClient(llmCfg config.LLMConfig, lang string) (*triage.LLMClient, error) {
+	promptTemplate, err := llmCfg.LoadPromptTemplate()
+	if err != nil {
+		return nil, err
+	}
+	if promptTemplate == "" {
+		promptTemplate = triage.AutoTriagePrompt(lang)
+	}
+
+	client, err := triage.NewLLMClient(
+		llmCfg.Provider,
+		llmCfg.APIKey,
+		triage.WithLLMBaseURL(llmCfg.BaseURL),
+		triage.WithLLMModel(llmCfg.Model),
+		triage.WithLLMAPIFormat(llmCfg.APIFormat),
+		triage.WithLLMPromptTemplate(promptTemplate),
+		triage.WithLLMStructuredOutput(llmCfg.StructuredOutput),
+		triage.WithLLMHeaders(llmCfg.Headers),
+		triage.WithLLMLogger(logger),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LLM client: %w", err)
+	}
+	return client, nil
+}
+
+func (m *Model) startUpdating() tea.Cmd {
+	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+		return func() tea.Msg {
+			return ErrorMsg{Error: fmt.Errorf("READWISE_TOKEN not configured")}
+		}
+	}
+
+	updates := m.buildUpdates()
+	if m.demo || m.skipPushCheck || len(updates) == 0 {
+		return m.runUpdates(updates)
+	}
+
+	// A revoked token or a dead network would otherwise only show up as
+	// per-item failures after the whole rate-limited batch has run.
+	m.state = StateUpdating
+	m.updateProgress = 0
+	m.statusMessage = "Checking Readwise token..."
+	token := m.cfg.ReadwiseToken
+	return func() tea.Msg {
+		client, err := newReadwiseClient(token)
+		if err != nil {
+			return PushCheckedMsg{Err: err}
+		}
+		valid, err := client.VerifyToken()
+		return PushCheckedMsg{Updates: updates, Valid: valid, Err: err}
+	}
+}
+
+// handlePushChecked starts the push once the token check passes, or returns
+// to the list with the reason it was stopped.
+func (m *Model) handlePushChecked(msg PushCheckedMsg) tea.Cmd {
+	switch {
+	case msg.Err != nil:
+		m.statusMessage = fmt.Sprintf("Push stopped, could not reach Readwise: %v", msg.Err)
+	case !msg.Valid:
+		m.tokenOK = false
+		m.tokenStatus = "token invalid"
+		m.statusMessage = "Push stopped: Readwise rejected the token. Update READWISE_TOKEN and push again."
+	default:
+		return m.runUpdates(msg.Updates)
+	}
+	m.messageType = "error"
+	m.state = StateMessage
+	return nil
+}
+
+// logger receives request logs from the Readwise and LLM clients. It
+// discards everything unless SetLogger is called, so nothing reaches the
+// terminal while the TUI is drawing.
+var logger = slog.New(slog.DiscardHandler)
+
+// SetLogger sends Readwise and LLM request logs to l.
+func SetLogger(l *slog.Logger) {
+	logger = l
+}
+
+// newReadwiseClient creates the Readwise client used for fetches and updates.
+// It is a variable so tests can point it at a local server.
+var newReadwiseClient = func(token string) (*readwise.Client, error) {
+	return readwise.NewClient(token, readwise.WithLogger(logger))
+}
+
+// runUpdates pushes the given updates to Readwise and streams progress.
+func (m *Model) runUpdates(updates []readwise.UpdateRequest) tea.Cmd {
+	if len(updates) == 0 {
+		return func() tea.Msg {
+			return UpdateFinishedMsg{Success: 0, Failed: 0}
+		}
+	}
+
+	batchUpdate := demoBatchUpdate
+	if !m.demo {
+		client, err := newReadwiseClient(m.cfg.ReadwiseToken)
+		if err != nil {
+			return func() tea.Msg {
+				return ErrorMsg{Error: fmt.Errorf("failed to create Readwise client: %w", err)}
+			}
+		}
+		batchUpdate = client.BatchUpdate
+	}
+
+	m.pushing = m.pushing[:0]
+	for _, update := range updates {
+		m.pushing = append(m.pushing, update.DocumentID)
+	}
+	m.state = StateUpdating
+	m.updateProgress = 0
+	m.updateFailures = nil
+	m.statusMessage = "Preparing updates..."
+
+	progressChan := make(chan readwise.BatchUpdateProgress)
+
+	go func() {
+		batchUpdate(updates, progressChan)
+		close(progressChan)
+	}()
+
+	return m.waitForUpdateProgress(progressChan, 0, 0, nil, nil)
+}
+
+// retryFailedUpdates rebuilds updates for the items that failed in the last
+// run and pushes only those.
+func (m *Model) retryFailedUpdates() tea.Cmd {
+	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+		return func() tea.Msg {
+			return ErrorMsg{Error: fmt.Errorf("READWISE_TOKEN not configured")}
+		}
+	}
+
+	failed := make(map[string]bool, len(m.updateFailures))
+	for _, f := range m.updateFailures {
+		failed[f.ID] = true
+	}
+
+	var updates []readwise.UpdateRequest
+	for _, item := range m.items {
+		for _, update := range m.updateRequestsFor(item) {
+			if failed[update.DocumentID] {
+				updates = append(updates, update)
+			}
+		}
+	}
+	return m.runUpdates(dedupeUpdates(updates))
+}
+
+// buildUpdates converts triaged items into Readwise update requests.
+// Selection-aware: uses selected items if any, otherwise all triaged items.
+// Snoozed items are never pushed, and each document is updated only once.
+func (m *Model) buildUpdates() []readwise.UpdateRequest {
+	var updates []readwise.UpdateRequest
+	for _, item := range m.itemsToUpdate() {
+		updates = append(updates, m.updateRequestsFor(item)...)
+	}
+	return dedupeUpdates(updates)
+}
+
+// itemsToUpdate returns the selected items, or all items when none are selected.
+func (m *Model) itemsToUpdate() []Item {
+	selectedIndices := m.listView.GetSelected()
+	useSelection := len(selectedIndices) > 0
+
+	var items []Item
+	for i, item := range m.items {
+		if useSelection {
+			isSelected := false
+			for _, idx := range selectedIndices {
+				if idx == i {
+					isSelected = true
+					break
+				}
+			}
+			if !isSelected {
+				continue
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// tagChange describes how an update replaces an item's tags on Readwise.
+type tagChange struct {
+	Title   string
+	Added   []string
+	Removed []string
+}
+
+// tagChanges compares each pending update's tag set with the tags fetched
+// from Readwise. Updates replace the whole set, so anything changed on the
+// server since the fetch is overwritten.
+func (m *Model) tagChanges() []tagChange {
+	var changes []tagChange
+	for _, item := range m.itemsToUpdate() {
+		update, ok := m.updateRequestFor(item)
+		if !ok {
+			continue
+		}
+		remote := item.OriginalTags
+		if item.RemotePriority != "" {
+			remote = append(slices.Clip(remote), "priority:"+item.RemotePriority)
+		}
+		added, removed := tagDiff(remote, update.Tags)
+		if len(added) > 0 || len(removed) > 0 {
+			changes = append(changes, tagChange{Title: item.Title, Added: added, Removed: removed})
+		}
+	}
+	return changes
+}
+
+// tagDiff returns the tags in after but not before, and in before but not after.
+func tagDiff(before, after []string) (added, removed []string) {
+	inBefore := make(map[string]bool, len(before))
+	for _, t := range before {
+		inBefore[t] = true
+	}
+	inAfter := make(map[string]bool, len(after))
+	for _, t := range after {
+		if !inAfter[t] && !inBefore[t] {
+			added = append(added, t)
+		}
+		inAfter[t] = true
+	}
+	for _, t := range before {
+		if !inAfter[t] {
+			removed = append(removed, t)
+			inAfter[t] = true // report each removed tag once
+		}
+	}
+	return added, removed
+}
+
+// updateRequestsFor returns the update for an item plus an identical update
+// for each duplicate collapsed into it.
+func (m *Model) updateRequestsFor(item Item) []readwise.UpdateRequest {
+	update, ok := m.updateRequestFor(item)
+	if !ok {
+		return nil
+	}
+	updates := []readwise.UpdateRequest{update}
+	for _, id := range item.DuplicateIDs {
+		dup := update
+		dup.DocumentID = id
+		updates = append(updates, dup)
+	}
+	return updates
+}
+
+// updateRequestFor builds the Readwise update for a triaged item.
+// Returns false for untriaged and snoozed items, which are never pushed.
+func (m *Model) updateRequestFor(item Item) (readwise.UpdateRequest, bool) {
+	if item.Action == "" || item.Action == "snooze" {
+		return readwise.UpdateRequest{}, false
+	}
+
+	update := readwise.UpdateRequest{
+		DocumentID: item.ID,
+	}
+
+	update.Location = m.actionLocation(item.Action)
+
+	// Empty notes are omitted so existing server notes are kept
+	update.Notes = item.Notes
+
+	if item.StartFresh {
+		zero := 0.0
+		update.ReadingProgress = &zero
+	}
+
+	// Start with original Readwise tags to preserve them
+	update.Tags = append(update.Tags, item.OriginalTags...)
+
+	if item.Priority != "" {
+		update.Tags = append(update.Tags, "priority:"+item.Priority)
+	}
+
+	// The star tag follows the star: added while starred, dropped from the
+	// Readwise tags once the item is unstarred
+	if tag := m.starTag(); tag != "" {
+		update.Tags = slices.DeleteFunc(update.Tags, func(t string) bool { return t == tag })
+		if item.Starred {
+			update.Tags = append(update.Tags, tag)
+		}
+	}
+
+	// The needs_review tag follows the decision: added while the item needs
+	// review, dropped from the Readwise tags once it is decided otherwise
+	if tag := m.needsReviewTag(); tag != "" {
+		update.Tags = slices.DeleteFunc(update.Tags, func(t string) bool { return t == tag })
+		if item.Action == "needs_review" {
+			update.Tags = append(update.Tags, tag)
+		}
+	}
+
+	// Add LLM-suggested tags
+	if len(item.Tags) > 0 {
+		update.Tags = append(update.Tags, item.Tags...)
+	}
+
+	return update, true
+}
+
+// actionLocation returns where an update for action moves the document.
+// action_locations in the config overrides the defaults; "" leaves the
+// document where it is.
+func (m *Model) actionLocation(action string) string {
+	if m.cfg != nil {
+		if location, ok := m.cfg.ActionLocations[action]; ok {
+			return location
+		}
+	}
+	switch action {
+	case "read_now", "needs_review":
+		if m.fetchLocation == "feed" {
+			return "new"
+		}
+	case "later":
+		return "later"
+	case "archive", "delete":
+		return "archive"
+	}
+	return ""
+}
+
+// starTag returns the tag pushed for starred items, or "" when star_tag is
+// unset and stars stay local.
+func (m *Model) starTag() string {
+	if m.cfg == nil {
+		return ""
+	}
+	return strings.TrimSpace(m.cfg.StarTag)
+}
+
+// needsReviewTag returns the tag pushed for needs_review items, or "" when
+// needs_review_tag is unset.
+func (m *Model) needsReviewTag() string {
+	if m.cfg == nil {
+		return ""
+	}
+	return strings.TrimSpace(m.cfg.NeedsReviewTag)
+}
+
+// splitPriorityTag pulls a priority:X tag pushed by an earlier update out of
+// tags, so a fresh fetch restores the priority without duplicating the tag.
+func splitPriorityTag(tags []string) (string, []string) {
+	priority := ""
+	rest := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		name, value, ok := strings.Cut(tag, ":")
+		if ok && strings.EqualFold(name, "priority") {
+			switch value = strings.ToLower(value); value {
+			case "high", "medium", "low":
+				priority = value
+				continue
+			}
+		}
+		rest = append(rest, tag)
+	}
+	return priority, rest
+}
+
+func (m *Model) waitForUpdateProgress(ch chan readwise.BatchUpdateProgress, success, failed int, failures []UpdateFailure, gone []string) tea.Cmd {
+	return func() tea.Msg {
+		progress, ok := <-ch
+		if !ok {
+			return UpdateFinishedMsg{Success: success, Failed: failed, Failures: failures, Gone: gone}
+		}
+
+		newSuccess := success
+		newFailed := failed
+		newFailures := failures
+		newGone := gone
+		if progress.Success {
+			newSuccess++
+		} else if progress.Gone {
+			newGone = append(append([]string(nil), gone...), progress.ItemID)
+		} else {
+			newFailed++
+			errText := "unknown error"
+			if progress.Error != nil {
+				errText = progress.Error.Error()
+			}
+			newFailures = append(append([]UpdateFailure(nil), failures...), UpdateFailure{ID: progress.ItemID, Error: errText})
+		}
+
+		return ProgressMsg{
+			Progress: float64(progress.Current) / float64(progress.Total),
+			Message:  fmt.Sprintf("Updated %s/%s items", formatCount(progress.Current), formatCount(progress.Total)),
+			Success:  newSuccess,
+			Failed:   newFailed,
+			Failures: newFailures,
+			Gone:     newGone,
+			Channel:  ch,
+		}
+	}
+}
+
+// removeGoneItems drops documents that Readwise no longer has from the list,
+// keeping the focus and selection on the remaining items.
+func (m *Model) removeGoneItems(ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	gone := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		gone[id] = true
+		delete(m.unpushed, id)
+	}
+
+	var focusedID string
+	if item := m.listView.CurrentItem(); item != nil {
+		focusedID = item.ID
+	}
+	selected := make(map[string]bool)
+	for _, idx := range m.listView.GetSelected() {
+		if idx >= 0 && idx < len(m.items) {
+			selected[m.items[idx].ID] = true
+		}
+	}
+
+	m.items = slices.DeleteFunc(m.items, func(item Item) bool { return gone[item.ID] })
+	for i := range m.items {
+		m.items[i].DuplicateIDs = slices.DeleteFunc(m.items[i].DuplicateIDs, func(id string) bool { return gone[id] })
+	}
+
+	var indices []int
+	for i, item := range m.items {
+		if selected[item.ID] {
+			indices = append(indices, i)
+		}
+	}
+	m.listView.SetItems(m.items)
+	m.listView.SetSelection(indices)
+	for row := 0; row < m.listView.VisibleCount(); row++ {
+		if idx := m.listView.ItemIndex(row); idx >= 0 && m.items[idx].ID == focusedID {
+			m.listView.SetCursor(row)
+			break
+		}
+	}
+	m.cursor = m.listView.Cursor()
+	m.batchMode = len(indices) > 0
+}
+
+func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Tag/notes editing mode intercept
+	if m.editingText() {
 		runes := []rune(m.tagsInput)
 		// Use msg.String() for word-jump bindings so both CSI sequences
 		// (alt+left/alt+right) and ESC+letter sequences (alt+b/alt+f)
 		// are handled — macOS terminals commonly send the latter.
 		switch s := msg.String(); {
 		case msg.Type == tea.KeyEnter:
-			tags := parseTags(m.tagsInput)
-			if m.batchMode {
-				m.applyBatchTags(tags)
-			} else if item := m.listView.GetItem(m.listView.Cursor()); item != nil {
-				item.Tags = tags
-				m.saveTriage(item.ID, item.Action, item.Priority, item.Tags)
-				m.listView.SetItems(m.items)
+			if m.renamingTag {
+				m.applyRename(m.tagsInput)
+			} else if m.editingNotes {
+				m.applyNotes(strings.TrimSpace(m.tagsInput))
+			} else if m.editingReason {
+				m.applyReason(strings.Join(strings.Fields(m.tagsInput), " "))
+			} else {
+				tags := parseTags(m.tagsInput)
+				if removals, ok := tagRemovals(tags); ok && m.batchMode {
+					changed := 0
+					for _, tag := range removals {
+						changed += m.removeTagFromItems(m.listView.GetSelected(), tag)
+					}
+					m.statusMessage = fmt.Sprintf("Removed %s from %d items", strings.Join(removals, ", "), changed)
+				} else if m.batchMode {
+					m.applyBatchTags(tags)
+				} else if item := m.listView.CurrentItem(); item != nil {
+					item.Tags = tags
+					m.saveTriage(item.ID, item.Action, item.Priority, item.Tags)
+					m.listView.SetItems(m.items)
+				}
 			}
 			m.editingTags = false
+			m.editingNotes = false
+			m.editingReason = false
+			m.renamingTag = false
 			m.tagsInput = ""
 			m.tagsCursor = 0
 		case msg.Type == tea.KeyEsc:
 			m.editingTags = false
+			m.editingNotes = false
+			m.editingReason = false
+			m.renamingTag = false
 			m.tagsInput = ""
 			m.tagsCursor = 0
 		case msg.Type == tea.KeyBackspace && !msg.Alt:
@@ -687,16 +1812,24 @@ func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.tagsCursor++
 			}
 		default:
-			if len(s) == 1 && s[0] >= 32 {
-				r := []rune(s)[0]
-				runes = append(runes[:m.tagsCursor], append([]rune{r}, runes[m.tagsCursor:]...)...)
-				m.tagsCursor++
+			// A bracketed paste arrives as one message with many runes
+			if typed := m.typedRunes(msg); len(typed) > 0 {
+				runes = append(runes[:m.tagsCursor], append(typed, runes[m.tagsCursor:]...)...)
+				m.tagsCursor += len(typed)
 				m.tagsInput = string(runes)
 			}
 		}
 		return m, nil
 	}
 
+	if ok, cmd := m.handleTypeAheadKey(msg); ok {
+		return m, cmd
+	}
+	if m.handleCountKey(msg) {
+		return m, nil
+	}
+	count := m.takeRepeatCount()
+
 	switch {
 	case keyMatches(msg, m.keys.Enter):
 		// Enter tag editing mode
@@ -704,36 +1837,98 @@ func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.batchMode {
 			m.tagsInput = ""
 			m.tagsCursor = 0
-		} else if item := m.listView.GetItem(m.listView.Cursor()); item != nil {
-			m.tagsInput = strings.Join(item.Tags, ", ")
+		} else if item := m.listView.CurrentItem(); item != nil {
+			m.tagsInput = formatTags(item.Tags)
 			m.tagsCursor = len([]rune(m.tagsInput))
 		}
 		return m, nil
 	case keyMatches(msg, m.keys.Up):
 		// Use SetCursor directly to avoid the table's broken YOffset logic in MoveUp/MoveDown
-		m.listView.MoveCursor(-1)
+		for i := 0; i < count; i++ {
+			m.listView.MoveCursor(-1)
+		}
 		m.cursor = m.listView.Cursor()
 		return m, nil
 	case keyMatches(msg, m.keys.Down):
-		m.listView.MoveCursor(1)
+		for i := 0; i < count; i++ {
+			m.listView.MoveCursor(1)
+		}
 		m.cursor = m.listView.Cursor()
 		return m, nil
 	case keyMatches(msg, m.keys.Open):
-		selected := m.listView.GetSelected()
-		if len(selected) > 0 {
-			for _, idx := range selected {
-				if item := m.listView.GetItem(idx); item != nil {
-					_ = openURL(item.URL)
-				}
-			}
-		} else if item := m.listView.GetItem(m.listView.Cursor()); item != nil {
-			if err := openURL(item.URL); err != nil {
-				m.statusMessage = fmt.Sprintf("Failed to open URL: %v", err)
-				m.messageType = "error"
-				m.state = StateMessage
-			}
+		m.openItems(false)
+		return m, nil
+	case keyMatches(msg, m.keys.OpenReader):
+		m.openItems(true)
+		return m, nil
+	case keyMatches(msg, m.keys.OpenReview):
+		m.openActionURLs("needs_review", false, "Open Needs Review")
+		return m, nil
+	case keyMatches(msg, m.keys.OpenReadNow):
+		m.openActionURLs("read_now", true, "Open Read Now in Reader")
+		return m, nil
+	case keyMatches(msg, m.keys.ArchiveRest):
+		m.confirmArchiveUntriaged()
+		return m, nil
+	case keyMatches(msg, m.keys.Preview):
+		return m, m.startPreview()
+	case keyMatches(msg, m.keys.Explain):
+		m.startExplain()
+		return m, nil
+	case keyMatches(msg, m.keys.RefreshItem):
+		return m, m.refreshItem()
+	case keyMatches(msg, m.keys.JumpTo):
+		return m, m.startTypeAhead()
+	case keyMatches(msg, m.keys.Notes):
+		m.editingNotes = true
+		if m.batchMode {
+			m.tagsInput = ""
+			m.tagsCursor = 0
+		} else if item := m.listView.CurrentItem(); item != nil {
+			m.tagsInput = item.Notes
+			m.tagsCursor = len([]rune(m.tagsInput))
+		}
+		return m, nil
+	case keyMatches(msg, m.keys.Reason):
+		m.editingReason = true
+		if m.batchMode {
+			m.tagsInput = ""
+			m.tagsCursor = 0
+		} else if item := m.listView.CurrentItem(); item != nil {
+			m.tagsInput = item.Reason
+			m.tagsCursor = len([]rune(m.tagsInput))
 		}
 		return m, nil
+	case keyMatches(msg, m.keys.RenameTag):
+		m.renamingTag = true
+		m.tagsInput = ""
+		m.tagsCursor = 0
+		return m, nil
+	case keyMatches(msg, m.keys.Filter):
+		m.editingFilter = true
+		m.filterInput = m.filterQuery
+		return m, nil
+	case keyMatches(msg, m.keys.Compact):
+		m.listView.SetCompact(!m.listView.Compact())
+		if m.cfg != nil {
+			m.cfg.Compact = m.listView.Compact()
+			m.saveConfig()
+		}
+		return m, nil
+	case keyMatches(msg, m.keys.CleanTitles):
+		m.listView.SetCleanTitles(!m.listView.CleanTitles())
+		m.listView.SetItems(m.items)
+		if m.cfg != nil {
+			m.cfg.CleanTitles = m.listView.CleanTitles()
+			m.saveConfig()
+		}
+		return m, nil
+	case keyMatches(msg, m.keys.HideFinished):
+		m.hideFinished = !m.hideFinished
+		m.listView.SetItems(m.items)
+		m.cursor = m.listView.Cursor()
+		m.batchMode = len(m.listView.GetSelected()) > 0
+		return m, nil
 	case keyMatches(msg, m.keys.Select):
 		m.listView.ToggleSelection()
 		m.cursor = m.listView.Cursor()
@@ -744,7 +1939,37 @@ func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.statusMessage = fmt.Sprintf("Export failed: %v", err)
 			m.messageType = "error"
 		} else {
-			m.statusMessage = "Items exported to clipboard! Paste to your LLM."
+			m.statusMessage = m.withExportWarning("Items exported to clipboard! Paste to your LLM.")
+			m.messageType = "success"
+		}
+		m.state = StateMessage
+		return m, nil
+	case keyMatches(msg, m.keys.ExportFile):
+		if path, err := m.ExportItemsToFile(); err != nil {
+			m.statusMessage = fmt.Sprintf("Export failed: %v", err)
+			m.messageType = "error"
+		} else {
+			m.statusMessage = m.withExportWarning(fmt.Sprintf("Items exported to %s. Paste its contents to your LLM.", path))
+			m.messageType = "success"
+		}
+		m.state = StateMessage
+		return m, nil
+	case keyMatches(msg, m.keys.CopyItem):
+		if err := m.CopyFocusedItem(); err != nil {
+			m.statusMessage = fmt.Sprintf("Copy failed: %v", err)
+			m.messageType = "error"
+		} else {
+			m.statusMessage = "Focused item's export copied to clipboard!"
+			m.messageType = "success"
+		}
+		m.state = StateMessage
+		return m, nil
+	case msg.String() == "E":
+		if err := m.ExportAllToClipboard(); err != nil {
+			m.statusMessage = fmt.Sprintf("Export failed: %v", err)
+			m.messageType = "error"
+		} else {
+			m.statusMessage = "All items and their decisions exported to clipboard! Paste to your LLM for a second opinion."
 			m.messageType = "success"
 		}
 		m.state = StateMessage
@@ -756,23 +1981,55 @@ func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.messageType = "error"
 		} else {
 			if m.statusMessage == "" {
-				m.statusMessage = fmt.Sprintf("Applied triage results to %d items", applied)
+				m.statusMessage = fmt.Sprintf("Applied triage results to %s items", formatCount(applied))
 			}
 			m.messageType = "success"
 		}
 		m.state = StateMessage
 		return m, nil
+	case keyMatches(msg, m.keys.RetryImport):
+		if _, err := m.RetryImport(); err != nil {
+			m.statusMessage = fmt.Sprintf("Retry failed: %v", err)
+			m.messageType = "error"
+		} else {
+			m.messageType = "success"
+		}
+		m.state = StateMessage
+		return m, nil
 	case keyMatches(msg, m.keys.Update):
+		if m.cfg != nil && !m.cfg.ConfirmsPush() {
+			return m, m.startUpdating()
+		}
 		m.state = StateConfirming
 		return m, nil
+	case keyMatches(msg, m.keys.PushNow):
+		return m, m.startUpdating()
 	case keyMatches(msg, m.keys.FetchMore):
+		if m.nextPage != nil {
+			return m, m.fetchNextPage()
+		}
+		m.sinceLast = false
+		m.weekWindow = 0
 		*m.activeLookbackPtr() += 7
 		m.saveLookback()
 		return m, m.startFetching()
-	case keyMatches(msg, m.keys.Refresh):
+	case keyMatches(msg, m.keys.PrevWeek):
+		m.sinceLast = false
+		m.weekWindow++
+		return m, m.startFetching()
+	case keyMatches(msg, m.keys.NextWeek):
+		if m.weekWindow <= 1 {
+			m.statusMessage = "Already at the most recent week"
+			return m, nil
+		}
+		m.weekWindow--
 		return m, m.startFetching()
+	case keyMatches(msg, m.keys.Refresh):
+		return m, m.requestRefresh()
 	case keyMatches(msg, m.keys.AutoTriage):
 		return m, m.startTriaging()
+	case keyMatches(msg, m.keys.Retriage):
+		return m, m.startRetriage()
 	case keyMatches(msg, m.keys.Back):
 		m.state = StateConfig
 		return m, nil
@@ -790,46 +2047,295 @@ func (m *Model) handleReviewingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.applyBatchAction("delete")
 		case "n":
 			m.applyBatchAction("needs_review")
+		case "z":
+			m.snoozeSelected()
+		case "0":
+			m.toggleBatchStartFresh()
+		case "*":
+			m.toggleBatchStar()
 		case "1":
 			m.applyBatchPriority("high")
 		case "2":
 			m.applyBatchPriority("medium")
 		case "3":
 			m.applyBatchPriority("low")
+		case "+":
+			m.cycleBatchPriority(1)
+		case "-":
+			m.cycleBatchPriority(-1)
 		}
+		return m, m.startAutoPush()
+	}
+
+	if action, ok := actionKeys[msg.String()]; ok {
+		m.repeatOnItems(count, func(item *Item) { m.setItemAction(item, action) })
+		return m, m.startAutoPush()
+	}
+	if msg.String() == "z" {
+		m.repeatOnItems(count, m.snoozeItem)
 		return m, nil
 	}
 
-	if item := m.listView.GetItem(m.listView.Cursor()); item != nil {
+	if item := m.listView.CurrentItem(); item != nil {
 		switch msg.String() {
-		case "r":
-			m.setItemAction(item, "read_now")
-		case "l":
-			m.setItemAction(item, "later")
-		case "a":
-			m.setItemAction(item, "archive")
-		case "d":
-			m.setItemAction(item, "delete")
-		case "n":
-			m.setItemAction(item, "needs_review")
+		case "0":
+			item.StartFresh = !item.StartFresh
+			m.listView.SetItems(m.items)
+		case "*":
+			m.setStarred([]*Item{item}, !item.Starred)
 		case "1":
 			m.setItemPriority(item, "high")
 		case "2":
 			m.setItemPriority(item, "medium")
 		case "3":
 			m.setItemPriority(item, "low")
+		case "+", "-":
+			dir := 1
+			if msg.String() == "-" {
+				dir = -1
+			}
+			cyclePriority(item, dir)
+			m.setItemPriority(item, item.Priority)
 		}
 	}
 
+	return m, m.startAutoPush()
+}
+
+// openItems opens the selected items (or the focused item) in the browser.
+// If readerView is true, the Readwise Reader page is opened instead of the source URL.
+func (m *Model) openItems(readerView bool) {
+	selected := m.listView.GetSelected()
+	if len(selected) > 0 {
+		missing := 0
+		for _, idx := range selected {
+			if item := m.listView.GetItem(idx); item != nil {
+				if url := itemOpenURL(item, readerView); url != "" {
+					_ = openURL(url)
+				} else {
+					missing++
+				}
+			}
+		}
+		if missing > 0 {
+			m.statusMessage = fmt.Sprintf("Skipped %d selected items with no URL", missing)
+			m.messageType = "error"
+			m.state = StateMessage
+		}
+		return
+	}
+
+	if item := m.listView.CurrentItem(); item != nil {
+		m.openItem(item, readerView)
+	}
+}
+
+// openItem opens a single item, surfacing a message if it has no URL.
+func (m *Model) openItem(item *Item, readerView bool) {
+	url := itemOpenURL(item, readerView)
+	if url == "" {
+		m.statusMessage = "No URL for this item"
+		if readerView {
+			m.statusMessage = "No Readwise Reader URL available for this item"
+		}
+		m.messageType = "error"
+		m.state = StateMessage
+		return
+	}
+	if err := openURL(url); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to open URL: %v", err)
+		m.messageType = "error"
+		m.state = StateMessage
+	}
+}
+
+// handleFilterKeys edits the filter prompt opened with /.
+func (m *Model) handleFilterKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.editingFilter = false
+		m.setFilter(strings.TrimSpace(m.filterInput))
+	case tea.KeyEsc:
+		m.editingFilter = false
+	case tea.KeyBackspace:
+		if runes := []rune(m.filterInput); len(runes) > 0 {
+			m.filterInput = string(runes[:len(runes)-1])
+		}
+	case tea.KeyCtrlU:
+		m.filterInput = ""
+	case tea.KeyRunes, tea.KeySpace:
+		m.filterInput += string(msg.Runes)
+	}
 	return m, nil
 }
 
+// setFilter applies a filter query and refreshes the visible rows.
+func (m *Model) setFilter(query string) {
+	m.filterQuery = query
+	m.filterInput = ""
+	m.listView.SetItems(m.items)
+	m.cursor = m.listView.Cursor()
+	m.batchMode = len(m.listView.GetSelected()) > 0
+}
+
+// handleReviewingMouse moves the cursor to the clicked row. A modifier-click
+// toggles selection and a double-click opens the item's URL.
+func (m *Model) handleReviewingMouse(msg tea.MouseMsg) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return
+	}
+	row := m.listView.RowAtY(msg.Y, reviewHeaderHeight)
+	if row < 0 {
+		return
+	}
+
+	m.listView.SetCursor(row)
+	m.cursor = m.listView.Cursor()
+
+	if msg.Ctrl || msg.Alt || msg.Shift {
+		m.listView.ToggleSelection()
+		m.batchMode = len(m.listView.GetSelected()) > 0
+		m.lastClickAt = time.Time{}
+		return
+	}
+
+	now := time.Now()
+	if row == m.lastClickRow && now.Sub(m.lastClickAt) <= doubleClickInterval {
+		m.lastClickAt = time.Time{}
+		if item := m.listView.CurrentItem(); item != nil {
+			m.openItem(item, false)
+		}
+		return
+	}
+	m.lastClickRow = row
+	m.lastClickAt = now
+}
+
+// maxBulkOpen caps how many browser tabs a single bulk open spawns.
+const maxBulkOpen = 10
+
+// actionURLs returns the URLs of visible items with the given action, capped
+// at limit, along with how many such items have a URL in total. readerView
+// picks Reader pages over source URLs, as with itemOpenURL.
+func actionURLs(items []Item, action string, readerView bool, visible func(Item) bool, limit int) ([]string, int) {
+	var urls []string
+	total := 0
+	for _, item := range items {
+		url := itemOpenURL(&item, readerView)
+		if item.Action != action || url == "" {
+			continue
+		}
+		if visible != nil && !visible(item) {
+			continue
+		}
+		total++
+		if len(urls) < limit {
+			urls = append(urls, url)
+		}
+	}
+	return urls, total
+}
+
+// openActionURLs opens every visible item with the given action, in Reader
+// when readerView is set, asking first when there are more than maxBulkOpen
+// of them. title heads the confirmation.
+func (m *Model) openActionURLs(action string, readerView bool, title string) {
+	urls, total := actionURLs(m.items, action, readerView, m.itemVisible, maxBulkOpen)
+	if total == 0 {
+		m.statusMessage = fmt.Sprintf("No %s items to open", action)
+		m.messageType = "error"
+		m.state = StateMessage
+		return
+	}
+	if total > len(urls) {
+		m.pendingOpen = urls
+		m.pendingOpenTitle = title
+		m.statusMessage = fmt.Sprintf("Open the first %d of %d %s items?", len(urls), total, action)
+		m.state = StateConfirming
+		return
+	}
+	m.openURLs(urls)
+}
+
+// untriagedIDs returns the IDs of visible items that have no action yet.
+func (m *Model) untriagedIDs() []string {
+	var ids []string
+	for _, item := range m.items {
+		if item.Action == "" && m.itemVisible(item) {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+// confirmArchiveUntriaged asks before archiving every visible untriaged item.
+func (m *Model) confirmArchiveUntriaged() {
+	ids := m.untriagedIDs()
+	if len(ids) == 0 {
+		m.statusMessage = "No untriaged items to archive"
+		m.messageType = "error"
+		m.state = StateMessage
+		return
+	}
+	noun := "items"
+	if len(ids) == 1 {
+		noun = "item"
+	}
+	m.pendingArchive = ids
+	m.statusMessage = fmt.Sprintf("Archive %s untriaged %s?", formatCount(len(ids)), noun)
+	m.state = StateConfirming
+}
+
+// archiveUntriaged archives the given items, skipping any that were decided
+// since confirmation was requested. Each goes through setItemAction so it is
+// queued for auto_push like any other decision.
+func (m *Model) archiveUntriaged(ids []string) {
+	pending := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		pending[id] = true
+	}
+	count := 0
+	for i := range m.items {
+		item := &m.items[i]
+		if pending[item.ID] && item.Action == "" {
+			m.setItemAction(item, "archive")
+			count++
+		}
+	}
+	m.listView.SetItems(m.items)
+	m.cursor = m.listView.Cursor()
+	m.statusMessage = fmt.Sprintf("Archived %s untriaged items", formatCount(count))
+}
+
+// openURLs opens each URL, reporting the first failure.
+func (m *Model) openURLs(urls []string) {
+	for _, url := range urls {
+		if err := openURL(url); err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to open URL: %v", err)
+			m.messageType = "error"
+			m.state = StateMessage
+			return
+		}
+	}
+}
+
+// itemOpenURL returns the URL to open for an item: the Readwise Reader page
+// when readerView is true, otherwise the original source URL, falling back
+// to the Reader page for items without one (e.g. emails and notes).
+func itemOpenURL(item *Item, readerView bool) string {
+	if readerView || item.URL == "" {
+		return item.ReaderURL
+	}
+	return item.URL
+}
+
 func (m *Model) applyBatchAction(action string) {
 	selected := m.listView.GetSelected()
 	for _, idx := range selected {
 		if idx >= 0 && idx < len(m.items) {
 			m.items[idx].Action = action
 			m.saveTriage(m.items[idx].ID, m.items[idx].Action, m.items[idx].Priority, m.items[idx].Tags)
+			m.enqueueAutoPush(&m.items[idx])
 		}
 	}
 	m.listView.SetItems(m.items)
@@ -841,6 +2347,77 @@ func (m *Model) applyBatchPriority(priority string) {
 		if idx >= 0 && idx < len(m.items) {
 			m.items[idx].Priority = priority
 			m.saveTriage(m.items[idx].ID, m.items[idx].Action, m.items[idx].Priority, m.items[idx].Tags)
+			m.enqueueAutoPush(&m.items[idx])
+		}
+	}
+	m.listView.SetItems(m.items)
+}
+
+// cycleBatchPriority bumps the priority of every selected item one step.
+func (m *Model) cycleBatchPriority(dir int) {
+	for _, idx := range m.listView.GetSelected() {
+		if idx >= 0 && idx < len(m.items) {
+			cyclePriority(&m.items[idx], dir)
+			m.saveTriage(m.items[idx].ID, m.items[idx].Action, m.items[idx].Priority, m.items[idx].Tags)
+			m.enqueueAutoPush(&m.items[idx])
+		}
+	}
+	m.listView.SetItems(m.items)
+}
+
+// priorityCycle orders priorities from none to high for + and -.
+var priorityCycle = []string{"", "low", "medium", "high"}
+
+// cyclePriority moves the item's priority one step up (dir > 0) or down
+// (dir < 0) through none, low, medium, and high, wrapping at either end.
+// An unrecognized priority counts as none.
+func cyclePriority(item *Item, dir int) {
+	i := max(slices.Index(priorityCycle, item.Priority), 0)
+	step := 1
+	if dir < 0 {
+		step = len(priorityCycle) - 1
+	}
+	item.Priority = priorityCycle[(i+step)%len(priorityCycle)]
+}
+
+// setStarred stars or unstars items and saves the change.
+func (m *Model) setStarred(items []*Item, starred bool) {
+	for _, item := range items {
+		item.Starred = starred
+		if m.triageStore != nil {
+			m.triageStore.SetStarred(item.ID, starred)
+		}
+	}
+	m.listView.SetItems(m.items)
+}
+
+// toggleBatchStar stars every selected item, or unstars them if all of them
+// are already starred.
+func (m *Model) toggleBatchStar() {
+	var items []*Item
+	on := false
+	for _, idx := range m.listView.GetSelected() {
+		if idx >= 0 && idx < len(m.items) {
+			items = append(items, &m.items[idx])
+			on = on || !m.items[idx].Starred
+		}
+	}
+	m.setStarred(items, on)
+}
+
+// toggleBatchStartFresh marks every selected item to have its reading
+// progress reset on update, or clears the mark if all of them already have it.
+func (m *Model) toggleBatchStartFresh() {
+	selected := m.listView.GetSelected()
+	on := false
+	for _, idx := range selected {
+		if idx >= 0 && idx < len(m.items) && !m.items[idx].StartFresh {
+			on = true
+		}
+	}
+	for _, idx := range selected {
+		if idx >= 0 && idx < len(m.items) {
+			m.items[idx].StartFresh = on
 		}
 	}
 	m.listView.SetItems(m.items)
@@ -857,16 +2434,240 @@ func (m *Model) applyBatchTags(tags []string) {
 	m.listView.SetItems(m.items)
 }
 
+// tagRemovals reports whether every tag is written as -name, returning the
+// names to remove from the selection instead of replacing its tags.
+func tagRemovals(tags []string) ([]string, bool) {
+	if len(tags) == 0 {
+		return nil, false
+	}
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		name := strings.TrimSpace(strings.TrimPrefix(tag, "-"))
+		if !strings.HasPrefix(tag, "-") || name == "" {
+			return nil, false
+		}
+		names = append(names, name)
+	}
+	return names, true
+}
+
+// removeTagFromItems deletes tag (case-insensitively) from the items at the
+// given indices, leaving their other tags alone. It returns how many items
+// had the tag.
+func (m *Model) removeTagFromItems(indices []int, tag string) int {
+	changed := 0
+	for _, idx := range indices {
+		if idx < 0 || idx >= len(m.items) {
+			continue
+		}
+		item := &m.items[idx]
+		kept := item.Tags[:0:0]
+		for _, t := range item.Tags {
+			if !strings.EqualFold(t, tag) {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) == len(item.Tags) {
+			continue
+		}
+		item.Tags = kept
+		m.saveTriage(item.ID, item.Action, item.Priority, item.Tags)
+		changed++
+	}
+	m.listView.SetItems(m.items)
+	return changed
+}
+
+// applyRename parses "from, to" input and renames the tag across all items.
+func (m *Model) applyRename(input string) {
+	tags := parseTags(input)
+	if len(tags) != 2 {
+		m.statusMessage = "Rename needs exactly two tags: old, new"
+		return
+	}
+	changed := m.renameTag(tags[0], tags[1])
+	m.statusMessage = fmt.Sprintf("Renamed %s to %s on %d items", tags[0], tags[1], changed)
+}
+
+// renameTag replaces tag from (case-insensitively) with to on every loaded
+// item, dropping from instead when an item already has to. It returns how
+// many items changed.
+func (m *Model) renameTag(from, to string) int {
+	if from == "" || to == "" || from == to {
+		return 0
+	}
+	changed := 0
+	for i := range m.items {
+		item := &m.items[i]
+		idx := -1
+		hasTarget := false
+		for j, t := range item.Tags {
+			if strings.EqualFold(t, from) && idx < 0 {
+				idx = j
+			} else if strings.EqualFold(t, to) && !strings.EqualFold(t, from) {
+				hasTarget = true
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		tags := make([]string, 0, len(item.Tags))
+		for j, t := range item.Tags {
+			switch {
+			case j == idx && !hasTarget:
+				tags = append(tags, to)
+			case strings.EqualFold(t, from):
+				// Drop duplicates of the old tag
+			default:
+				tags = append(tags, t)
+			}
+		}
+		if slices.Equal(tags, item.Tags) {
+			continue
+		}
+		item.Tags = tags
+		m.saveTriage(item.ID, item.Action, item.Priority, item.Tags)
+		changed++
+	}
+	m.listView.SetItems(m.items)
+	return changed
+}
+
+// applyNotes sets notes on the selected items in batch mode, else the current item.
+func (m *Model) applyNotes(notes string) {
+	if m.batchMode {
+		for _, idx := range m.listView.GetSelected() {
+			if idx >= 0 && idx < len(m.items) {
+				m.items[idx].Notes = notes
+				m.items[idx].NotesEdited = true
+			}
+		}
+	} else if item := m.listView.CurrentItem(); item != nil {
+		item.Notes = notes
+		item.NotesEdited = true
+	}
+	m.listView.SetItems(m.items)
+}
+
+// applyReason saves a triage reason on the selected items in batch mode,
+// else the current item. An empty reason clears it.
+func (m *Model) applyReason(reason string) {
+	var items []*Item
+	if m.batchMode {
+		for _, idx := range m.listView.GetSelected() {
+			if idx >= 0 && idx < len(m.items) {
+				items = append(items, &m.items[idx])
+			}
+		}
+	} else if item := m.listView.CurrentItem(); item != nil {
+		items = append(items, item)
+	}
+	for _, item := range items {
+		item.Reason = reason
+		if m.triageStore != nil {
+			m.triageStore.SetReason(item.ID, reason)
+		}
+	}
+	m.listView.SetItems(m.items)
+}
+
+// editingText reports whether the tag, notes, reason, or rename popup is open.
+// typedRunes returns the text a key message inserts into the tag editor:
+// one typed character or a whole paste. Pasted line breaks separate tags in
+// the tag editor and become spaces elsewhere; other control characters are
+// dropped.
+func (m *Model) typedRunes(msg tea.KeyMsg) []rune {
+	if msg.Alt {
+		return nil
+	}
+	if msg.Type == tea.KeySpace {
+		return []rune{' '}
+	}
+	if msg.Type != tea.KeyRunes {
+		return nil
+	}
+	lineBreak := ' '
+	if m.editingTags {
+		lineBreak = ','
+	}
+	typed := make([]rune, 0, len(msg.Runes))
+	for _, r := range strings.TrimRight(strings.ReplaceAll(string(msg.Runes), "\r\n", "\n"), "\n") {
+		switch {
+		case r == '\n' || r == '\r':
+			typed = append(typed, lineBreak)
+		case r == '\t':
+			typed = append(typed, ' ')
+		case r >= 32 && r != 127:
+			typed = append(typed, r)
+		}
+	}
+	return typed
+}
+
+func (m *Model) editingText() bool {
+	return m.editingTags || m.editingNotes || m.editingReason || m.renamingTag
+}
+
+// parseTags splits comma-separated tag input. Double-quoted segments may
+// contain commas and keep their spaces; \" is a literal quote.
 func parseTags(input string) []string {
-	parts := strings.Split(input, ",")
+	type tagRune struct {
+		r      rune
+		quoted bool
+	}
 	var tags []string
-	for _, p := range parts {
-		t := strings.TrimSpace(p)
-		if t != "" {
-			tags = append(tags, t)
+	var cur []tagRune
+	inQuotes := false
+
+	flush := func() {
+		// Trim only whitespace that was typed outside quotes
+		start, end := 0, len(cur)
+		for start < end && !cur[start].quoted && unicode.IsSpace(cur[start].r) {
+			start++
+		}
+		for end > start && !cur[end-1].quoted && unicode.IsSpace(cur[end-1].r) {
+			end--
+		}
+		var b strings.Builder
+		for _, tr := range cur[start:end] {
+			b.WriteRune(tr.r)
+		}
+		if b.Len() > 0 {
+			tags = append(tags, b.String())
+		}
+		cur = cur[:0]
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\'):
+			i++
+			cur = append(cur, tagRune{runes[i], true})
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == ',' && !inQuotes:
+			flush()
+		default:
+			cur = append(cur, tagRune{r, inQuotes})
+		}
+	}
+	flush()
+	return tags
+}
+
+// formatTags joins tags for the tag editor, quoting any that parseTags would
+// otherwise split or trim.
+func formatTags(tags []string) string {
+	parts := make([]string, len(tags))
+	for i, tag := range tags {
+		if strings.ContainsAny(tag, ",\"\\") || strings.TrimSpace(tag) != tag {
+			tag = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(tag) + `"`
 		}
+		parts[i] = tag
 	}
-	return tags
+	return strings.Join(parts, ", ")
 }
 
 // prevWordBoundary returns the cursor position at the start of the previous word.
@@ -907,16 +2708,97 @@ func nextWordBoundary(runes []rune, pos int) int {
 func (m *Model) setItemAction(item *Item, action string) {
 	item.Action = action
 	m.saveTriage(item.ID, item.Action, item.Priority, item.Tags)
+	m.enqueueAutoPush(item)
+	m.listView.SetItems(m.items)
+}
+
+// snoozeItem hides an item for snoozeDuration without changing anything in Readwise.
+func (m *Model) snoozeItem(item *Item) {
+	item.Action = "snooze"
+	item.Defaulted = false
+	if m.triageStore != nil {
+		m.triageStore.SnoozeItem(item.ID, time.Now().Add(snoozeDuration))
+	}
+	m.listView.SetItems(m.items)
+	m.cursor = m.listView.Cursor()
+	m.statusMessage = fmt.Sprintf("Snoozed %q for %d days", Truncate(item.Title, 40), int(snoozeDuration.Hours()/24))
+}
+
+// snoozeSelected snoozes all selected items.
+func (m *Model) snoozeSelected() {
+	selected := m.listView.GetSelected()
+	until := time.Now().Add(snoozeDuration)
+	for _, idx := range selected {
+		if idx >= 0 && idx < len(m.items) {
+			m.items[idx].Action = "snooze"
+			m.items[idx].Defaulted = false
+			if m.triageStore != nil {
+				m.triageStore.SnoozeItem(m.items[idx].ID, until)
+			}
+		}
+	}
 	m.listView.SetItems(m.items)
+	m.cursor = m.listView.Cursor()
+	m.batchMode = len(m.listView.GetSelected()) > 0
+	m.statusMessage = fmt.Sprintf("Snoozed %s items for %d days", formatCount(len(selected)), int(snoozeDuration.Hours()/24))
+}
+
+// itemVisible reports whether an item should be shown in the review list.
+func (m *Model) itemVisible(item Item) bool {
+	if item.Action == "snooze" {
+		return false
+	}
+	if m.hideFinished && item.Progress > finishedProgress {
+		return false
+	}
+	return matchesFilter(item, m.filterQuery)
 }
 
 func (m *Model) setItemPriority(item *Item, priority string) {
 	item.Priority = priority
 	m.saveTriage(item.ID, item.Action, item.Priority, item.Tags)
+	m.enqueueAutoPush(item)
 	m.listView.SetItems(m.items)
 }
 
 func (m *Model) handleConfirmingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.pendingQuit {
+		return m, m.handleQuitKeys(msg)
+	}
+	if m.pendingRefresh {
+		return m, m.handleRefreshKeys(msg)
+	}
+	if m.pendingOpen != nil {
+		urls := m.pendingOpen
+		switch msg.String() {
+		case "y", "Y":
+			m.pendingOpen = nil
+			m.statusMessage = ""
+			m.state = StateReviewing
+			m.openURLs(urls)
+		case "n", "N", "esc":
+			m.pendingOpen = nil
+			m.statusMessage = ""
+			m.state = StateReviewing
+		}
+		return m, nil
+	}
+	if m.pendingArchive != nil {
+		ids := m.pendingArchive
+		switch msg.String() {
+		case "y", "Y":
+			m.pendingArchive = nil
+			m.state = StateReviewing
+			m.archiveUntriaged(ids)
+			return m, m.startAutoPush()
+		case "n", "N", "esc":
+			m.pendingArchive = nil
+			m.statusMessage = ""
+			m.state = StateReviewing
+		}
+		return m, nil
+	}
+
 	switch msg.String() {
 	case "y", "Y":
 		return m, m.startUpdating()
@@ -926,8 +2808,33 @@ func (m *Model) handleConfirmingKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleDoneKeys handles the post-update screen: r (or enter) re-fetches and
+// returns to review, q quits. With failures listed, r retries them instead.
 func (m *Model) handleDoneKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	return m, m.startFetching()
+	if keyMatches(msg, m.keys.Quit) {
+		return m, m.requestQuit(msg)
+	}
+	if len(m.updateFailures) > 0 {
+		switch {
+		case msg.String() == "r":
+			return m, m.retryFailedUpdates()
+		case keyMatches(msg, m.keys.Down):
+			if m.failureOffset+maxFailureRows < len(m.updateFailures) {
+				m.failureOffset++
+			}
+			return m, nil
+		case keyMatches(msg, m.keys.Up):
+			if m.failureOffset > 0 {
+				m.failureOffset--
+			}
+			return m, nil
+		}
+	}
+	switch {
+	case msg.String() == "r", keyMatches(msg, m.keys.Enter):
+		return m, m.startFetching()
+	}
+	return m, nil
 }
 
 func (m *Model) handleMessageKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -940,13 +2847,152 @@ func (m *Model) applySavedTriages() {
 	if m.triageStore == nil {
 		return
 	}
+	m.applySavedTriagesTo(m.items)
+	m.sortUncertainFirst()
+}
+
+// applySavedTriagesTo restores stored decisions onto items.
+func (m *Model) applySavedTriagesTo(items []Item) {
+	now := time.Now()
+	for i := range items {
+		if entry, ok := m.triageStore.GetItem(items[i].ID); ok {
+			items[i].Starred = entry.Starred
+			items[i].Reason = entry.Reason
+			// Expired snoozes aren't restored, so the item shows up
+			// untriaged; neither are entries that only hold a star or a
+			// reason, which keep any default_action pre-fill
+			if entry.Action == "" || entry.Source == "snooze" && !entry.Snoozed(now) {
+				continue
+			}
+			items[i].Action = entry.Action
+			items[i].Defaulted = false
+			// Keep the priority fetched from Readwise unless one was set
+			// locally; a default_priority never overrides a stored decision.
+			if entry.Priority != "" {
+				items[i].Priority = entry.Priority
+			} else if _, def := m.fetchDefaults(); def != "" && items[i].RemotePriority == "" {
+				items[i].Priority = ""
+			}
+			items[i].Tags = entry.Tags
+			items[i].TriagedAt = entry.TriagedAt
+		}
+	}
+}
+
+// archiveRead marks items read past autoArchiveProgress as archive when they
+// have no stored decision, saving them with source "auto-progress" so a
+// manual or LLM decision can replace them. It returns how many were marked.
+func (m *Model) archiveRead() int {
+	marked := 0
+	for i := range m.items {
+		item := &m.items[i]
+		if item.Progress <= autoArchiveProgress {
+			continue
+		}
+		if m.triageStore != nil && m.triageStore.HasTriaged(item.ID) {
+			continue
+		}
+		item.Action = "archive"
+		if m.triageStore != nil {
+			m.triageStore.SetItem(item.ID, item.Action, item.Priority, "auto-progress", item.Tags, nil)
+			m.markTriaged(item.ID)
+		}
+		marked++
+	}
+	if marked > 0 {
+		m.sortUncertainFirst()
+	}
+	return marked
+}
+
+// archiveShort marks items shorter than auto_archive_under_words as archive
+// when they have no stored decision, saving them with source "auto-short" so
+// a manual or LLM decision can replace them. Items without a word count are
+// left alone. It returns how many were marked.
+func (m *Model) archiveShort() int {
+	if m.cfg == nil || m.cfg.AutoArchiveUnderWords <= 0 {
+		return 0
+	}
+	marked := 0
 	for i := range m.items {
-		if entry, ok := m.triageStore.GetItem(m.items[i].ID); ok {
-			m.items[i].Action = entry.Action
-			m.items[i].Priority = entry.Priority
-			m.items[i].Tags = entry.Tags
+		item := &m.items[i]
+		if item.WordCount <= 0 || item.WordCount >= m.cfg.AutoArchiveUnderWords || item.Action == "archive" {
+			continue
+		}
+		if m.triageStore != nil && m.triageStore.HasTriaged(item.ID) {
+			continue
+		}
+		item.Action = "archive"
+		if m.triageStore != nil {
+			m.triageStore.SetItem(item.ID, item.Action, item.Priority, "auto-short", item.Tags, nil)
+			m.markTriaged(item.ID)
 		}
+		marked++
 	}
+	if marked > 0 {
+		m.sortUncertainFirst()
+	}
+	return marked
+}
+
+// triageRank orders items for review_uncertain_first: needs_review, then
+// decided items by priority, then untriaged items.
+func triageRank(item Item) int {
+	if item.Action == "needs_review" {
+		return 0
+	}
+	if item.Action == "" {
+		return 5
+	}
+	switch item.Priority {
+	case "high":
+		return 1
+	case "medium":
+		return 2
+	case "low":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// sortUncertainFirst reorders m.items by triageRank when review_uncertain_first
+// is set, keeping the original order within each group and keeping the
+// focused and selected items.
+func (m *Model) sortUncertainFirst() {
+	if m.cfg == nil || !m.cfg.ReviewUncertainFirst {
+		return
+	}
+	focusedID := ""
+	if item := m.listView.CurrentItem(); item != nil {
+		focusedID = item.ID
+	}
+	selected := make(map[string]bool)
+	for _, idx := range m.listView.GetSelected() {
+		if idx >= 0 && idx < len(m.items) {
+			selected[m.items[idx].ID] = true
+		}
+	}
+
+	slices.SortStableFunc(m.items, func(a, b Item) int {
+		return triageRank(a) - triageRank(b)
+	})
+
+	var indices []int
+	for i, item := range m.items {
+		if selected[item.ID] {
+			indices = append(indices, i)
+		}
+	}
+	m.listView.SetItems(m.items)
+	m.listView.SetSelection(indices)
+	for row := 0; row < m.listView.VisibleCount(); row++ {
+		if idx := m.listView.ItemIndex(row); idx >= 0 && m.items[idx].ID == focusedID {
+			m.listView.SetCursor(row)
+			break
+		}
+	}
+	m.cursor = m.listView.Cursor()
 }
 
 func (m *Model) saveTriage(id, action, priority string, tags []string) {
@@ -954,6 +3000,7 @@ func (m *Model) saveTriage(id, action, priority string, tags []string) {
 		return
 	}
 	m.triageStore.SetItem(id, action, priority, "manual", tags, nil)
+	m.markTriaged(id)
 }
 
 func (m *Model) saveLLMTriage(id, action, priority string, tags []string, report *triage.Result) {
@@ -961,6 +3008,24 @@ func (m *Model) saveLLMTriage(id, action, priority string, tags []string, report
 		return
 	}
 	m.triageStore.SetItem(id, action, priority, "llm", tags, report)
+	m.markTriaged(id)
+}
+
+// markTriaged stamps the item with the current time after a save, clears a
+// pre-filled default action, and remembers that the decision hasn't been
+// pushed yet.
+func (m *Model) markTriaged(id string) {
+	if m.unpushed == nil {
+		m.unpushed = make(map[string]bool)
+	}
+	m.unpushed[id] = true
+	now := time.Now().Format(time.RFC3339)
+	for i := range m.items {
+		if m.items[i].ID == id {
+			m.items[i].TriagedAt = now
+			m.items[i].Defaulted = false
+		}
+	}
 }
 
 func (m *Model) configView() string {
@@ -971,12 +3036,15 @@ func (m *Model) configView() string {
 		Render("  Readwise Triage")
 
 	// Theme indicator
-	themeName := m.cfg.Theme
-	if themeName == "" {
-		themeName = "default"
-	}
+	themeName := GetThemeNames()[m.themeIndex]
 	themeLine := fmt.Sprintf("  🎨  %s", m.styles.Normal.Render("Theme: "+themeName))
 
+	// Profile indicator (only shown when a profile is active)
+	var profileLine string
+	if m.cfg.Profile != "" {
+		profileLine = fmt.Sprintf("  👤  %s", m.styles.Normal.Render("Profile: "+m.cfg.Profile))
+	}
+
 	// Location indicator
 	locationLabel := "Inbox"
 	if m.fetchLocation == "feed" {
@@ -988,19 +3056,61 @@ func (m *Model) configView() string {
 	var daysLine string
 	if m.editingDays {
 		daysLine = fmt.Sprintf("  📅  %s", m.styles.Normal.Render("Days: "+m.daysInput+"▌"))
+		if m.daysInput != "" {
+			if _, hint := parseDaysInput(m.daysInput); hint != "" {
+				daysLine += "  " + m.styles.Help.Render(hint)
+			}
+		}
+	} else if m.weekWindow > 0 {
+		start, end := weekBounds(time.Now(), m.weekWindow)
+		daysLine = fmt.Sprintf("  📅  %s", m.styles.Normal.Render("Week "+formatWindow(start, end)))
+	} else if since := m.fetchSince(); !since.IsZero() {
+		daysLine = fmt.Sprintf("  📅  %s", m.styles.Normal.Render("Since last fetch ("+since.Local().Format("Jan 2 15:04")+")"))
+	} else if m.sinceLast {
+		daysLine = fmt.Sprintf("  📅  %s", m.styles.Normal.Render(fmt.Sprintf("Fetch last %d days (no previous fetch)", m.activeLookback())))
 	} else {
 		daysLine = fmt.Sprintf("  📅  %s", m.styles.Normal.Render(fmt.Sprintf("Fetch last %d days", m.activeLookback())))
 	}
 
-	content := lipgloss.JoinVertical(lipgloss.Left,
-		"",
-		title,
-		"",
-		themeLine,
-		locationLine,
-		daysLine,
-		"",
-	)
+	lines := []string{"", title, ""}
+	if profileLine != "" {
+		lines = append(lines, profileLine)
+	}
+	limitLine := fmt.Sprintf("  🔢  %s", m.styles.Normal.Render("Items: all"))
+	if limit := m.fetchLimit(); limit > 0 {
+		limitLine = fmt.Sprintf("  🔢  %s", m.styles.Normal.Render(fmt.Sprintf("Items: first %d", limit)))
+	}
+
+	lines = append(lines, themeLine, locationLine, daysLine, limitLine)
+	if m.presetName != "" {
+		lines = append(lines, fmt.Sprintf("  ⭐  %s", m.styles.Normal.Render("Preset: "+m.presetName)))
+	}
+	if category := m.fetchCategory(); category != "" {
+		lines = append(lines, fmt.Sprintf("  🗂️  %s", m.styles.Normal.Render("Category: "+category)))
+	}
+	if tag := m.fetchTag(); tag != "" {
+		lines = append(lines, fmt.Sprintf("  🔖  %s", m.styles.Normal.Render("Tag: "+tag)))
+	}
+	lines = append(lines, fmt.Sprintf("  🤖  %s", m.styles.Normal.Render("Provider: "+m.llmLabel())))
+	lines = append(lines, m.llmPickerLines()...)
+	if m.resumeSession != nil {
+		lines = append(lines, "", m.resumeLine())
+	}
+	if m.choosingPreset {
+		lines = append(lines, "", m.styles.Normal.Render("  Fetch presets:"))
+		for i, name := range m.cfg.PresetNames() {
+			lines = append(lines, fmt.Sprintf("   %s  %s  %s", m.styles.HelpKey.Render(strconv.Itoa(i+1)), m.styles.Normal.Render(name), m.styles.Help.Render(describePreset(m.cfg.Presets[name]))))
+		}
+	}
+	if m.tokenStatus != "" {
+		style := m.styles.Error
+		if m.tokenOK {
+			style = m.styles.Success
+		}
+		lines = append(lines, fmt.Sprintf("  🔑  %s", style.Render(m.tokenStatus)))
+	}
+	lines = append(lines, "")
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
 
 	// Error display
 	if m.statusMessage != "" {
@@ -1009,14 +3119,30 @@ func (m *Model) configView() string {
 	}
 
 	// Help
-	help := m.renderHelpLine([]helpEntry{
+	entries := []helpEntry{
 		{"enter", "start"},
 		{"h/l", "location"},
 		{"j/k", "days ±7"},
 		{"0-9", "type days"},
-		{"t", "theme"},
-		{"q", "quit"},
-	})
+		{"s", "since last fetch"},
+		{"L", "item limit"},
+		{"c", "category"},
+	}
+	if m.cfg != nil && len(m.cfg.Presets) > 0 {
+		entries = append(entries, helpEntry{"p", "presets"})
+	}
+	entries = append(entries, helpEntry{"P", "llm provider"}, helpEntry{"V", "verify token"}, helpEntry{"t", "theme"}, helpEntry{"q", "quit"})
+	switch {
+	case m.resumeSession != nil:
+		entries = []helpEntry{{"y", "resume session"}, {"n", "start fresh"}}
+	case m.choosingPreset:
+		entries = []helpEntry{{"1-9", "fetch with preset"}, {"esc", "cancel"}}
+	case m.choosingLLM:
+		entries = []helpEntry{{fmt.Sprintf("1-%d", len(llmPickerProviders())), "pick provider"}, {"esc", "cancel"}}
+	case m.llmInputField != "":
+		entries = []helpEntry{{"enter", "next"}, {"esc", "cancel"}}
+	}
+	help := m.renderHelpLine(entries)
 
 	card := m.styles.Card.Render(content)
 
@@ -1052,7 +3178,8 @@ func (m *Model) fetchingView() string {
 
 func (m *Model) triagingView() string {
 	spinnerView := m.spinner.View()
-	status := fmt.Sprintf("%s Processing with LLM...", spinnerView)
+	elapsed := time.Since(m.triageStarted).Round(time.Second)
+	status := fmt.Sprintf("%s Processing with LLM... %s", spinnerView, elapsed)
 
 	content := m.styles.Border.Render(
 		lipgloss.JoinVertical(lipgloss.Center,
@@ -1062,7 +3189,7 @@ func (m *Model) triagingView() string {
 		),
 	)
 
-	help := m.renderHelpLine([]helpEntry{{"q", "cancel"}})
+	help := m.renderHelpLine([]helpEntry{{"esc", "cancel"}, {"q", "quit"}})
 	return lipgloss.JoinVertical(lipgloss.Center, "", content, "", help)
 }
 
@@ -1073,7 +3200,13 @@ func (m *Model) reviewingView() string {
 		locationTag = "[Feed]"
 	}
 	headerLeft := m.styles.HelpKey.Render("Readwise Triage " + locationTag)
-	countText := m.styles.HelpDesc.Render(fmt.Sprintf("%d/%d", m.cursor+1, len(m.items)))
+	countText := m.autoPushStatus() + m.styles.HelpDesc.Render(formatCount(m.cursor+1)+"/"+formatCount(m.listView.VisibleCount()))
+	if m.hideFinished {
+		countText = m.styles.HelpDesc.Render("finished hidden  ") + countText
+	}
+	if m.filterQuery != "" {
+		countText = m.styles.Highlight.Render("filter: "+Truncate(m.filterQuery, 30)+"  ") + countText
+	}
 	if m.batchMode {
 		selectedCount := len(m.listView.GetSelected())
 		countText += m.styles.Highlight.Render(fmt.Sprintf("  ● %d selected", selectedCount))
@@ -1089,15 +3222,15 @@ func (m *Model) reviewingView() string {
 
 	// Table
 	var list string
-	if len(m.items) == 0 {
-		list = m.styles.Normal.Render("  No items to review")
+	if m.listView.VisibleCount() == 0 {
+		list = m.emptyListView()
 	} else {
 		list = m.listView.View()
 	}
 
 	// Detail pane (simple padded text, no border)
 	detail := ""
-	if !m.editingTags && len(m.items) > 0 {
+	if !m.editingText() && !m.listView.Compact() && m.listView.VisibleCount() > 0 {
 		detailContent := m.listView.DetailView(m.width, m.styles)
 		if detailContent != "" {
 			divW := m.width - 1
@@ -1109,15 +3242,18 @@ func (m *Model) reviewingView() string {
 		}
 	}
 
-	// Status message
+	// Status message, or the filter prompt while it is open
 	var statusLine string
-	if m.statusMessage != "" {
+	if m.editingFilter {
+		statusLine = m.styles.Normal.Render("  /"+m.filterInput+"▌") + "  " +
+			m.renderHelpLine([]helpEntry{{"enter", "apply"}, {"esc", "cancel"}, {"", "e.g. source:substack.com"}})
+	} else if m.statusMessage != "" {
 		statusLine = m.styles.Help.Render("  " + m.statusMessage)
 	}
 
 	// Help overlay or footer (hidden during tag editing)
 	var footer string
-	if !m.editingTags {
+	if !m.editingText() {
 		if m.showHelp {
 			footer = m.renderFullHelp()
 		} else {
@@ -1139,46 +3275,38 @@ func (m *Model) reviewingView() string {
 	content := strings.Join(parts, "\n")
 
 	// Tag editing popup — overlaid on top of the review view
-	if m.editingTags && m.height > 0 {
+	if m.editingText() && m.height > 0 {
 		runes := []rune(m.tagsInput)
 		before := string(runes[:m.tagsCursor])
 		after := string(runes[m.tagsCursor:])
-		inputLine := fmt.Sprintf("tags: %s▌%s", before, after)
-		helpLine := m.renderHelpLine([]helpEntry{{"enter", "confirm"}, {"esc", "cancel"}, {"←/→", "move"}, {"opt+←/→", "word"}})
+		popupTitle, label := "Edit Tags", "tags"
+		if m.editingNotes {
+			popupTitle, label = "Edit Notes", "notes"
+		} else if m.editingReason {
+			popupTitle, label = "Why this decision (kept locally)", "reason"
+		} else if m.renamingTag {
+			popupTitle, label = "Rename Tag (all items)", "old, new"
+		}
+		inputLine := fmt.Sprintf("%s: %s▌%s", label, before, after)
+		entries := []helpEntry{{"enter", "confirm"}, {"esc", "cancel"}, {"←/→", "move"}, {"opt+←/→", "word"}}
+		if m.editingTags && m.batchMode {
+			entries = append(entries, helpEntry{"-tag", "remove from selection"})
+		}
+		helpLine := m.renderHelpLine(entries)
 		popup := m.styles.Card.Render(
 			lipgloss.JoinVertical(lipgloss.Left,
-				m.styles.Title.Render("Edit Tags"),
+				m.styles.Title.Render(popupTitle),
 				"",
 				m.styles.Normal.Render(inputLine),
 				"",
 				helpLine,
 			),
 		)
-
-		// Split both the background and popup into lines
-		bgLines := strings.Split(content, "\n")
-		for len(bgLines) < m.height {
-			bgLines = append(bgLines, "")
-		}
-		bgLines = bgLines[:m.height]
-
-		popupLines := strings.Split(popup, "\n")
-		popupH := len(popupLines)
-
-		w := m.width - 1
-		if w < 1 {
-			w = 1
-		}
-
-		// Center the popup lines horizontally and stamp them over the background
-		startY := (m.height - popupH) / 2
-		for i, pLine := range popupLines {
-			row := startY + i
-			if row >= 0 && row < m.height {
-				bgLines[row] = lipgloss.PlaceHorizontal(w, lipgloss.Center, pLine)
-			}
+		// Fall back to the bare input line when the card doesn't fit
+		if lipgloss.Height(popup) > m.height || lipgloss.Width(popup) > m.width {
+			popup = m.styles.Normal.Render(inputLine)
 		}
-		content = strings.Join(bgLines, "\n")
+		content = m.overlayPopup(content, popup)
 	}
 
 	// Pad output to exactly m.height lines so the alternate screen buffer
@@ -1193,12 +3321,140 @@ func (m *Model) reviewingView() string {
 	return content
 }
 
+// emptyListView explains why the review list is empty and what to try next.
+func (m *Model) emptyListView() string {
+	var title string
+	var hints []helpEntry
+	if len(m.items) > 0 {
+		title = fmt.Sprintf("No items to show (%d hidden)", len(m.items))
+		if m.filterQuery != "" {
+			hints = append(hints, helpEntry{"/", "then enter on an empty filter to clear it"})
+		}
+		if m.hideFinished {
+			hints = append(hints, helpEntry{"H", "show finished items"})
+		}
+	} else {
+		location, other := "Inbox", "Feed"
+		if m.fetchLocation == "feed" {
+			location, other = "Feed", "Inbox"
+		}
+		title = fmt.Sprintf("No %s items %s", location, m.fetchWindowLabel())
+		hints = append(hints,
+			helpEntry{"f", fmt.Sprintf("fetch 7 more days (%d → %d)", m.activeLookback(), m.activeLookback()+7)},
+			helpEntry{"esc", fmt.Sprintf("back to config, ←/→ to switch to %s, enter to fetch", other)},
+			helpEntry{"R", "refresh"},
+		)
+	}
+
+	lines := []string{m.styles.Normal.Render("  " + title), ""}
+	for _, h := range hints {
+		lines = append(lines, "  "+m.renderHelpLine([]helpEntry{h}))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// fetchWindowLabel describes the range of the last fetch, e.g. "from the last 7 days".
+func (m *Model) fetchWindowLabel() string {
+	if m.weekWindow > 0 {
+		start, end := weekBounds(time.Now(), m.weekWindow)
+		return "from " + formatWindow(start, end)
+	}
+	if since := m.fetchSince(); !since.IsZero() {
+		return "since " + since.Local().Format("Jan 2 15:04")
+	}
+	return fmt.Sprintf("from the last %d days", m.activeLookback())
+}
+
+// overlayPopup stamps popup over content, centered and clipped to the
+// terminal so tiny windows never index past the screen.
+func (m *Model) overlayPopup(content, popup string) string {
+	bgLines := strings.Split(content, "\n")
+	for len(bgLines) < m.height {
+		bgLines = append(bgLines, "")
+	}
+	bgLines = bgLines[:m.height]
+
+	popupLines := strings.Split(popup, "\n")
+	if len(popupLines) > m.height {
+		popupLines = popupLines[:m.height]
+	}
+
+	w := m.width - 1
+	if w < 1 {
+		w = 1
+	}
+	clip := lipgloss.NewStyle().MaxWidth(w)
+
+	startY := (m.height - len(popupLines)) / 2
+	for i, pLine := range popupLines {
+		bgLines[startY+i] = lipgloss.PlaceHorizontal(w, lipgloss.Center, clip.Render(pLine))
+	}
+	return strings.Join(bgLines, "\n")
+}
+
+// maxTagChangeRows limits how many tag changes the confirmation lists.
+const maxTagChangeRows = 5
+
 func (m *Model) confirmingView() string {
+	if m.pendingQuit {
+		return m.confirmView("Quit", []string{
+			m.styles.Normal.Render(m.statusMessage),
+			m.styles.Help.Render("They are saved locally; push them with u next time"),
+		})
+	}
+	if m.pendingRefresh {
+		return m.refreshConfirmView()
+	}
+	if m.pendingOpen != nil {
+		return m.confirmView(m.pendingOpenTitle, []string{m.styles.Normal.Render(m.statusMessage)})
+	}
+	if m.pendingArchive != nil {
+		return m.confirmView("Archive Untriaged", []string{
+			m.styles.Normal.Render(m.statusMessage),
+			m.styles.Help.Render("Items you already decided on are left alone"),
+		})
+	}
+
+	count := len(m.buildUpdates())
+	noun := "updates"
+	if count == 1 {
+		noun = "update"
+	}
+	lines := []string{m.styles.Normal.Render(fmt.Sprintf("Push %s %s to Readwise?", formatCount(count), noun))}
+
+	if changes := m.tagChanges(); len(changes) > 0 {
+		noun = "items"
+		if len(changes) == 1 {
+			noun = "item"
+		}
+		lines = append(lines, "",
+			m.styles.Error.Render(fmt.Sprintf("⚠ %s %s will have their tags replaced", formatCount(len(changes)), noun)),
+			m.styles.Help.Render("Tag edits made on Readwise since fetching will be lost"),
+		)
+		for i, c := range changes {
+			if i == maxTagChangeRows {
+				lines = append(lines, m.styles.Help.Render(fmt.Sprintf("…and %d more", len(changes)-i)))
+				break
+			}
+			var diff []string
+			for _, t := range c.Added {
+				diff = append(diff, "+"+t)
+			}
+			for _, t := range c.Removed {
+				diff = append(diff, "-"+t)
+			}
+			lines = append(lines, m.styles.Normal.Render(Truncate(c.Title, 30))+" "+m.styles.HelpDesc.Render(Truncate(strings.Join(diff, " "), 40)))
+		}
+	}
+
+	return m.confirmView("Confirm Update", lines)
+}
+
+// confirmView renders a y/n confirmation card.
+func (m *Model) confirmView(title string, lines []string) string {
 	content := m.styles.Border.Render(
 		lipgloss.JoinVertical(lipgloss.Center,
-			m.styles.Title.Render("Confirm Update"),
-			"",
-			m.styles.Normal.Render("Push changes to Readwise?"),
+			append([]string{m.styles.Title.Render(title), ""}, lines...)...,
 		),
 	)
 
@@ -1214,10 +3470,14 @@ func (m *Model) updatingView() string {
 	spinnerView := m.spinner.View()
 	progressBar := m.progress.View()
 	pctText := fmt.Sprintf("%.0f%%", m.updateProgress*100)
+	title := "Updating Readwise"
+	if m.state == StateImporting {
+		title = "Importing Results"
+	}
 
 	content := m.styles.Border.Render(
 		lipgloss.JoinVertical(lipgloss.Center,
-			m.styles.Title.Render("Updating Readwise"),
+			m.styles.Title.Render(title),
 			"",
 			fmt.Sprintf("%s %s  %s", spinnerView, m.styles.Normal.Render(m.statusMessage), m.styles.Help.Render(pctText)),
 			"",
@@ -1228,16 +3488,58 @@ func (m *Model) updatingView() string {
 	return lipgloss.JoinVertical(lipgloss.Center, "", content)
 }
 
+// maxFailureRows is the number of failed updates shown at once in the done view.
+const maxFailureRows = 8
+
 func (m *Model) doneView() string {
-	content := m.styles.Border.Render(
-		lipgloss.JoinVertical(lipgloss.Center,
-			m.styles.Success.Render("✓ Complete"),
-			"",
-			m.styles.Normal.Render(m.statusMessage),
-		),
-	)
+	lines := []string{
+		m.styles.Success.Render("✓ Complete"),
+		"",
+		m.styles.Normal.Render(m.statusMessage),
+	}
+
+	if len(m.updateFailures) > 0 {
+		titles := make(map[string]string, len(m.items))
+		for _, item := range m.items {
+			titles[item.ID] = item.Title
+			for _, id := range item.DuplicateIDs {
+				titles[id] = item.Title
+			}
+		}
+
+		end := m.failureOffset + maxFailureRows
+		if end > len(m.updateFailures) {
+			end = len(m.updateFailures)
+		}
+
+		var failLines []string
+		for _, f := range m.updateFailures[m.failureOffset:end] {
+			title := titles[f.ID]
+			if title == "" {
+				title = f.ID
+			}
+			failLines = append(failLines, m.styles.Error.Render("✗ "+Truncate(title, 40))+" "+m.styles.HelpDesc.Render(Truncate(f.Error, 50)))
+		}
+		if len(m.updateFailures) > maxFailureRows {
+			failLines = append(failLines, m.styles.Help.Render(fmt.Sprintf("%d-%d of %d failures", m.failureOffset+1, end, len(m.updateFailures))))
+		}
+
+		lines = append(lines, "", m.styles.Error.Render("Failed updates:"))
+		lines = append(lines, failLines...)
+	}
+
+	// Left-align when listing failures so the error column lines up
+	align := lipgloss.Center
+	if len(m.updateFailures) > 0 {
+		align = lipgloss.Left
+	}
+	content := m.styles.Border.Render(lipgloss.JoinVertical(align, lines...))
 
-	help := m.renderHelpLine([]helpEntry{{"any key", "back to review"}})
+	entries := []helpEntry{{"r", "keep reviewing"}, {"q", "quit"}}
+	if len(m.updateFailures) > 0 {
+		entries = []helpEntry{{"r", "retry failed"}, {"j/k", "scroll"}, {"enter", "keep reviewing"}, {"q", "quit"}}
+	}
+	help := m.renderHelpLine(entries)
 	return lipgloss.JoinVertical(lipgloss.Center, "", content, "", help)
 }
 
@@ -1290,14 +3592,14 @@ func (m *Model) renderReviewFooter() string {
 		line1 = []helpEntry{
 			{"j/k", "navigate"},
 			{"x", "deselect"},
-			{"r l a d n", "action"},
+			{"r l a d n z", "action"},
 			{"1 2 3", "priority"},
 		}
 	} else {
 		line1 = []helpEntry{
 			{"j/k", "navigate"},
 			{"x", "select"},
-			{"r l a d n", "action"},
+			{"r l a d n z", "action"},
 			{"1 2 3", "priority"},
 		}
 	}
@@ -1308,6 +3610,12 @@ func (m *Model) renderReviewFooter() string {
 		{"i", "import"},
 		{"T", "auto-triage"},
 		{"o", "open"},
+		{"O", "reader"},
+		{"N", "open review"},
+		{"c", "notes"},
+		{"H", "hide done"},
+		{"C", "compact"},
+		{"/", "filter"},
 		{"f", "more"},
 		{"R", "refresh"},
 		{"u", "update"},
@@ -1330,6 +3638,9 @@ func (m *Model) renderFullHelp() string {
 			{"j / ↓", "move down"},
 			{"k / ↑", "move up"},
 			{"x / space", "toggle select"},
+			{"4-9 …", "count: 5j moves 5, 4a archives 4 (1-3 set priority)"},
+			{"# …", "count from any digit: #3a archives 3"},
+			{"' …", "jump to the next title starting with the letters typed"},
 		}},
 		{"Triage Actions", []helpEntry{
 			{"r", "read now"},
@@ -1337,21 +3648,46 @@ func (m *Model) renderFullHelp() string {
 			{"a", "archive"},
 			{"d", "delete"},
 			{"n", "needs review"},
+			{"z", "snooze (hide 7 days)"},
+			{"0", "start fresh (reset progress on update)"},
+			{"*", "star / unstar (kept through any action)"},
 		}},
 		{"Priority", []helpEntry{
 			{"1", "high"},
 			{"2", "medium"},
 			{"3", "low"},
+			{"+ / -", "raise / lower (wraps through none)"},
 		}},
 		{"Operations", []helpEntry{
 			{"enter", "edit tags"},
+			{"c", "edit notes (comment)"},
+			{"y", "note why you triaged it (reason, kept locally)"},
 			{"e", "export to clipboard"},
+			{"E", "export all with decisions (second opinion)"},
+			{"Y", "copy the focused item's export"},
+			{"ctrl+e", "export to a temp file (large exports)"},
 			{"i", "import from clipboard"},
+			{"I", "retry import results not yet matched"},
 			{"T", "auto-triage with LLM"},
+			{"ctrl+t", "re-triage focused item with LLM"},
 			{"o", "open URL in browser"},
+			{"O", "open in Readwise Reader"},
+			{"v", "preview full content (scroll with j/k)"},
+			{"w", "why: show the stored LLM reasoning"},
+			{"N", "open all needs_review URLs (max 10)"},
+			{"W", "open all read_now items in Reader (max 10)"},
+			{"A", "archive all visible untriaged items"},
+			{"H", "hide finished (>90% read)"},
+			{"C", "toggle compact one-line list"},
+			{"S", "toggle short titles without site names"},
+			{"/", "filter (source:<site>, author:<name>, title text)"},
+			{"ctrl+r", "rename a tag on all items"},
 			{"u", "update Readwise"},
-			{"f", "fetch more (+7 days)"},
+			{"U", "update Readwise without confirming"},
+			{"f", "fetch the next page, or 7 more days"},
+			{"[ / ]", "previous / next week"},
 			{"R", "refresh from Readwise"},
+			{"F", "re-fetch the focused item's metadata"},
 		}},
 		{"General", []helpEntry{
 			{"?", "toggle this help"},
@@ -1382,7 +3718,12 @@ func keyMatches(msg tea.KeyMsg, target key.Binding) bool {
 	return false
 }
 
-func openURL(url string) error {
+// openURL opens a URL in the default browser. It is a variable so tests can stub it.
+var openURL = func(url string) error {
+	if url == "" {
+		return fmt.Errorf("no URL to open")
+	}
+
 	var cmd string
 	var args []string
 
@@ -1403,18 +3744,7 @@ func openURL(url string) error {
 // buildTriageItemsJSON builds the JSON payload for LLM triage.
 // Selection-aware: uses selected items if any, otherwise untriaged items.
 func (m *Model) buildTriageItemsJSON() (string, error) {
-	type exportItem struct {
-		ID          string `json:"id"`
-		Title       string `json:"title"`
-		URL         string `json:"url"`
-		Summary     string `json:"summary"`
-		Category    string `json:"category"`
-		Source      string `json:"source"`
-		WordCount   int    `json:"word_count"`
-		ReadingTime string `json:"reading_time"`
-	}
-
-	var items []exportItem
+	var items []Item
 	selectedIndices := m.listView.GetSelected()
 	useSelection := len(selectedIndices) > 0
 
@@ -1430,12 +3760,38 @@ func (m *Model) buildTriageItemsJSON() (string, error) {
 			if !isSelected {
 				continue
 			}
-		} else if item.Action != "" {
-			// Skip already-triaged items when no selection
+		} else if item.Action != "" && !item.Defaulted {
+			// Skip already-triaged items when no selection; a pre-filled
+			// default_action isn't a decision
 			continue
 		}
 
-		items = append(items, exportItem{
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return "", fmt.Errorf("no items to triage (all items already triaged)")
+	}
+
+	return triageItemsJSON(items)
+}
+
+// triageItemsJSON marshals items into the shape sent to the LLM.
+func triageItemsJSON(items []Item) (string, error) {
+	type exportItem struct {
+		ID          string `json:"id"`
+		Title       string `json:"title"`
+		URL         string `json:"url"`
+		Summary     string `json:"summary"`
+		Category    string `json:"category"`
+		Source      string `json:"source"`
+		WordCount   int    `json:"word_count"`
+		ReadingTime string `json:"reading_time"`
+	}
+
+	exported := make([]exportItem, len(items))
+	for i, item := range items {
+		exported[i] = exportItem{
 			ID:          item.ID,
 			Title:       item.Title,
 			URL:         item.URL,
@@ -1444,14 +3800,10 @@ func (m *Model) buildTriageItemsJSON() (string, error) {
 			Source:      item.Source,
 			WordCount:   item.WordCount,
 			ReadingTime: item.ReadingTime,
-		})
-	}
-
-	if len(items) == 0 {
-		return "", fmt.Errorf("no items to triage (all items already triaged)")
+		}
 	}
 
-	data, err := json.MarshalIndent(items, "", "  ")
+	data, err := json.MarshalIndent(exported, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal items: %w", err)
 	}
@@ -1459,6 +3811,26 @@ func (m *Model) buildTriageItemsJSON() (string, error) {
 	return string(data), nil
 }
 
+// applyAltResults records the secondary provider's decisions for display.
+// It returns how many items got one and how many differ from the applied action.
+func (m *Model) applyAltResults(alt map[string]triage.Result) (compared, disagree int) {
+	for i := range m.items {
+		item := &m.items[i]
+		result, ok := alt[item.ID]
+		if !ok || result.TriageDecision.Action == "" {
+			continue
+		}
+		item.AltAction = result.TriageDecision.Action
+		item.AltPriority = result.TriageDecision.Priority
+		compared++
+		if item.AltAction != item.Action {
+			disagree++
+		}
+	}
+	m.listView.SetItems(m.items)
+	return compared, disagree
+}
+
 // applyTriageResults applies LLM triage results to the current items.
 // Returns the number of items successfully applied.
 func (m *Model) applyTriageResults(results []triage.Result) int {
@@ -1481,16 +3853,15 @@ func (m *Model) applyTriageResults(results []triage.Result) int {
 		item.Action = result.TriageDecision.Action
 		item.Priority = result.TriageDecision.Priority
 
-		// Apply suggested tags, filtering out action-name duplicates
+		// Apply suggested tags, filtering out action-name duplicates. With
+		// review_suggested_tags they wait for the tag review step instead.
 		if len(result.MetadataEnhancement.SuggestedTags) > 0 {
-			var filtered []string
-			for _, tag := range result.MetadataEnhancement.SuggestedTags {
-				lower := strings.ToLower(strings.TrimSpace(tag))
-				if !validActions[lower] {
-					filtered = append(filtered, tag)
-				}
+			filtered := suggestedTags(result)
+			if m.cfg != nil && m.cfg.ReviewSuggestedTags && len(filtered) > 0 {
+				m.queueTagReview(item, filtered, result)
+			} else {
+				item.Tags = filtered
 			}
-			item.Tags = filtered
 		}
 
 		// Save to triage store with full report
@@ -1499,5 +3870,6 @@ func (m *Model) applyTriageResults(results []triage.Result) int {
 	}
 
 	m.listView.SetItems(m.items)
+	m.sortUncertainFirst()
 	return applied
 }
@@ -1,11 +1,22 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/mcao2/readwise-triage/internal/config"
@@ -22,22 +33,64 @@ func TestMain(m *testing.M) {
 	defer os.RemoveAll(tmpDir)
 
 	os.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(tmpDir, "config.yaml"))
+	// Keep OSC52 clipboard fallbacks out of the test output
+	osc52Output = io.Discard
 
 	os.Exit(m.Run())
 }
 
+// newTestModel returns a model backed by a fresh in-memory triage store and
+// config file, so decisions, preferences and sessions saved by one test
+// never leak into another.
+func newTestModel() *Model {
+	os.Remove(os.Getenv("READWISE_TRIAGE_CONFIG"))
+	config.ClearSession()
+	m := NewModel()
+	m.triageStore = config.NewMemTriageStore()
+	// Test servers only stub the update endpoints
+	m.skipPushCheck = true
+	return m
+}
+
 func TestNewModel(t *testing.T) {
 	m := NewModel()
 	if m.state != StateConfig {
 		t.Errorf("expected initial state StateConfig, got %v", m.state)
 	}
+	if _, ok := m.triageStore.(*config.SQLiteTriageStore); !ok {
+		t.Errorf("expected SQLite triage store, got %T", m.triageStore)
+	}
 	if m.cursor != 0 {
 		t.Errorf("expected initial cursor 0, got %d", m.cursor)
 	}
 }
 
-func TestStateTransitions(t *testing.T) {
+func TestNewModelCorruptTriageStore(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("READWISE_TRIAGE_CONFIG", filepath.Join(dir, "config.yaml"))
+	dbPath := filepath.Join(dir, "triage.db")
+	if err := os.WriteFile(dbPath, []byte(strings.Repeat("garbage", 200)), 0600); err != nil {
+		t.Fatal(err)
+	}
+
 	m := NewModel()
+	if _, ok := m.triageStore.(*config.MemTriageStore); !ok {
+		t.Fatalf("expected in-memory fallback store, got %T", m.triageStore)
+	}
+	if !strings.Contains(m.statusMessage, "won't be saved") || !strings.Contains(m.statusMessage, dbPath) {
+		t.Errorf("expected a warning naming %s, got %q", dbPath, m.statusMessage)
+	}
+
+	// Decisions still work for the session
+	m.items = []Item{{ID: "1", Title: "Item 1"}}
+	m.triageStore.SetItem("1", "archive", "", "manual", nil, nil)
+	if !m.triageStore.HasTriaged("1") {
+		t.Error("expected the fallback store to record decisions")
+	}
+}
+
+func TestStateTransitions(t *testing.T) {
+	m := newTestModel()
 
 	m.Update(StateChangeMsg{State: StateFetching})
 	if m.state != StateFetching {
@@ -69,7 +122,7 @@ func TestStateTransitions(t *testing.T) {
 }
 
 func TestNavigation(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -114,7 +167,7 @@ func TestNavigation(t *testing.T) {
 }
 
 func TestSelectionAndBatchMode(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -155,7 +208,7 @@ func TestSelectionAndBatchMode(t *testing.T) {
 }
 
 func TestApplyActions(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -193,8 +246,61 @@ func TestApplyActions(t *testing.T) {
 	}
 }
 
+func TestCyclePriority(t *testing.T) {
+	item := &Item{ID: "1"}
+	for _, want := range []string{"low", "medium", "high", "", "low"} {
+		cyclePriority(item, 1)
+		if item.Priority != want {
+			t.Fatalf("cycling up gave %q, want %q", item.Priority, want)
+		}
+	}
+	for _, want := range []string{"", "high", "medium", "low", ""} {
+		cyclePriority(item, -1)
+		if item.Priority != want {
+			t.Fatalf("cycling down gave %q, want %q", item.Priority, want)
+		}
+	}
+
+	item.Priority = "urgent"
+	cyclePriority(item, 1)
+	if item.Priority != "low" {
+		t.Errorf("expected an unknown priority to count as none, got %q", item.Priority)
+	}
+}
+
+func TestCyclePriorityKeys(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Item 1", Priority: "low"},
+		{ID: "2", Title: "Item 2", Priority: "high"},
+		{ID: "3", Title: "Item 3"},
+	}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	if m.items[0].Priority != "medium" {
+		t.Errorf("expected + to raise low to medium, got %q", m.items[0].Priority)
+	}
+	if entry, ok := m.triageStore.GetItem("1"); !ok || entry.Priority != "medium" {
+		t.Errorf("expected the new priority saved, got %+v", entry)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	if m.items[0].Priority != "" {
+		t.Errorf("expected - twice to lower medium to none, got %q", m.items[0].Priority)
+	}
+
+	// Batch: each selected item moves one step from its own priority
+	typeKeys(m, " j j ")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("+")})
+	for i, want := range []string{"low", "", "low"} {
+		if m.items[i].Priority != want {
+			t.Errorf("item %d priority after batch + = %q, want %q", i+1, m.items[i].Priority, want)
+		}
+	}
+}
+
 func TestThemeCycling(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	initialTheme := m.cfg.Theme
 	if initialTheme == "" {
 		initialTheme = "default"
@@ -207,8 +313,41 @@ func TestThemeCycling(t *testing.T) {
 	}
 }
 
+func TestLightTheme(t *testing.T) {
+	theme, ok := Themes["solarized-light"]
+	if !ok {
+		t.Fatal("expected solarized-light theme to exist")
+	}
+
+	found := false
+	for _, name := range GetThemeNames() {
+		if name == "solarized-light" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected GetThemeNames to include solarized-light")
+	}
+
+	styles := NewStyles(theme)
+	if styles.Title.Render("Readwise Triage") == "" || styles.Help.Render("help") == "" {
+		t.Error("expected light theme styles to render")
+	}
+
+	// Cycling through every theme reaches the light one
+	m := newTestModel()
+	seen := map[string]bool{}
+	for range GetThemeNames() {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+		seen[m.cfg.Theme] = true
+	}
+	if !seen["solarized-light"] {
+		t.Errorf("expected theme cycling to include solarized-light, saw %v", seen)
+	}
+}
+
 func TestImportTriageResults(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -250,7 +389,7 @@ func TestImportTriageResults(t *testing.T) {
 }
 
 func TestHandleAdditionalKeys(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 
 	m.state = StateConfirming
 	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
@@ -265,9 +404,9 @@ func TestHandleAdditionalKeys(t *testing.T) {
 	}
 
 	m.state = StateDone
-	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
 	if m.state != StateFetching {
-		t.Errorf("expected Fetching state after key in Done (re-fetch), got %v", m.state)
+		t.Errorf("expected Fetching state after r in Done (re-fetch), got %v", m.state)
 	}
 
 	m.state = StateMessage
@@ -278,7 +417,7 @@ func TestHandleAdditionalKeys(t *testing.T) {
 }
 
 func TestValidateTriageJSON(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	validJSON := `[{"id": "1", "title": "Test", "triage_decision": {"action": "read_now"}}]`
@@ -301,7 +440,7 @@ func TestValidateTriageJSON(t *testing.T) {
 }
 
 func TestUpdateWithSelection(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Action: "read_now"},
 		{ID: "2", Title: "Item 2", Action: "later"},
@@ -319,10 +458,10 @@ func TestUpdateWithSelection(t *testing.T) {
 }
 
 func TestProgressUpdateLoop(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	ch := make(chan readwise.BatchUpdateProgress, 2)
 
-	cmd := m.waitForUpdateProgress(ch, 0, 0)
+	cmd := m.waitForUpdateProgress(ch, 0, 0, nil, nil)
 
 	ch <- readwise.BatchUpdateProgress{Current: 1, Total: 2, ItemID: "1", Success: true}
 
@@ -335,7 +474,7 @@ func TestProgressUpdateLoop(t *testing.T) {
 		t.Errorf("expected progress 0.5, got %f", progressMsg.Progress)
 	}
 
-	nextCmd := m.waitForUpdateProgress(progressMsg.Channel, progressMsg.Success, progressMsg.Failed)
+	nextCmd := m.waitForUpdateProgress(progressMsg.Channel, progressMsg.Success, progressMsg.Failed, progressMsg.Failures, progressMsg.Gone)
 	ch <- readwise.BatchUpdateProgress{Current: 2, Total: 2, ItemID: "2", Success: true}
 
 	msg2 := nextCmd()
@@ -348,7 +487,7 @@ func TestProgressUpdateLoop(t *testing.T) {
 	}
 
 	close(ch)
-	finishCmd := m.waitForUpdateProgress(progressMsg2.Channel, progressMsg2.Success, progressMsg2.Failed)
+	finishCmd := m.waitForUpdateProgress(progressMsg2.Channel, progressMsg2.Success, progressMsg2.Failed, progressMsg2.Failures, progressMsg2.Gone)
 	finishMsg := finishCmd()
 	if _, ok := finishMsg.(UpdateFinishedMsg); !ok {
 		t.Fatalf("expected UpdateFinishedMsg, got %T", finishMsg)
@@ -357,7 +496,7 @@ func TestProgressUpdateLoop(t *testing.T) {
 
 func TestViewRendering(t *testing.T) {
 
-	m := NewModel()
+	m := newTestModel()
 
 	view := m.View()
 	if view == "" {
@@ -381,7 +520,7 @@ func TestViewRendering(t *testing.T) {
 }
 
 func TestRefreshKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -401,7 +540,7 @@ func TestRefreshKey(t *testing.T) {
 }
 
 func TestNeedsReviewAction(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{
 		{ID: "1", Title: "Item 1"},
 	}
@@ -418,7 +557,7 @@ func TestNeedsReviewAction(t *testing.T) {
 }
 
 func TestBatchNeedsReviewAction(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -445,7 +584,7 @@ func TestBatchNeedsReviewAction(t *testing.T) {
 }
 
 func TestUpdateRequestWithTags(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 
 	// Directly set items with tags
@@ -540,7 +679,7 @@ func TestAllSingleItemActions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.key+"="+tt.action, func(t *testing.T) {
-			m := NewModel()
+			m := newTestModel()
 			m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Test"}}})
 			m.state = StateReviewing
 
@@ -564,7 +703,7 @@ func TestAllSingleItemPriorities(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.key+"="+tt.priority, func(t *testing.T) {
-			m := NewModel()
+			m := newTestModel()
 			m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Test"}}})
 			m.state = StateReviewing
 
@@ -590,7 +729,7 @@ func TestAllBatchActions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("batch_"+tt.key+"="+tt.action, func(t *testing.T) {
-			m := NewModel()
+			m := newTestModel()
 			items := []Item{
 				{ID: "1", Title: "Item 1"},
 				{ID: "2", Title: "Item 2"},
@@ -627,7 +766,7 @@ func TestAllBatchPriorities(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("batch_"+tt.key+"="+tt.priority, func(t *testing.T) {
-			m := NewModel()
+			m := newTestModel()
 			items := []Item{
 				{ID: "1", Title: "Item 1"},
 				{ID: "2", Title: "Item 2"},
@@ -652,7 +791,7 @@ func TestAllBatchPriorities(t *testing.T) {
 }
 
 func TestFetchMoreKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	items := []Item{{ID: "1", Title: "Item 1"}}
 	m.Update(ItemsLoadedMsg{Items: items})
 	m.state = StateReviewing
@@ -673,7 +812,7 @@ func TestFetchMoreKey(t *testing.T) {
 }
 
 func TestToggleLLMMode_Disabled(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	initial := m.useLLMTriage
 
 	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
@@ -729,7 +868,7 @@ func TestAllViewRendering(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			m := NewModel()
+			m := newTestModel()
 			tt.setup(m)
 			view := m.View()
 			if view == "" {
@@ -740,7 +879,7 @@ func TestAllViewRendering(t *testing.T) {
 }
 
 func TestConfirmingToUpdatingFlow(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Action: "read_now", Priority: "high"},
@@ -759,8 +898,49 @@ func TestConfirmingToUpdatingFlow(t *testing.T) {
 	}
 }
 
+func TestConfirmBeforePush(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	no := false
+	tests := []struct {
+		name    string
+		confirm *bool
+		key     string
+		want    State
+	}{
+		{"u confirms by default", nil, "u", StateConfirming},
+		{"u pushes with confirm disabled", &no, "u", StateUpdating},
+		{"U always pushes", nil, "U", StateUpdating},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.cfg = &config.Config{ReadwiseToken: "test-token", ConfirmBeforePush: tt.confirm}
+			m.items = []Item{{ID: "1", Title: "Item 1", Action: "archive"}}
+			m.listView.SetItems(m.items)
+			m.state = StateReviewing
+
+			_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+			if m.state != tt.want {
+				t.Errorf("state after %s = %v, want %v", tt.key, m.state, tt.want)
+			}
+			if tt.want == StateUpdating && cmd == nil {
+				t.Error("expected the update to start")
+			}
+		})
+	}
+}
+
 func TestStartUpdatingNoItems(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"}, // no action set
@@ -784,7 +964,7 @@ func TestStartUpdatingNoItems(t *testing.T) {
 }
 
 func TestStartUpdatingNoToken(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: ""}
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Action: "read_now"},
@@ -807,7 +987,7 @@ func TestStartUpdatingNoToken(t *testing.T) {
 }
 
 func TestStartUpdatingWithSelection(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Action: "read_now"},
@@ -833,7 +1013,7 @@ func TestStartUpdatingWithSelection(t *testing.T) {
 }
 
 func TestExportWithSelection(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", URL: "https://example.com/1"},
 		{ID: "2", Title: "Item 2", URL: "https://example.com/2"},
@@ -862,7 +1042,7 @@ func TestExportWithSelection(t *testing.T) {
 }
 
 func TestTriagePersistence(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -890,7 +1070,7 @@ func TestTriagePersistence(t *testing.T) {
 }
 
 func TestApplySavedTriages(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 
 	// Pre-populate triage store
 	m.triageStore.SetItem("1", "archive", "low", "manual", nil, nil)
@@ -1011,9 +1191,9 @@ func TestKeyMapKeys(t *testing.T) {
 	if len(keys) == 0 {
 		t.Error("expected non-empty key bindings")
 	}
-	// Should have 17 bindings
-	if len(keys) != 17 {
-		t.Errorf("expected 17 key bindings, got %d", len(keys))
+	// One binding per KeyMap field
+	if len(keys) != 45 {
+		t.Errorf("expected 45 key bindings, got %d", len(keys))
 	}
 }
 
@@ -1027,7 +1207,7 @@ func TestDefaultStyles(t *testing.T) {
 }
 
 func TestSaveLLMTriage(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.saveLLMTriage("item1", "read_now", "high", nil, nil)
 
 	if m.triageStore == nil {
@@ -1046,15 +1226,14 @@ func TestSaveLLMTriage(t *testing.T) {
 }
 
 func TestSaveLLMTriageNilStore(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.triageStore = nil
 	// Should not panic
 	m.saveLLMTriage("item1", "read_now", "high", nil, nil)
 }
 
 func TestExportItemsToFile(t *testing.T) {
-	m := NewModel()
-	m.triageStore = nil // Avoid filtering by triage store from earlier tests
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "export-file-1", Title: "Item 1", URL: "https://example.com/1"},
 	}
@@ -1076,10 +1255,42 @@ func TestExportItemsToFile(t *testing.T) {
 	if !strings.Contains(string(data), "Item 1") {
 		t.Error("expected exported file to contain 'Item 1'")
 	}
+	if !strings.Contains(string(data), "**Inbox items to process:**") {
+		t.Error("expected exported file to include the triage prompt")
+	}
+}
+
+func TestExportToFileKey(t *testing.T) {
+	m := newTestModel()
+	m.state = StateReviewing
+	m.items = []Item{{ID: "export-key-1", Title: "Item 1"}}
+	m.listView.SetItems(m.items)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if m.state != StateMessage || m.messageType != "success" {
+		t.Fatalf("expected success message, got state %v %q: %s", m.state, m.messageType, m.statusMessage)
+	}
+	path := strings.TrimSuffix(strings.TrimPrefix(m.statusMessage, "Items exported to "), ". Paste its contents to your LLM.")
+	defer os.Remove(path)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected message to show the export path, got %q: %v", m.statusMessage, err)
+	}
+	if !strings.Contains(string(data), "export-key-1") {
+		t.Errorf("expected exported file to contain the item, got %q", data)
+	}
+
+	// Nothing left to export is reported as an error
+	m = newTestModel()
+	m.state = StateReviewing
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if m.messageType != "error" {
+		t.Errorf("expected error with no items, got %q: %s", m.messageType, m.statusMessage)
+	}
 }
 
 func TestImportTriageResultsFromFile(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"},
 	}
@@ -1089,12 +1300,13 @@ func TestImportTriageResultsFromFile(t *testing.T) {
 	tmpFile := filepath.Join(t.TempDir(), "triage.json")
 	os.WriteFile(tmpFile, []byte(jsonData), 0644)
 
-	applied, err := m.ImportTriageResultsFromFile(tmpFile)
+	cmd, err := m.ImportTriageResultsFromFile(tmpFile)
 	if err != nil {
 		t.Fatalf("ImportTriageResultsFromFile failed: %v", err)
 	}
-	if applied != 1 {
-		t.Errorf("expected 1 applied, got %d", applied)
+	runImport(t, m, cmd)
+	if m.messageType != "success" || !strings.Contains(m.statusMessage, "applied triage results to 1 items") {
+		t.Errorf("expected 1 applied, got %q", m.statusMessage)
 	}
 	if m.items[0].Action != "archive" {
 		t.Errorf("expected action 'archive', got %q", m.items[0].Action)
@@ -1102,7 +1314,7 @@ func TestImportTriageResultsFromFile(t *testing.T) {
 }
 
 func TestImportTriageResultsFromFileMissing(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	_, err := m.ImportTriageResultsFromFile("/nonexistent/file.json")
 	if err == nil {
 		t.Error("expected error on missing file")
@@ -1110,7 +1322,7 @@ func TestImportTriageResultsFromFileMissing(t *testing.T) {
 }
 
 func TestConfigViewWithError(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 	m.statusMessage = "some error"
 	view := m.View()
@@ -1120,7 +1332,7 @@ func TestConfigViewWithError(t *testing.T) {
 }
 
 func TestFetchingViewWithLLM(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateFetching
 	m.useLLMTriage = true
 	view := m.View()
@@ -1130,7 +1342,7 @@ func TestFetchingViewWithLLM(t *testing.T) {
 }
 
 func TestFetchingViewWithoutLLM(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateFetching
 	m.useLLMTriage = false
 	view := m.View()
@@ -1140,7 +1352,7 @@ func TestFetchingViewWithoutLLM(t *testing.T) {
 }
 
 func TestReviewingViewWithStatus(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.items = []Item{{ID: "1", Title: "Test"}}
 	m.listView.SetItems(m.items)
@@ -1152,7 +1364,7 @@ func TestReviewingViewWithStatus(t *testing.T) {
 }
 
 func TestWindowSizeMsg(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
 	if m.width != 120 {
 		t.Errorf("expected width 120, got %d", m.width)
@@ -1163,7 +1375,7 @@ func TestWindowSizeMsg(t *testing.T) {
 }
 
 func TestQuitKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
 	if cmd == nil {
@@ -1172,7 +1384,7 @@ func TestQuitKey(t *testing.T) {
 }
 
 func TestHelpKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
 	if cmd != nil {
@@ -1181,7 +1393,7 @@ func TestHelpKey(t *testing.T) {
 }
 
 func TestModelInit(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	cmd := m.Init()
 	if cmd == nil {
 		t.Error("expected non-nil cmd from Init (spinner tick)")
@@ -1189,7 +1401,7 @@ func TestModelInit(t *testing.T) {
 }
 
 func TestHandleReviewingUpdateKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test", Action: "read_now"}}
 	m.listView.SetItems(m.items)
 	m.state = StateReviewing
@@ -1201,12 +1413,13 @@ func TestHandleReviewingUpdateKey(t *testing.T) {
 }
 
 func TestHandleReviewingExportKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 	m.listView.SetItems(m.items)
 	m.state = StateReviewing
 
-	// Export will fail (no clipboard in test), but should transition to StateMessage
+	// Without a clipboard in tests the export fails or falls back to OSC52,
+	// either way it should transition to StateMessage
 	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
 	if m.state != StateMessage {
 		t.Errorf("expected StateMessage after 'e', got %v", m.state)
@@ -1214,7 +1427,7 @@ func TestHandleReviewingExportKey(t *testing.T) {
 }
 
 func TestHandleReviewingImportKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 	m.listView.SetItems(m.items)
 	m.state = StateReviewing
@@ -1227,7 +1440,7 @@ func TestHandleReviewingImportKey(t *testing.T) {
 }
 
 func TestStartFetchingNoToken(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: ""}
 
 	cmd := m.startFetching()
@@ -1246,7 +1459,7 @@ func TestStartFetchingNoToken(t *testing.T) {
 }
 
 func TestStartFetchingNilConfig(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = nil
 
 	cmd := m.startFetching()
@@ -1261,7 +1474,7 @@ func TestStartFetchingNilConfig(t *testing.T) {
 }
 
 func TestStartTriaging(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	cmd := m.startTriaging()
 	if cmd == nil {
 		t.Fatal("expected command")
@@ -1282,7 +1495,7 @@ func TestStartTriaging(t *testing.T) {
 }
 
 func TestConfigEnterKey(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: ""}
 	m.state = StateConfig
 
@@ -1296,7 +1509,7 @@ func TestConfigEnterKey(t *testing.T) {
 }
 
 func TestConfigToggleMode_Disabled(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 	initial := m.useLLMTriage
 
@@ -1307,7 +1520,7 @@ func TestConfigToggleMode_Disabled(t *testing.T) {
 }
 
 func TestConfigViewNoLLMMode(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 	view := m.View()
 	if strings.Contains(view, "LLM") {
@@ -1316,7 +1529,7 @@ func TestConfigViewNoLLMMode(t *testing.T) {
 }
 
 func TestValidateTriageJSON_MissingID(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	json := `[{"id": "", "title": "Test", "triage_decision": {"action": "read_now"}}]`
@@ -1327,7 +1540,7 @@ func TestValidateTriageJSON_MissingID(t *testing.T) {
 }
 
 func TestValidateTriageJSON_MissingAction(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	json := `[{"id": "1", "title": "Test", "triage_decision": {}}]`
@@ -1338,7 +1551,7 @@ func TestValidateTriageJSON_MissingAction(t *testing.T) {
 }
 
 func TestValidateTriageJSON_InvalidPriority(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	json := `[{"id": "1", "title": "Test", "triage_decision": {"action": "read_now", "priority": "urgent"}}]`
@@ -1349,7 +1562,7 @@ func TestValidateTriageJSON_InvalidPriority(t *testing.T) {
 }
 
 func TestValidateTriageJSON_EmptyArray(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	ok, _ := m.ValidateTriageJSON("[]")
@@ -1359,7 +1572,7 @@ func TestValidateTriageJSON_EmptyArray(t *testing.T) {
 }
 
 func TestValidateTriageJSON_ParseError(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	ok, _ := m.ValidateTriageJSON("not json at all")
@@ -1369,7 +1582,7 @@ func TestValidateTriageJSON_ParseError(t *testing.T) {
 }
 
 func TestImportTriageResults_EmptyResults(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	_, err := m.ImportTriageResults("[]")
@@ -1379,7 +1592,7 @@ func TestImportTriageResults_EmptyResults(t *testing.T) {
 }
 
 func TestImportTriageResults_NoJSON(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	_, err := m.ImportTriageResults("not json")
@@ -1389,7 +1602,7 @@ func TestImportTriageResults_NoJSON(t *testing.T) {
 }
 
 func TestImportTriageResults_MissingID(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	json := `[{"id": "", "title": "Test", "triage_decision": {"action": "read_now"}}]`
@@ -1400,7 +1613,7 @@ func TestImportTriageResults_MissingID(t *testing.T) {
 }
 
 func TestImportTriageResults_MissingAction(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	json := `[{"id": "1", "title": "Test", "triage_decision": {}}]`
@@ -1411,7 +1624,7 @@ func TestImportTriageResults_MissingAction(t *testing.T) {
 }
 
 func TestImportTriageResults_InvalidPriority(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Test"}}
 
 	json := `[{"id": "1", "title": "Test", "triage_decision": {"action": "read_now", "priority": "urgent"}}]`
@@ -1422,7 +1635,7 @@ func TestImportTriageResults_InvalidPriority(t *testing.T) {
 }
 
 func TestImportTriageResults_PartialSuccess(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"},
 		{ID: "2", Title: "Item 2"},
@@ -1447,14 +1660,14 @@ func TestImportTriageResults_PartialSuccess(t *testing.T) {
 }
 
 func TestSaveTriageNilStore(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.triageStore = nil
 	// Should not panic
 	m.saveTriage("1", "read_now", "high", nil)
 }
 
 func TestHandleKeyPressInFetchingState(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateFetching
 
 	// Keys in fetching state should go through quit/help handling
@@ -1465,7 +1678,7 @@ func TestHandleKeyPressInFetchingState(t *testing.T) {
 }
 
 func TestExportItemsToJSON_AllTriaged(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "triaged-1", Title: "Item 1"},
 	}
@@ -1479,7 +1692,7 @@ func TestExportItemsToJSON_AllTriaged(t *testing.T) {
 }
 
 func TestHelpToggle(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.items = []Item{{ID: "1", Title: "Test"}}
 	m.listView.SetItems(m.items)
@@ -1500,7 +1713,7 @@ func TestHelpToggle(t *testing.T) {
 }
 
 func TestReviewingViewWithHelp(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.width = 100
 	m.height = 40
@@ -1525,7 +1738,7 @@ func TestReviewingViewWithHelp(t *testing.T) {
 }
 
 func TestFetchingViewSpinner(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateFetching
 	view := m.View()
 	if !strings.Contains(view, "Loading from Readwise") {
@@ -1534,7 +1747,7 @@ func TestFetchingViewSpinner(t *testing.T) {
 }
 
 func TestUpdatingViewProgress(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateUpdating
 	m.updateProgress = 0.75
 	m.statusMessage = "Updated 3/4 items"
@@ -1548,7 +1761,7 @@ func TestUpdatingViewProgress(t *testing.T) {
 }
 
 func TestConfigViewCard(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 	m.width = 80
 	view := m.View()
@@ -1561,7 +1774,7 @@ func TestConfigViewCard(t *testing.T) {
 }
 
 func TestDoneViewCheckmark(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateDone
 	m.statusMessage = "Updated 5 items"
 	view := m.View()
@@ -1574,7 +1787,7 @@ func TestDoneViewCheckmark(t *testing.T) {
 }
 
 func TestDoneKeyRefetchesItems(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 
 	// Load items, mark one as archived, simulate update
@@ -1588,10 +1801,10 @@ func TestDoneKeyRefetchesItems(t *testing.T) {
 		t.Fatalf("expected StateDone, got %v", m.state)
 	}
 
-	// Press any key — should trigger re-fetch, not go straight to reviewing
-	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	// Press r — should trigger re-fetch, not go straight to reviewing
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
 	if m.state != StateFetching {
-		t.Fatalf("expected StateFetching after key in Done, got %v", m.state)
+		t.Fatalf("expected StateFetching after r in Done, got %v", m.state)
 	}
 	if cmd == nil {
 		t.Fatal("expected a fetch command, got nil")
@@ -1611,7 +1824,7 @@ func TestDoneKeyRefetchesItems(t *testing.T) {
 }
 
 func TestMessageViewIcons(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateMessage
 	m.messageType = "error"
 	m.statusMessage = "something broke"
@@ -1629,7 +1842,7 @@ func TestMessageViewIcons(t *testing.T) {
 }
 
 func TestReviewingViewDetailPane(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.width = 100
 	m.height = 40
@@ -1658,7 +1871,7 @@ func TestReviewingViewDetailPane(t *testing.T) {
 }
 
 func TestReviewingViewBatchIndicator(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.width = 100
 	m.height = 40
@@ -1681,7 +1894,7 @@ func TestReviewingViewBatchIndicator(t *testing.T) {
 }
 
 func TestRenderHelpLine(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	entries := []helpEntry{
 		{"j/k", "navigate"},
 		{"q", "quit"},
@@ -1699,7 +1912,7 @@ func TestRenderHelpLine(t *testing.T) {
 }
 
 func TestSpinnerUpdate(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	// Spinner tick should be handled without error
 	_, cmd := m.Update(m.spinner.Tick())
 	if cmd == nil {
@@ -1708,7 +1921,7 @@ func TestSpinnerUpdate(t *testing.T) {
 }
 
 func TestNavigationAfterMultipleUpdateCycles(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 
 	items := []Item{
@@ -1739,8 +1952,8 @@ func TestNavigationAfterMultipleUpdateCycles(t *testing.T) {
 			t.Fatalf("cycle %d: expected StateDone, got %v", cycle, m.state)
 		}
 
-		// Press any key to re-fetch items
-		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+		// Press r to re-fetch items
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
 		if m.state != StateFetching {
 			t.Fatalf("cycle %d: expected StateFetching after done, got %v", cycle, m.state)
 		}
@@ -1780,7 +1993,7 @@ func TestNavigationAfterMultipleUpdateCycles(t *testing.T) {
 }
 
 func TestConfigLocationToggle(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 
 	if m.fetchLocation != "new" {
@@ -1813,7 +2026,7 @@ func TestConfigLocationToggle(t *testing.T) {
 }
 
 func TestConfigViewRendersLocation(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 
 	view := m.View()
@@ -1832,7 +2045,7 @@ func TestConfigViewRendersLocation(t *testing.T) {
 }
 
 func TestReviewingHeaderShowsLocationTag(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.width = 100
 	m.height = 40
@@ -1854,7 +2067,7 @@ func TestReviewingHeaderShowsLocationTag(t *testing.T) {
 }
 
 func TestFeedUpdatePromotesToInbox(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.cfg = &config.Config{ReadwiseToken: "test-token"}
 	m.fetchLocation = "feed"
 	m.items = []Item{
@@ -1876,7 +2089,7 @@ func TestFeedUpdatePromotesToInbox(t *testing.T) {
 }
 
 func TestFetchingViewFeedTitle(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateFetching
 	m.fetchLocation = "feed"
 	view := m.View()
@@ -1892,7 +2105,7 @@ func TestFetchingViewFeedTitle(t *testing.T) {
 }
 
 func TestItemsLoadedMsgFeedStatus(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.fetchLocation = "feed"
 	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Test"}}})
 	if !strings.Contains(m.statusMessage, "feed") {
@@ -1907,7 +2120,7 @@ func TestItemsLoadedMsgFeedStatus(t *testing.T) {
 }
 
 func TestIndependentLookbackPerLocation(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Item 1"}}
 	m.listView.SetItems(m.items)
 	m.state = StateReviewing
@@ -1944,7 +2157,7 @@ func TestIndependentLookbackPerLocation(t *testing.T) {
 }
 
 func TestConfigDaysAdjust(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 	initial := m.activeLookback()
 
@@ -1968,8 +2181,53 @@ func TestConfigDaysAdjust(t *testing.T) {
 	}
 }
 
+func TestConfigDaysInputValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantDays int
+		wantHint string
+	}{
+		{"in range", "30", 30, ""},
+		{"max", "365", 365, ""},
+		{"zero", "0", 1, "min 1 day"},
+		{"too large", "9000", 365, "capped at 365"},
+		{"overflow", "99999999999999999999", 365, "capped at 365"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.state = StateConfig
+			m.statusMessage = ""
+			for _, r := range tt.input {
+				m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+			}
+
+			// Feedback shows while typing
+			view := m.configView()
+			if tt.wantHint != "" && !strings.Contains(view, tt.wantHint) {
+				t.Errorf("expected live hint %q in config view", tt.wantHint)
+			}
+			if tt.wantHint == "" && (strings.Contains(view, "min 1 day") || strings.Contains(view, "capped at")) {
+				t.Error("expected no hint for an in-range value")
+			}
+
+			m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+			if m.activeLookback() != tt.wantDays {
+				t.Errorf("lookback = %d, want %d", m.activeLookback(), tt.wantDays)
+			}
+			if tt.wantHint != "" && !strings.Contains(m.statusMessage, tt.wantHint) {
+				t.Errorf("expected clamp message with %q, got %q", tt.wantHint, m.statusMessage)
+			}
+			if tt.wantHint == "" && m.statusMessage != "" {
+				t.Errorf("expected no message, got %q", m.statusMessage)
+			}
+		})
+	}
+}
+
 func TestConfigDaysDirectInput(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 
 	// Type "30" then Enter to set days to 30
@@ -2007,12 +2265,13 @@ func TestConfigDaysDirectInput(t *testing.T) {
 		t.Errorf("expected lookback still 30 after Esc, got %d", m.activeLookback())
 	}
 
-	// Invalid input (0) is ignored on Enter
+	// Out-of-range input is clamped on Enter
 	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("0")})
 	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
-	if m.activeLookback() != 30 {
-		t.Errorf("expected lookback still 30 after entering 0, got %d", m.activeLookback())
+	if m.activeLookback() != 1 {
+		t.Errorf("expected lookback clamped to 1 after entering 0, got %d", m.activeLookback())
 	}
+	m.inboxLookback = 30
 
 	// Backspace removes last digit
 	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("4")})
@@ -2031,7 +2290,7 @@ func TestConfigDaysDirectInput(t *testing.T) {
 }
 
 func TestEnterKeyEntersTagEditingMode(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Tags: []string{"go", "tutorial"}},
 	}
@@ -2052,7 +2311,7 @@ func TestEnterKeyEntersTagEditingMode(t *testing.T) {
 }
 
 func TestTagEditingTypingAndConfirm(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"},
 	}
@@ -2081,7 +2340,7 @@ func TestTagEditingTypingAndConfirm(t *testing.T) {
 }
 
 func TestTagEditingEscCancels(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Tags: []string{"original"}},
 	}
@@ -2105,7 +2364,7 @@ func TestTagEditingEscCancels(t *testing.T) {
 }
 
 func TestTagEditingBackspace(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1"},
 	}
@@ -2146,7 +2405,7 @@ func TestTagEditingBackspace(t *testing.T) {
 }
 
 func TestTagEditingBatchMode(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Item 1", Tags: []string{"old"}},
 		{ID: "2", Title: "Item 2", Tags: []string{"old"}},
@@ -2184,7 +2443,7 @@ func TestTagEditingBatchMode(t *testing.T) {
 }
 
 func TestTagEditingViewPopup(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.width = 100
 	m.height = 40
@@ -2212,6 +2471,13 @@ func TestParseTags(t *testing.T) {
 		{"", nil},
 		{",,,", nil},
 		{"single", []string{"single"}},
+		{`"ai, ml", reference`, []string{"ai, ml", "reference"}},
+		{`go, "machine learning, nlp" , rust`, []string{"go", "machine learning, nlp", "rust"}},
+		{`" padded ", plain `, []string{" padded ", "plain"}},
+		{`"say \"hi\"", x`, []string{`say "hi"`, "x"}},
+		{`back\\slash`, []string{`back\slash`}},
+		{`"", go`, []string{"go"}},
+		{`"unterminated, still one`, []string{"unterminated, still one"}},
 	}
 	for _, tt := range tests {
 		got := parseTags(tt.input)
@@ -2227,8 +2493,20 @@ func TestParseTags(t *testing.T) {
 	}
 }
 
+func TestFormatTagsRoundTrip(t *testing.T) {
+	tags := []string{"go", "ai, ml", " padded ", `say "hi"`, `back\slash`}
+	input := formatTags(tags)
+	if !strings.HasPrefix(input, `go, "ai, ml"`) {
+		t.Errorf("formatTags() = %q, expected plain tags unquoted and comma tags quoted", input)
+	}
+	got := parseTags(input)
+	if strings.Join(got, "|") != strings.Join(tags, "|") {
+		t.Errorf("parseTags(formatTags(%q)) = %q", tags, got)
+	}
+}
+
 func TestTagEditingArrowKeys(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Item 1"}}
 	m.listView.SetItems(m.items)
 	m.state = StateReviewing
@@ -2284,8 +2562,45 @@ func TestTagEditingArrowKeys(t *testing.T) {
 	}
 }
 
+func TestTagEditingPaste(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{{ID: "1", Title: "Item 1"}}
+	m.listView.SetItems(m.items)
+	m.state = StateReviewing
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("go")})
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+
+	// A bracketed paste inserts every rune at the cursor
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("machine learning, ü日本, "), Paste: true})
+	if m.tagsInput != "machine learning, ü日本, go" {
+		t.Errorf("expected the whole paste inserted, got %q", m.tagsInput)
+	}
+	if m.tagsCursor != len([]rune("machine learning, ü日本, ")) {
+		t.Errorf("expected the cursor after the paste, got %d", m.tagsCursor)
+	}
+
+	// Pasted lines become separate tags; a trailing newline is dropped
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ai\r\nrust\tlang\n"), Paste: true})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := m.items[0].Tags; !reflect.DeepEqual(got, []string{"ai", "rust lang"}) {
+		t.Errorf("expected tags [ai rust lang], got %v", got)
+	}
+
+	// Notes keep pasted lines on one line
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("first\nsecond"), Paste: true})
+	if m.tagsInput != "first second" {
+		t.Errorf("expected the pasted note on one line, got %q", m.tagsInput)
+	}
+}
+
 func TestTagEditingOptionDelete(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{{ID: "1", Title: "Item 1"}}
 	m.listView.SetItems(m.items)
 	m.state = StateReviewing
@@ -2335,7 +2650,7 @@ func TestTagEditingOptionDelete(t *testing.T) {
 func TestTagEditingWordJump(t *testing.T) {
 	// Helper to set up a model in tag-editing mode with "go, rust, wasm"
 	setup := func() *Model {
-		m := NewModel()
+		m := newTestModel()
 		m.items = []Item{{ID: "1", Title: "Item 1"}}
 		m.listView.SetItems(m.items)
 		m.state = StateReviewing
@@ -2457,7 +2772,7 @@ func TestWordBoundaryHelpers(t *testing.T) {
 
 func TestLocationPersistence(t *testing.T) {
 	// Toggle to feed and verify it's saved to config
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateConfig
 
 	m.Update(tea.KeyMsg{Type: tea.KeyRight}) // toggle to feed
@@ -2488,7 +2803,7 @@ func TestLocationPersistence(t *testing.T) {
 }
 
 func TestTriageFinishedMsg_Success(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateTriaging
 	m.items = []Item{
 		{ID: "1", Title: "Article 1", URL: "https://example.com/1"},
@@ -2545,7 +2860,7 @@ func TestTriageFinishedMsg_Success(t *testing.T) {
 }
 
 func TestTriageFinishedMsg_Error(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateTriaging
 
 	m.Update(TriageFinishedMsg{Err: fmt.Errorf("API rate limited")})
@@ -2561,8 +2876,22 @@ func TestTriageFinishedMsg_Error(t *testing.T) {
 	}
 }
 
+func TestTriageFinishedMsg_ErrorAdvice(t *testing.T) {
+	m := newTestModel()
+	m.state = StateTriaging
+
+	err := fmt.Errorf("triage failed: %w", &triage.APIError{StatusCode: 401, Kind: triage.ErrorAuth, Message: "Incorrect API key"})
+	m.Update(TriageFinishedMsg{Err: err})
+
+	for _, want := range []string{"Your API key is invalid", "llm.api_key", "Incorrect API key"} {
+		if !strings.Contains(m.statusMessage, want) {
+			t.Errorf("expected %q in status message, got %q", want, m.statusMessage)
+		}
+	}
+}
+
 func TestApplyTriageResults_FiltersActionTags(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Article 1"},
 	}
@@ -2594,7 +2923,7 @@ func TestApplyTriageResults_FiltersActionTags(t *testing.T) {
 }
 
 func TestApplyTriageResults_UnknownIDSkipped(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Article 1"},
 	}
@@ -2622,7 +2951,7 @@ func TestApplyTriageResults_UnknownIDSkipped(t *testing.T) {
 }
 
 func TestBuildTriageItemsJSON(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Untriaged", URL: "https://example.com/1"},
 		{ID: "2", Title: "Already triaged", URL: "https://example.com/2", Action: "read_now"},
@@ -2648,7 +2977,7 @@ func TestBuildTriageItemsJSON(t *testing.T) {
 }
 
 func TestBuildTriageItemsJSON_AllTriaged(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.items = []Item{
 		{ID: "1", Title: "Done", Action: "read_now"},
 	}
@@ -2661,7 +2990,7 @@ func TestBuildTriageItemsJSON_AllTriaged(t *testing.T) {
 }
 
 func TestAutoTriageKeyBinding(t *testing.T) {
-	m := NewModel()
+	m := newTestModel()
 	m.state = StateReviewing
 	m.items = []Item{
 		{ID: "1", Title: "Test"},
@@ -2676,3 +3005,2399 @@ func TestAutoTriageKeyBinding(t *testing.T) {
 		t.Errorf("expected StateTriaging after T key, got %v", m.state)
 	}
 }
+
+func TestItemOpenURL(t *testing.T) {
+	item := &Item{URL: "https://example.com/post", ReaderURL: "https://read.readwise.io/read/abc"}
+	if got := itemOpenURL(item, false); got != item.URL {
+		t.Errorf("expected source URL, got %q", got)
+	}
+	if got := itemOpenURL(item, true); got != item.ReaderURL {
+		t.Errorf("expected reader URL, got %q", got)
+	}
+}
+
+func TestOpenKeys(t *testing.T) {
+	var opened []string
+	origOpen := openURL
+	openURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	defer func() { openURL = origOpen }()
+
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Item 1", URL: "https://example.com/post", ReaderURL: "https://read.readwise.io/read/1"},
+		{ID: "2", Title: "Item 2", URL: "https://example.com/other"},
+	}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}})
+	if len(opened) != 2 {
+		t.Fatalf("expected 2 URLs opened, got %d", len(opened))
+	}
+	if opened[0] != "https://example.com/post" {
+		t.Errorf("expected o to open source URL, got %q", opened[0])
+	}
+	if opened[1] != "https://read.readwise.io/read/1" {
+		t.Errorf("expected O to open reader URL, got %q", opened[1])
+	}
+
+	// Empty ReaderURL shows an error instead of opening anything
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'O'}})
+	if len(opened) != 2 {
+		t.Errorf("expected no URL opened for empty ReaderURL, got %v", opened)
+	}
+	if m.state != StateMessage || m.messageType != "error" {
+		t.Errorf("expected error message state, got %v/%q", m.state, m.messageType)
+	}
+}
+
+func TestOpenItemWithoutURL(t *testing.T) {
+	var opened []string
+	origOpen := openURL
+	openURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	defer func() { openURL = origOpen }()
+
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "no-url", Title: "A note"},
+		{ID: "reader-only", Title: "An email", ReaderURL: "https://read.readwise.io/read/email"},
+	}})
+
+	// No URL at all: a friendly message and nothing is opened
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if len(opened) != 0 {
+		t.Fatalf("expected nothing opened, got %v", opened)
+	}
+	if m.state != StateMessage || m.statusMessage != "No URL for this item" {
+		t.Errorf("expected friendly message, got %v %q", m.state, m.statusMessage)
+	}
+
+	// Without a source URL, o falls back to the Reader page
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}}) // dismiss
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if len(opened) != 1 || opened[0] != "https://read.readwise.io/read/email" {
+		t.Errorf("expected reader URL fallback, got %v", opened)
+	}
+
+	// Batch open skips items with no URL and says so
+	opened = nil
+	m.state = StateReviewing
+	m.listView.SetSelection([]int{0, 1})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if len(opened) != 1 {
+		t.Errorf("expected only the item with a URL opened, got %v", opened)
+	}
+	if !strings.Contains(m.statusMessage, "Skipped 1 selected items with no URL") {
+		t.Errorf("expected skipped note, got %q", m.statusMessage)
+	}
+
+	// The real opener refuses an empty URL instead of running the command
+	if err := origOpen(""); err == nil {
+		t.Error("expected openURL to reject an empty URL")
+	}
+}
+
+func TestSnoozeItem(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "snooze-1", Title: "Undecided"},
+		{ID: "snooze-2", Title: "Keep"},
+	}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+
+	if m.items[0].Action != "snooze" {
+		t.Errorf("expected action 'snooze', got %q", m.items[0].Action)
+	}
+	if m.listView.VisibleCount() != 1 {
+		t.Errorf("expected snoozed item hidden, got %d visible", m.listView.VisibleCount())
+	}
+	if item := m.listView.CurrentItem(); item == nil || item.ID != "snooze-2" {
+		t.Errorf("expected cursor on remaining item, got %+v", item)
+	}
+
+	entry, ok := m.triageStore.GetItem("snooze-1")
+	if !ok {
+		t.Fatal("expected snooze recorded in store")
+	}
+	if entry.Source != "snooze" || entry.SnoozeUntil == "" {
+		t.Errorf("expected snooze source and until time, got %q/%q", entry.Source, entry.SnoozeUntil)
+	}
+}
+
+func TestSnoozeExcludedFromUpdates(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{
+		{ID: "1", Title: "Snoozed", Action: "snooze"},
+		{ID: "2", Title: "Archived", Action: "archive"},
+	}
+	m.listView.SetItems(m.items)
+
+	updates := m.buildUpdates()
+	if len(updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(updates))
+	}
+	if updates[0].DocumentID != "2" {
+		t.Errorf("expected update for item 2, got %q", updates[0].DocumentID)
+	}
+
+	out, err := m.ExportItemsToJSON()
+	if err == nil && strings.Contains(out, `"Snoozed"`) {
+		t.Error("expected snoozed item excluded from export")
+	}
+}
+
+func TestApplySavedTriagesSnoozeExpiry(t *testing.T) {
+	m := newTestModel()
+	m.triageStore.SnoozeItem("snooze-active", time.Now().Add(time.Hour))
+	m.triageStore.SnoozeItem("snooze-expired", time.Now().Add(-time.Hour))
+
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "snooze-active", Title: "Active"},
+		{ID: "snooze-expired", Title: "Expired"},
+	}})
+
+	if m.items[0].Action != "snooze" {
+		t.Errorf("expected active snooze restored, got %q", m.items[0].Action)
+	}
+	if m.items[1].Action != "" {
+		t.Errorf("expected expired snooze not restored, got %q", m.items[1].Action)
+	}
+	if m.listView.VisibleCount() != 1 {
+		t.Errorf("expected only the expired item visible, got %d", m.listView.VisibleCount())
+	}
+}
+
+func TestUpdateDocumentGone(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Item 1"},
+		{ID: "2", Title: "Item 2"},
+		{ID: "3", Title: "Item 3", DuplicateIDs: []string{"3b"}},
+	}})
+	typeKeys(m, "ajaja")
+	m.pushing = []string{"1", "2", "3", "3b"}
+
+	ch := make(chan readwise.BatchUpdateProgress, 4)
+	ch <- readwise.BatchUpdateProgress{Current: 1, Total: 4, ItemID: "1", Success: true}
+	ch <- readwise.BatchUpdateProgress{Current: 2, Total: 4, ItemID: "2", Gone: true, Error: fmt.Errorf("update failed with status 404: %w", readwise.ErrDocumentGone)}
+	ch <- readwise.BatchUpdateProgress{Current: 3, Total: 4, ItemID: "3", Success: true}
+	ch <- readwise.BatchUpdateProgress{Current: 4, Total: 4, ItemID: "3b", Gone: true, Error: readwise.ErrDocumentGone}
+	close(ch)
+
+	cmd := m.waitForUpdateProgress(ch, 0, 0, nil, nil)
+	var finished UpdateFinishedMsg
+	for {
+		msg := cmd()
+		if p, ok := msg.(ProgressMsg); ok {
+			cmd = m.waitForUpdateProgress(p.Channel, p.Success, p.Failed, p.Failures, p.Gone)
+			continue
+		}
+		finished = msg.(UpdateFinishedMsg)
+		break
+	}
+
+	if finished.Success != 2 || finished.Failed != 0 || len(finished.Failures) != 0 {
+		t.Errorf("expected 2 successes and no failures, got %+v", finished)
+	}
+	if len(finished.Gone) != 2 || finished.Gone[0] != "2" || finished.Gone[1] != "3b" {
+		t.Fatalf("expected 2 and 3b reported as gone, got %v", finished.Gone)
+	}
+
+	m.Update(finished)
+	if len(m.items) != 2 || m.items[0].ID != "1" || m.items[1].ID != "3" {
+		t.Fatalf("expected item 2 removed, got %+v", m.items)
+	}
+	if len(m.items[1].DuplicateIDs) != 0 {
+		t.Errorf("expected the gone duplicate dropped, got %v", m.items[1].DuplicateIDs)
+	}
+	if m.unpushedCount() != 0 {
+		t.Errorf("unpushedCount() = %d, want 0", m.unpushedCount())
+	}
+	if !strings.Contains(m.statusMessage, "(0 failed); 2 already deleted in Readwise") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+}
+
+func TestUpdateFailuresPropagate(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{
+		{ID: "1", Title: "Item 1", Action: "archive"},
+		{ID: "2", Title: "Item 2", Action: "later"},
+		{ID: "3", Title: "Item 3", Action: "delete"},
+	}
+	ch := make(chan readwise.BatchUpdateProgress, 3)
+	ch <- readwise.BatchUpdateProgress{Current: 1, Total: 3, ItemID: "1", Success: true}
+	ch <- readwise.BatchUpdateProgress{Current: 2, Total: 3, ItemID: "2", Error: fmt.Errorf("update failed with status 400")}
+	ch <- readwise.BatchUpdateProgress{Current: 3, Total: 3, ItemID: "3", Error: fmt.Errorf("server error: 500")}
+	close(ch)
+
+	cmd := m.waitForUpdateProgress(ch, 0, 0, nil, nil)
+	var finished UpdateFinishedMsg
+	for {
+		msg := cmd()
+		if p, ok := msg.(ProgressMsg); ok {
+			cmd = m.waitForUpdateProgress(p.Channel, p.Success, p.Failed, p.Failures, p.Gone)
+			continue
+		}
+		finished = msg.(UpdateFinishedMsg)
+		break
+	}
+
+	if finished.Success != 1 || finished.Failed != 2 {
+		t.Errorf("expected 1 success and 2 failures, got %d/%d", finished.Success, finished.Failed)
+	}
+	if len(finished.Failures) != 2 || finished.Failures[0].ID != "2" || finished.Failures[1].ID != "3" {
+		t.Fatalf("expected failures for items 2 and 3, got %+v", finished.Failures)
+	}
+
+	m.Update(finished)
+	if m.state != StateDone {
+		t.Errorf("expected StateDone, got %v", m.state)
+	}
+	view := m.doneView()
+	if !strings.Contains(view, "Item 2") || !strings.Contains(view, "status 400") {
+		t.Error("expected done view to list failed item and error")
+	}
+}
+
+func TestRetryFailedUpdates(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.items = []Item{
+		{ID: "1", Title: "Item 1", Action: "archive"},
+		{ID: "2", Title: "Item 2", Action: "later"},
+		{ID: "3", Title: "Item 3", Action: "delete"},
+	}
+	m.listView.SetItems(m.items)
+	m.Update(UpdateFinishedMsg{Success: 1, Failed: 2, Failures: []UpdateFailure{
+		{ID: "2", Error: "update failed with status 400"},
+		{ID: "3", Error: "server error: 500"},
+	}})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if m.state != StateUpdating {
+		t.Fatalf("expected StateUpdating after retry, got %v", m.state)
+	}
+
+	for {
+		msg := cmd()
+		if p, ok := msg.(ProgressMsg); ok {
+			cmd = m.waitForUpdateProgress(p.Channel, p.Success, p.Failed, p.Failures, p.Gone)
+			continue
+		}
+		finished := msg.(UpdateFinishedMsg)
+		if finished.Success != 2 || finished.Failed != 0 {
+			t.Errorf("expected 2 successful retries, got %d/%d", finished.Success, finished.Failed)
+		}
+		break
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(paths) != 2 || !strings.Contains(paths[0], "/update/2/") || !strings.Contains(paths[1], "/update/3/") {
+		t.Errorf("expected retry to re-submit only items 2 and 3, got %v", paths)
+	}
+}
+
+func TestHideFinishedFilter(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "progress-1", Title: "Finished", Progress: 0.95},
+		{ID: "progress-2", Title: "Halfway", Progress: 0.5},
+		{ID: "progress-3", Title: "Exactly ninety", Progress: 0.9},
+	}})
+
+	if m.listView.VisibleCount() != 3 {
+		t.Fatalf("expected all items visible by default, got %d", m.listView.VisibleCount())
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	if !m.hideFinished {
+		t.Fatal("expected H to enable the finished filter")
+	}
+	if m.listView.VisibleCount() != 2 {
+		t.Errorf("expected finished item hidden, got %d visible", m.listView.VisibleCount())
+	}
+	for row := 0; row < m.listView.VisibleCount(); row++ {
+		if item := m.listView.GetItem(m.listView.ItemIndex(row)); item.ID == "progress-1" {
+			t.Error("expected finished item to be filtered out")
+		}
+	}
+	if !strings.Contains(m.View(), "finished hidden") {
+		t.Error("expected header to indicate the filter is active")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	if m.listView.VisibleCount() != 3 {
+		t.Errorf("expected toggling again to show all items, got %d", m.listView.VisibleCount())
+	}
+}
+
+func TestMouseClick(t *testing.T) {
+	var opened []string
+	origOpen := openURL
+	openURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	defer func() { openURL = origOpen }()
+
+	m := newTestModel()
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "mouse-1", Title: "Item 1", URL: "https://example.com/1"},
+		{ID: "mouse-2", Title: "Item 2", URL: "https://example.com/2"},
+		{ID: "mouse-3", Title: "Item 3", URL: "https://example.com/3"},
+	}})
+
+	click := func(y int, ctrl bool) {
+		m.Update(tea.MouseMsg{X: 10, Y: y, Ctrl: ctrl, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	}
+
+	// Row 0 is rendered at y=4 (review header + column header)
+	if lines := strings.Split(m.View(), "\n"); len(lines) < 5 || !strings.Contains(lines[4], "Item 1") {
+		t.Fatalf("expected first row on line 4 of the review view")
+	}
+
+	click(6, false)
+	if m.listView.Cursor() != 2 {
+		t.Errorf("expected click to move cursor to row 2, got %d", m.listView.Cursor())
+	}
+	if len(opened) != 0 {
+		t.Errorf("expected single click not to open, got %v", opened)
+	}
+
+	click(5, true)
+	if m.listView.Cursor() != 1 || !m.batchMode || !m.listView.IsSelected(1) {
+		t.Errorf("expected ctrl-click to select row 1, cursor=%d selected=%v", m.listView.Cursor(), m.listView.GetSelected())
+	}
+
+	click(4, false)
+	click(4, false)
+	if len(opened) != 1 || opened[0] != "https://example.com/1" {
+		t.Errorf("expected double-click to open item 1, got %v", opened)
+	}
+
+	// Clicks on the header are ignored
+	click(1, false)
+	if m.listView.Cursor() != 0 {
+		t.Errorf("expected header click to leave cursor unchanged, got %d", m.listView.Cursor())
+	}
+}
+
+func TestSourceFilter(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "source-1", Title: "Post A", SiteName: "substack.com"},
+		{ID: "source-2", Title: "Post B", SiteName: "medium.com"},
+		{ID: "source-3", Title: "Post C", Source: "substack.com"},
+	}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	if !m.editingFilter {
+		t.Fatal("expected / to open the filter prompt")
+	}
+	for _, r := range "source:substack" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.editingFilter || m.filterQuery != "source:substack" {
+		t.Fatalf("expected filter applied, got editing=%v query=%q", m.editingFilter, m.filterQuery)
+	}
+	if m.listView.VisibleCount() != 2 {
+		t.Fatalf("expected 2 substack items, got %d", m.listView.VisibleCount())
+	}
+	for row := 0; row < m.listView.VisibleCount(); row++ {
+		if item := m.listView.GetItem(m.listView.ItemIndex(row)); item.ID == "source-2" {
+			t.Error("expected medium.com item to be filtered out")
+		}
+	}
+
+	// Clearing the filter shows everything again
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlU})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.listView.VisibleCount() != 3 {
+		t.Errorf("expected all items after clearing filter, got %d", m.listView.VisibleCount())
+	}
+}
+
+func TestFilterPromptConsumesQuit(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "quit-1", Title: "Item"}}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	if cmd != nil {
+		t.Error("expected q to be typed into the filter, not quit")
+	}
+	if m.filterInput != "q" {
+		t.Errorf("expected filter input 'q', got %q", m.filterInput)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.editingFilter || m.filterQuery != "" {
+		t.Error("expected esc to cancel without applying")
+	}
+}
+
+func TestEditNotes(t *testing.T) {
+	var mu sync.Mutex
+	var gotNotes []any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		json.NewDecoder(r.Body).Decode(&payload)
+		mu.Lock()
+		gotNotes = append(gotNotes, payload["notes"])
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "notes-1", Title: "Item 1"},
+		{ID: "notes-2", Title: "Item 2", Notes: "server note"},
+	}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if !m.editingNotes {
+		t.Fatal("expected c to open the notes editor")
+	}
+	// q and ? are typed into the editor rather than quitting or toggling help
+	for _, r := range "quick read?" {
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		if cmd != nil {
+			t.Fatalf("expected %q to be typed into the editor", r)
+		}
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.editingNotes {
+		t.Error("expected enter to close the notes editor")
+	}
+	if m.items[0].Notes != "quick read?" {
+		t.Fatalf("expected notes 'quick read?', got %q", m.items[0].Notes)
+	}
+
+	// Existing server notes prefill the editor
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if m.tagsInput != "server note" {
+		t.Errorf("expected editor prefilled with server notes, got %q", m.tagsInput)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	m.items[0].Action = "later"
+	updates := m.buildUpdates()
+	if len(updates) != 1 || updates[0].Notes != "quick read?" {
+		t.Fatalf("expected update request with notes, got %+v", updates)
+	}
+
+	cmd := m.runUpdates(updates)
+	for {
+		msg := cmd()
+		if p, ok := msg.(ProgressMsg); ok {
+			cmd = m.waitForUpdateProgress(p.Channel, p.Success, p.Failed, p.Failures, p.Gone)
+			continue
+		}
+		break
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(gotNotes) != 1 || gotNotes[0] != "quick read?" {
+		t.Errorf("expected notes in PATCH body, got %v", gotNotes)
+	}
+}
+
+func TestEmptyNotesOmittedFromUpdate(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{{ID: "notes-3", Title: "Item", Action: "archive"}}
+	m.listView.SetItems(m.items)
+
+	updates := m.buildUpdates()
+	if len(updates) != 1 || updates[0].Notes != "" {
+		t.Errorf("expected no notes when unset, got %+v", updates)
+	}
+}
+
+func TestStartFreshResetsProgress(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{
+		{ID: "1", Title: "Item 1", Action: "read_now", Progress: 0.4},
+		{ID: "2", Title: "Item 2", Action: "read_now", Progress: 0.6},
+		{ID: "3", Title: "Item 3", Action: "later", Progress: 0.5},
+	}
+	m.state = StateReviewing
+	m.listView.SetItems(m.items)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}})
+	if !m.items[0].StartFresh {
+		t.Fatal("expected 0 to mark the focused item")
+	}
+	if detail := m.listView.DetailView(120, m.styles); !strings.Contains(detail, "start fresh") {
+		t.Error("expected start fresh shown in the detail line")
+	}
+
+	// In batch mode, 0 marks every selected item
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}})
+	if !m.items[1].StartFresh || !m.items[2].StartFresh {
+		t.Fatalf("expected selected items marked, got %+v", m.items)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}})
+	if m.items[1].StartFresh || m.items[2].StartFresh {
+		t.Fatal("expected a second 0 to clear the selected items")
+	}
+
+	for _, u := range m.buildUpdates() {
+		switch u.DocumentID {
+		case "1":
+			if u.ReadingProgress == nil || *u.ReadingProgress != 0 {
+				t.Errorf("expected progress reset for item 1, got %v", u.ReadingProgress)
+			}
+		default:
+			if u.ReadingProgress != nil {
+				t.Errorf("expected no progress for item %s, got %v", u.DocumentID, *u.ReadingProgress)
+			}
+		}
+	}
+}
+
+func TestStarItems(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Standout"},
+		{ID: "2", Title: "Other"},
+		{ID: "3", Title: "Third"},
+	}})
+	m.listView.SetWidthHeight(160, 20)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	if !m.items[0].Starred {
+		t.Fatal("expected * to star the focused item")
+	}
+	if entry, ok := m.triageStore.GetItem("1"); !ok || !entry.Starred {
+		t.Errorf("expected the star saved, got %+v", entry)
+	}
+	if !strings.Contains(m.listView.View(), "★ Standout") {
+		t.Error("expected a star glyph in the list")
+	}
+
+	// The star is orthogonal to the action and survives a refetch
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Standout"}, {ID: "2", Title: "Other"}, {ID: "3", Title: "Third"}}})
+	if !m.items[0].Starred || m.items[0].Action != "archive" {
+		t.Errorf("expected star and action restored, got %+v", m.items[0])
+	}
+
+	// Batch mode stars every selected item
+	m.listView.SetCursor(1)
+	m.listView.ToggleSelection()
+	m.listView.SetCursor(2)
+	m.listView.ToggleSelection()
+	m.batchMode = true
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	if !m.items[1].Starred || !m.items[2].Starred {
+		t.Errorf("expected selected items starred, got %+v", m.items)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	if m.items[1].Starred || m.items[2].Starred {
+		t.Error("expected a second * to unstar the selection")
+	}
+	m.listView.SetSelection(nil)
+	m.batchMode = false
+
+	data, err := m.ExportAllWithDecisions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(data, `"starred": true`) != 1 {
+		t.Errorf("expected only the starred item flagged in the export, got:\n%s", data)
+	}
+
+	// Stars are only pushed as a tag with star_tag
+	updates := m.buildUpdates()
+	if len(updates) != 1 || slices.Contains(updates[0].Tags, "favorite") {
+		t.Errorf("expected no star tag by default, got %+v", updates)
+	}
+	m.cfg.StarTag = "favorite"
+	m.items[0].OriginalTags = []string{"rss"}
+	updates = m.buildUpdates()
+	if len(updates) != 1 || !equalStrings(updates[0].Tags, []string{"rss", "favorite"}) {
+		t.Errorf("expected the star tag pushed once, got %+v", updates)
+	}
+	m.items[0].OriginalTags = []string{"favorite"}
+	if updates := m.buildUpdates(); !equalStrings(updates[0].Tags, []string{"favorite"}) {
+		t.Errorf("expected an existing star tag not duplicated, got %v", updates[0].Tags)
+	}
+
+	// Unstarring takes a star tag already on Readwise off again
+	m.items[0].OriginalTags = []string{"rss", "favorite"}
+	m.items[0].Starred = false
+	if updates := m.buildUpdates(); !equalStrings(updates[0].Tags, []string{"rss"}) {
+		t.Errorf("expected the star tag removed after unstarring, got %v", updates[0].Tags)
+	}
+}
+
+func TestNeedsReviewPush(t *testing.T) {
+	item := Item{ID: "1", Action: "needs_review", OriginalTags: []string{"go"}}
+	tests := []struct {
+		name      string
+		locations map[string]string
+		tag       string
+		want      readwise.UpdateRequest
+	}{
+		{"default moves feed items to the inbox", nil, "", readwise.UpdateRequest{DocumentID: "1", Location: "new", Tags: []string{"go"}}},
+		{"left untouched", map[string]string{"needs_review": ""}, "", readwise.UpdateRequest{DocumentID: "1", Tags: []string{"go"}}},
+		{"tagged in place", map[string]string{"needs_review": ""}, "needs-review", readwise.UpdateRequest{DocumentID: "1", Tags: []string{"go", "needs-review"}}},
+		{"moved", map[string]string{"needs_review": "shortlist"}, "", readwise.UpdateRequest{DocumentID: "1", Location: "shortlist", Tags: []string{"go"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.fetchLocation = "feed"
+			m.cfg.ActionLocations = tt.locations
+			m.cfg.NeedsReviewTag = tt.tag
+			got, ok := m.updateRequestFor(item)
+			if !ok || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("updateRequestFor() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// Once decided otherwise, a tag pushed earlier comes off
+	m := newTestModel()
+	m.cfg.NeedsReviewTag = "needs-review"
+	decided := Item{ID: "1", Action: "archive", OriginalTags: []string{"go", "needs-review"}}
+	if got, _ := m.updateRequestFor(decided); !reflect.DeepEqual(got.Tags, []string{"go"}) {
+		t.Errorf("tags after archiving = %v, want [go]", got.Tags)
+	}
+}
+
+func TestActionLocations(t *testing.T) {
+	m := newTestModel()
+	m.fetchLocation = "feed"
+	m.items = []Item{
+		{ID: "1", Action: "read_now"},
+		{ID: "2", Action: "needs_review"},
+		{ID: "3", Action: "later"},
+		{ID: "4", Action: "delete"},
+	}
+
+	locations := func() map[string]string {
+		got := map[string]string{}
+		for _, u := range m.buildUpdates() {
+			got[u.DocumentID] = u.Location
+		}
+		return got
+	}
+
+	want := map[string]string{"1": "new", "2": "new", "3": "later", "4": "archive"}
+	if got := locations(); !maps.Equal(got, want) {
+		t.Errorf("default locations = %v, want %v", got, want)
+	}
+
+	// Mapped actions follow the config; unmapped ones keep the defaults
+	m.cfg.ActionLocations = map[string]string{"read_now": "shortlist", "needs_review": ""}
+	want = map[string]string{"1": "shortlist", "2": "", "3": "later", "4": "archive"}
+	if got := locations(); !maps.Equal(got, want) {
+		t.Errorf("mapped locations = %v, want %v", got, want)
+	}
+}
+
+func TestDemoModel(t *testing.T) {
+	m := NewDemoModel()
+
+	if m.state != StateReviewing {
+		t.Fatalf("expected demo to start in StateReviewing, got %v", m.state)
+	}
+	if len(m.items) != len(demoItems()) || m.listView.VisibleCount() != len(demoItems()) {
+		t.Errorf("expected %d seeded items, got %d (%d visible)", len(demoItems()), len(m.items), m.listView.VisibleCount())
+	}
+	if _, ok := m.triageStore.(*config.MemTriageStore); !ok {
+		t.Errorf("expected in-memory triage store, got %T", m.triageStore)
+	}
+
+	// Triage an item and push: the fake update succeeds without a network
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	cmd := m.startUpdating()
+	var finished UpdateFinishedMsg
+	for {
+		msg := cmd()
+		if p, ok := msg.(ProgressMsg); ok {
+			cmd = m.waitForUpdateProgress(p.Channel, p.Success, p.Failed, p.Failures, p.Gone)
+			continue
+		}
+		finished = msg.(UpdateFinishedMsg)
+		break
+	}
+	if finished.Success != 1 || finished.Failed != 0 {
+		t.Errorf("expected 1 successful demo update, got %d/%d", finished.Success, finished.Failed)
+	}
+
+	// Refetching returns the sample items again
+	if msg, ok := m.startFetching()().(ItemsLoadedMsg); !ok || len(msg.Items) != len(demoItems()) {
+		t.Errorf("expected demo fetch to return sample items, got %T", msg)
+	}
+}
+
+func TestTinyWindowLayouts(t *testing.T) {
+	sizes := []struct{ width, height int }{
+		{10, 3},
+		{1, 1},
+		{200, 2},
+		{5, 40},
+		{30, 8},
+	}
+
+	for _, sz := range sizes {
+		for _, editing := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%dx%d editing=%v", sz.width, sz.height, editing), func(t *testing.T) {
+				m := newTestModel()
+				m.Update(ItemsLoadedMsg{Items: []Item{
+					{ID: "1", Title: "An item with a fairly long title", Tags: []string{"go"}},
+					{ID: "2", Title: "Second"},
+				}})
+				if editing {
+					m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+				}
+				// Resize while the popup is open
+				m.Update(tea.WindowSizeMsg{Width: sz.width, Height: sz.height})
+
+				view := m.View()
+				if view == "" {
+					t.Fatal("expected non-empty view")
+				}
+				if lines := strings.Count(view, "\n") + 1; lines != sz.height {
+					t.Errorf("expected %d lines, got %d", sz.height, lines)
+				}
+				if editing && sz.width >= 10 && !strings.Contains(view, "tags:") {
+					t.Error("expected tag input to stay visible")
+				}
+			})
+		}
+	}
+}
+
+func TestUpdatesApplyToDuplicates(t *testing.T) {
+	m := newTestModel()
+	m.items = []Item{
+		{ID: "dup-1", Title: "Article", Action: "archive", DuplicateIDs: []string{"dup-2", "dup-3"}},
+		{ID: "solo", Title: "Other", Action: "later"},
+	}
+	m.listView.SetItems(m.items)
+
+	updates := m.buildUpdates()
+	var ids []string
+	for _, u := range updates {
+		ids = append(ids, u.DocumentID)
+		if u.DocumentID != "solo" && u.Location != "archive" {
+			t.Errorf("expected duplicate %q to share the archive update, got %q", u.DocumentID, u.Location)
+		}
+	}
+	if strings.Join(ids, ",") != "dup-1,dup-2,dup-3,solo" {
+		t.Errorf("expected updates for survivor and duplicates, got %v", ids)
+	}
+}
+
+func TestThemeEnvOverride(t *testing.T) {
+	indexOf := func(name string) int {
+		for i, n := range GetThemeNames() {
+			if n == name {
+				return i
+			}
+		}
+		return -1
+	}
+
+	baseline := newTestModel().themeIndex
+
+	t.Run("valid theme", func(t *testing.T) {
+		t.Setenv("READWISE_TRIAGE_THEME", "nord")
+		before, _ := config.Load()
+
+		m := newTestModel()
+		if m.themeIndex != indexOf("nord") {
+			t.Errorf("expected themeIndex %d for nord, got %d", indexOf("nord"), m.themeIndex)
+		}
+
+		// Saving other preferences must not persist the override
+		m.saveLookback()
+		after, _ := config.Load()
+		if after.Theme != before.Theme {
+			t.Errorf("expected persisted theme %q to be unchanged, got %q", before.Theme, after.Theme)
+		}
+	})
+
+	t.Run("invalid theme", func(t *testing.T) {
+		t.Setenv("READWISE_TRIAGE_THEME", "no-such-theme")
+		if m := newTestModel(); m.themeIndex != baseline {
+			t.Errorf("expected invalid theme to be ignored (index %d), got %d", baseline, m.themeIndex)
+		}
+	})
+}
+
+func TestSinceLastFetch(t *testing.T) {
+	var mu sync.Mutex
+	var gotUpdatedAfter string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gotUpdatedAfter = r.URL.Query().Get("updatedAfter")
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":1,"nextPageCursor":null,"results":[{"id":"new-1","title":"Fresh"}]}`)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	last := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.lastVisit = map[string]time.Time{"new": last}
+
+	if !strings.Contains(m.View(), "Fetch last") {
+		t.Error("expected days lookback by default")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if !m.sinceLast {
+		t.Fatal("expected s to enable since-last-fetch mode")
+	}
+	if !strings.Contains(m.View(), "Since last fetch") {
+		t.Error("expected config view to show since-last-fetch mode")
+	}
+
+	msg := m.startFetching()()
+	mu.Lock()
+	if gotUpdatedAfter != last.Format(time.RFC3339) {
+		t.Errorf("updatedAfter = %q, want %q", gotUpdatedAfter, last.Format(time.RFC3339))
+	}
+	mu.Unlock()
+
+	m.Update(msg)
+	if !strings.Contains(m.statusMessage, "new inbox items since") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+	if at := m.cfg.LastFetchAt["new"]; !at.After(last) {
+		t.Errorf("expected last fetch time to be recorded, got %v", at)
+	}
+	// The session baseline stays put so a refresh shows the same window
+	if !m.lastVisit["new"].Equal(last) {
+		t.Errorf("session baseline changed to %v", m.lastVisit["new"])
+	}
+
+	// Without a stored time for the location, fall back to the days lookback
+	m.state = StateConfig
+	m.fetchLocation = "feed"
+	if !strings.Contains(m.View(), "no previous fetch") {
+		t.Error("expected fallback note when location has no previous fetch")
+	}
+}
+
+func TestActionURLs(t *testing.T) {
+	items := []Item{
+		{ID: "1", Action: "needs_review", URL: "https://example.com/1", ReaderURL: "https://read.readwise.io/read/1"},
+		{ID: "2", Action: "archive", URL: "https://example.com/2", ReaderURL: "https://read.readwise.io/read/2"},
+		{ID: "3", Action: "needs_review"},
+		{ID: "4", Action: "needs_review", URL: "https://example.com/4"},
+		{ID: "5", Action: "needs_review", URL: "https://example.com/5"},
+		{ID: "6", Action: "read_now", URL: "https://example.com/6", ReaderURL: "https://read.readwise.io/read/6"},
+		{ID: "7", Action: "read_now", URL: "https://example.com/7"},
+		{ID: "8", Action: "read_now", ReaderURL: "https://read.readwise.io/read/8"},
+	}
+
+	tests := []struct {
+		name       string
+		action     string
+		readerView bool
+		visible    func(Item) bool
+		limit      int
+		wantURLs   []string
+		wantTotal  int
+	}{
+		{"under cap", "needs_review", false, nil, 10, []string{"https://example.com/1", "https://example.com/4", "https://example.com/5"}, 3},
+		{"capped", "needs_review", false, nil, 2, []string{"https://example.com/1", "https://example.com/4"}, 3},
+		{"hidden items skipped", "needs_review", false, func(it Item) bool { return it.ID != "4" }, 10, []string{"https://example.com/1", "https://example.com/5"}, 2},
+		{"read_now in Reader", "read_now", true, nil, 10, []string{"https://read.readwise.io/read/6", "https://read.readwise.io/read/8"}, 2},
+		{"read_now in Reader capped", "read_now", true, nil, 1, []string{"https://read.readwise.io/read/6"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urls, total := actionURLs(items, tt.action, tt.readerView, tt.visible, tt.limit)
+			if total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", total, tt.wantTotal)
+			}
+			if strings.Join(urls, ",") != strings.Join(tt.wantURLs, ",") {
+				t.Errorf("urls = %v, want %v", urls, tt.wantURLs)
+			}
+		})
+	}
+}
+
+func TestOpenReadNowInReader(t *testing.T) {
+	var opened []string
+	origOpen := openURL
+	openURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	defer func() { openURL = origOpen }()
+
+	m := newTestModel()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'W'}})
+	var items []Item
+	for i := 0; i < maxBulkOpen+2; i++ {
+		items = append(items, Item{ID: fmt.Sprintf("rn-%d", i), Title: "Item", Action: "read_now", URL: fmt.Sprintf("https://example.com/%d", i), ReaderURL: fmt.Sprintf("https://read.readwise.io/read/rn-%d", i)})
+	}
+	items = append(items, Item{ID: "other", Title: "Other", Action: "later", ReaderURL: "https://read.readwise.io/read/other"})
+
+	m.Update(ItemsLoadedMsg{Items: items})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'W'}})
+	if m.state != StateConfirming || len(opened) != 0 {
+		t.Fatalf("expected confirmation before opening, state %v, opened %d", m.state, len(opened))
+	}
+	if view := m.View(); !strings.Contains(view, "Open Read Now in Reader") || !strings.Contains(view, "first 10 of 12 read_now") {
+		t.Errorf("expected the Reader confirmation, got:\n%s", view)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if len(opened) != maxBulkOpen {
+		t.Fatalf("expected %d URLs opened, got %d", maxBulkOpen, len(opened))
+	}
+	for _, url := range opened {
+		if !strings.HasPrefix(url, "https://read.readwise.io/read/rn-") {
+			t.Errorf("expected only read_now Reader URLs, opened %q", url)
+		}
+	}
+
+	m.Update(ItemsLoadedMsg{Items: items[len(items)-1:]})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'W'}})
+	if m.state != StateMessage || !strings.Contains(m.statusMessage, "No read_now items") {
+		t.Errorf("expected a message when nothing is read_now, got state %v and %q", m.state, m.statusMessage)
+	}
+}
+
+func TestOpenNeedsReview(t *testing.T) {
+	var opened []string
+	origOpen := openURL
+	openURL = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	defer func() { openURL = origOpen }()
+
+	m := newTestModel()
+	var items []Item
+	for i := 0; i < maxBulkOpen+3; i++ {
+		items = append(items, Item{ID: fmt.Sprintf("nr-%d", i), Title: "Item", Action: "needs_review", URL: fmt.Sprintf("https://example.com/%d", i)})
+	}
+	items = append(items, Item{ID: "other", Title: "Other", Action: "archive", URL: "https://example.com/other"})
+	m.Update(ItemsLoadedMsg{Items: items[:2]})
+
+	// Few enough items open immediately
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	if len(opened) != 2 || m.state != StateReviewing {
+		t.Fatalf("expected 2 URLs opened immediately, got %d (state %v)", len(opened), m.state)
+	}
+
+	// Over the cap asks first, and declining opens nothing
+	opened = nil
+	m.Update(ItemsLoadedMsg{Items: items})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	if m.state != StateConfirming || len(opened) != 0 {
+		t.Fatalf("expected confirmation before opening, state %v, opened %d", m.state, len(opened))
+	}
+	if view := m.View(); !strings.Contains(view, "first 10 of 13") {
+		t.Errorf("expected confirmation to mention the cap, got:\n%s", view)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if m.state != StateReviewing || len(opened) != 0 {
+		t.Fatalf("expected cancel to return to review without opening, opened %d", len(opened))
+	}
+
+	// Confirming opens only up to the cap
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if len(opened) != maxBulkOpen {
+		t.Errorf("expected %d URLs opened, got %d", maxBulkOpen, len(opened))
+	}
+	for _, url := range opened {
+		if url == "https://example.com/other" {
+			t.Error("opened a URL that is not needs_review")
+		}
+	}
+	if m.state != StateReviewing {
+		t.Errorf("expected review state after opening, got %v", m.state)
+	}
+}
+
+func TestArchiveUntriaged(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Go news"},
+		{ID: "2", Title: "Go tips", Action: "read_now", Priority: "high"},
+		{ID: "3", Title: "Go later", Action: "later"},
+		{ID: "4", Title: "Rust news"},
+		{ID: "5", Title: "Go digest"},
+	}})
+	m.filterQuery = "go"
+	m.listView.SetItems(m.items)
+
+	// Declining changes nothing
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	if m.state != StateConfirming {
+		t.Fatalf("expected confirmation, got %v", m.state)
+	}
+	if view := m.View(); !strings.Contains(view, "Archive 2 untriaged items?") {
+		t.Errorf("expected confirmation to count visible untriaged items, got:\n%s", view)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if m.state != StateReviewing || m.items[0].Action != "" {
+		t.Fatalf("expected cancel to leave items alone, state %v", m.state)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if m.state != StateReviewing {
+		t.Errorf("expected review state after archiving, got %v", m.state)
+	}
+	want := map[string]string{"1": "archive", "2": "read_now", "3": "later", "4": "", "5": "archive"}
+	for _, item := range m.items {
+		if item.Action != want[item.ID] {
+			t.Errorf("item %s action = %q, want %q", item.ID, item.Action, want[item.ID])
+		}
+	}
+	for _, id := range []string{"1", "5"} {
+		if entry, ok := m.triageStore.GetItem(id); !ok || entry.Action != "archive" {
+			t.Errorf("expected item %s saved as archive, got %+v", id, entry)
+		}
+	}
+	if _, ok := m.triageStore.GetItem("4"); ok {
+		t.Error("archived an item hidden by the filter")
+	}
+	if m.items[1].Priority != "high" {
+		t.Errorf("expected decided item untouched, got priority %q", m.items[1].Priority)
+	}
+
+	// Nothing left to archive
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'A'}})
+	if m.state != StateMessage || !strings.Contains(m.statusMessage, "No untriaged items") {
+		t.Errorf("expected nothing-to-archive message, got %v %q", m.state, m.statusMessage)
+	}
+}
+
+func TestCancelTriaging(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	m := newTestModel()
+	m.cfg = &config.Config{LLM: config.LLMConfig{Provider: "openai", APIKey: "sk-test", BaseURL: srv.URL}}
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Slow item"}}})
+
+	cmd := m.startTriaging()
+	if m.state != StateTriaging {
+		t.Fatalf("expected StateTriaging, got %v", m.state)
+	}
+	if !strings.Contains(m.View(), "esc") {
+		t.Error("expected triaging view to offer esc to cancel")
+	}
+	msgs := make(chan tea.Msg, 1)
+	go func() { msgs <- cmd() }()
+	<-started
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.state != StateReviewing {
+		t.Fatalf("expected esc to return to review, got %v", m.state)
+	}
+	if !strings.Contains(m.statusMessage, "canceled") {
+		t.Errorf("expected cancel message, got %q", m.statusMessage)
+	}
+
+	var msg tea.Msg
+	select {
+	case msg = <-msgs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("LLM request was not aborted after cancel")
+	}
+	tf, ok := msg.(TriageFinishedMsg)
+	if !ok || tf.Err == nil {
+		t.Fatalf("expected canceled TriageFinishedMsg with error, got %#v", msg)
+	}
+
+	// The late result is dropped instead of replacing the review screen
+	m.Update(msg)
+	if m.state != StateReviewing {
+		t.Errorf("expected abandoned result to be ignored, got state %v", m.state)
+	}
+}
+
+func TestRetriageFocusedItem(t *testing.T) {
+	var mu sync.Mutex
+	var prompt string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Messages []struct {
+				Role    string `json:"role"`
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		mu.Lock()
+		for _, msg := range req.Messages {
+			if msg.Role == "user" {
+				prompt = msg.Content
+			}
+		}
+		mu.Unlock()
+		content, _ := json.Marshal([]triage.Result{{
+			ID:             "2",
+			Title:          "Already triaged",
+			TriageDecision: triage.TriageDecision{Action: "later", Priority: "low"},
+		}})
+		json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{{"message": map[string]string{"role": "assistant", "content": string(content)}}},
+		})
+	}))
+	defer srv.Close()
+
+	m := newTestModel()
+	m.cfg = &config.Config{LLM: config.LLMConfig{Provider: "openai", APIKey: "sk-test", BaseURL: srv.URL}}
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Untriaged"},
+		{ID: "2", Title: "Already triaged", Action: "read_now", Priority: "high"},
+	}})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if cmd == nil || m.state != StateTriaging {
+		t.Fatalf("expected ctrl+t to start triaging, state %v", m.state)
+	}
+	m.Update(cmd())
+
+	mu.Lock()
+	defer mu.Unlock()
+	start := strings.Index(prompt, "[\n  {")
+	if start < 0 {
+		t.Fatalf("no items JSON in prompt: %q", prompt)
+	}
+	var sent []map[string]any
+	if err := json.NewDecoder(strings.NewReader(prompt[start:])).Decode(&sent); err != nil {
+		t.Fatalf("items JSON did not parse: %v", err)
+	}
+	if len(sent) != 1 || sent[0]["id"] != "2" {
+		t.Errorf("expected only the focused item to be sent, got %v", sent)
+	}
+	if m.items[1].Action != "later" || m.items[1].Priority != "low" {
+		t.Errorf("expected re-triage to replace the decision, got %q/%q", m.items[1].Action, m.items[1].Priority)
+	}
+	if m.items[0].Action != "" {
+		t.Errorf("expected other items untouched, got %q", m.items[0].Action)
+	}
+}
+
+// statusHTTPClient answers every request with a fixed status or error.
+type statusHTTPClient struct {
+	status int
+	err    error
+}
+
+func (c statusHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &http.Response{StatusCode: c.status, Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestVerifyToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		client statusHTTPClient
+		want   string
+		wantOK bool
+	}{
+		{"valid", "good", statusHTTPClient{status: http.StatusNoContent}, "token valid", true},
+		{"invalid", "bad", statusHTTPClient{status: http.StatusUnauthorized}, "token invalid", false},
+		{"network error", "good", statusHTTPClient{err: fmt.Errorf("dial tcp: no route to host")}, "could not verify token: dial tcp: no route to host", false},
+		{"no token", "", statusHTTPClient{status: http.StatusNoContent}, "no token configured", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origClient := newReadwiseClient
+			newReadwiseClient = func(token string) (*readwise.Client, error) {
+				return readwise.NewClient(token, readwise.WithHTTPClient(tt.client))
+			}
+			defer func() { newReadwiseClient = origClient }()
+
+			m := newTestModel()
+			m.cfg = &config.Config{ReadwiseToken: tt.token}
+			_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'V'}})
+			if cmd != nil {
+				m.Update(cmd())
+			}
+			if m.tokenStatus != tt.want {
+				t.Errorf("tokenStatus = %q, want %q", m.tokenStatus, tt.want)
+			}
+			if m.tokenOK != tt.wantOK {
+				t.Errorf("tokenOK = %v, want %v", m.tokenOK, tt.wantOK)
+			}
+			if m.state != StateConfig {
+				t.Errorf("expected to stay on the config screen, got %v", m.state)
+			}
+			if !strings.Contains(m.View(), tt.want) {
+				t.Errorf("expected config view to show %q", tt.want)
+			}
+		})
+	}
+}
+
+// pushCheckHTTPClient answers the token check with authStatus and counts
+// every other request.
+type pushCheckHTTPClient struct {
+	authStatus int
+	authErr    error
+	updates    *atomic.Int32
+}
+
+func (c pushCheckHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "/auth/") {
+		if c.authErr != nil {
+			return nil, c.authErr
+		}
+		return &http.Response{StatusCode: c.authStatus, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}
+	c.updates.Add(1)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestPushTokenCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      pushCheckHTTPClient
+		wantState   State
+		wantMessage string
+		wantUpdates int32
+	}{
+		{"valid token pushes", pushCheckHTTPClient{authStatus: http.StatusNoContent}, StateUpdating, "Preparing updates", 1},
+		{"invalid token stops", pushCheckHTTPClient{authStatus: http.StatusUnauthorized}, StateMessage, "Readwise rejected the token", 0},
+		{"network down stops", pushCheckHTTPClient{authErr: fmt.Errorf("dial tcp: no route to host")}, StateMessage, "could not reach Readwise: dial tcp: no route to host", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.client.updates = new(atomic.Int32)
+			origClient := newReadwiseClient
+			newReadwiseClient = func(token string) (*readwise.Client, error) {
+				return readwise.NewClient(token, readwise.WithHTTPClient(tt.client), readwise.WithMaxRetries(1))
+			}
+			defer func() { newReadwiseClient = origClient }()
+
+			m := newTestModel()
+			m.skipPushCheck = false
+			m.cfg = &config.Config{ReadwiseToken: "token"}
+			m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Item"}}})
+			typeKeys(m, "a")
+
+			_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'U'}})
+			if m.state != StateUpdating || !strings.Contains(m.View(), "Checking Readwise token") {
+				t.Fatalf("expected the token check before pushing, got state %v", m.state)
+			}
+			if tt.client.updates.Load() != 0 {
+				t.Fatal("expected no updates before the token check finishes")
+			}
+			_, cmd = m.Update(cmd())
+			if m.state != tt.wantState {
+				t.Fatalf("state = %v, want %v", m.state, tt.wantState)
+			}
+			if !strings.Contains(m.statusMessage, tt.wantMessage) {
+				t.Errorf("statusMessage = %q, want it to contain %q", m.statusMessage, tt.wantMessage)
+			}
+			if tt.wantState == StateUpdating {
+				// The batch sends its first update before reporting progress
+				if _, ok := cmd().(ProgressMsg); !ok {
+					t.Fatal("expected the batch to report progress")
+				}
+			} else if m.unpushedCount() != 1 {
+				t.Errorf("expected the decision to stay unpushed, got %d", m.unpushedCount())
+			}
+			if got := tt.client.updates.Load(); got != tt.wantUpdates {
+				t.Errorf("update requests = %d, want %d", got, tt.wantUpdates)
+			}
+		})
+	}
+}
+
+func TestDoneKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       tea.KeyMsg
+		wantState State
+		wantQuit  bool
+	}{
+		{"r keeps reviewing", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")}, StateFetching, false},
+		{"enter keeps reviewing", tea.KeyMsg{Type: tea.KeyEnter}, StateFetching, false},
+		{"q quits", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}, StateDone, true},
+		{"ctrl+c quits", tea.KeyMsg{Type: tea.KeyCtrlC}, StateDone, true},
+		{"other keys ignored", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}, StateDone, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.Update(UpdateFinishedMsg{Success: 1})
+			_, cmd := m.Update(tt.key)
+			if m.state != tt.wantState {
+				t.Errorf("state = %v, want %v", m.state, tt.wantState)
+			}
+			gotQuit := false
+			if cmd != nil && m.state != StateFetching {
+				_, gotQuit = cmd().(tea.QuitMsg)
+			}
+			if gotQuit != tt.wantQuit {
+				t.Errorf("quit = %v, want %v", gotQuit, tt.wantQuit)
+			}
+		})
+	}
+
+	m := newTestModel()
+	m.Update(UpdateFinishedMsg{Success: 1})
+	if view := m.View(); !strings.Contains(view, "keep reviewing") || !strings.Contains(view, "quit") {
+		t.Errorf("expected done view to offer keep reviewing and quit, got:\n%s", view)
+	}
+}
+
+func TestTagDiff(t *testing.T) {
+	tests := []struct {
+		name        string
+		before      []string
+		after       []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{"unchanged", []string{"go"}, []string{"go"}, nil, nil},
+		{"llm tags added", []string{"go"}, []string{"go", "priority:high", "ai"}, []string{"priority:high", "ai"}, nil},
+		{"tag removed", []string{"go", "old"}, []string{"go"}, nil, []string{"old"}},
+		{"duplicates reported once", []string{"x", "x"}, []string{"y", "y"}, []string{"y"}, []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := tagDiff(tt.before, tt.after)
+			if !equalStrings(added, tt.wantAdded) {
+				t.Errorf("added = %v, want %v", added, tt.wantAdded)
+			}
+			if !equalStrings(removed, tt.wantRemoved) {
+				t.Errorf("removed = %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestConfirmTagReplacementWarning(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "Gains LLM tags", Action: "later", OriginalTags: []string{"go"}, Tags: []string{"ai", "tools"}},
+		{ID: "2", Title: "Gains priority", Action: "archive", Priority: "low"},
+		{ID: "3", Title: "Tags unchanged", Action: "archive", OriginalTags: []string{"keep"}},
+		{ID: "4", Title: "Untriaged", OriginalTags: []string{"x"}},
+	}})
+
+	changes := m.tagChanges()
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 tag changes, got %d: %+v", len(changes), changes)
+	}
+	if changes[0].Title != "Gains LLM tags" || !equalStrings(changes[0].Added, []string{"ai", "tools"}) {
+		t.Errorf("unexpected first change %+v", changes[0])
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	view := m.View()
+	for _, want := range []string{"Push 3 updates", "2 items will have their tags replaced", "+ai +tools", "+priority:low"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected confirmation to contain %q, got:\n%s", want, view)
+		}
+	}
+
+	// Selecting only the unchanged item drops the warning
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m.listView.MoveCursor(2)
+	m.listView.ToggleSelection()
+	if len(m.tagChanges()) != 0 {
+		t.Errorf("expected no tag changes for the selection, got %+v", m.tagChanges())
+	}
+}
+
+func TestCompactToggle(t *testing.T) {
+	m := newTestModel()
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Item", URL: "https://example.com/detail"}}})
+	if !strings.Contains(m.View(), "https://example.com/detail") {
+		t.Fatal("expected detail pane in the default view")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	if !m.listView.Compact() || !m.cfg.Compact {
+		t.Fatal("expected C to enable compact mode and store it in config")
+	}
+	if strings.Contains(m.View(), "https://example.com/detail") {
+		t.Error("expected compact mode to drop the detail pane")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'C'}})
+	if m.listView.Compact() || m.cfg.Compact {
+		t.Error("expected C to toggle compact mode off")
+	}
+}
+
+func TestCleanTitlesToggle(t *testing.T) {
+	m := newTestModel()
+	m.Update(tea.WindowSizeMsg{Width: 160, Height: 30})
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Rates rise again | The New York Times"}}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	if !m.listView.CleanTitles() || !m.cfg.CleanTitles {
+		t.Fatal("expected S to enable short titles and store it in config")
+	}
+	list := m.listView.View()
+	if !strings.Contains(list, "Rates rise again") || strings.Contains(list, "New York Times") {
+		t.Errorf("expected the site name stripped from the list, got:\n%s", list)
+	}
+	if detail := m.listView.DetailView(160, m.styles); !strings.Contains(detail, "Rates rise again | The New York Times") {
+		t.Errorf("expected the full title in the detail pane, got %q", detail)
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.CleanTitles {
+		t.Error("expected clean_titles saved to the config file")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	if m.listView.CleanTitles() || !strings.Contains(m.listView.View(), "New York Times") {
+		t.Error("expected S to restore the full titles")
+	}
+}
+
+func TestRemoveTagFromItems(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "A", Tags: []string{"inbox", "go"}},
+		{ID: "2", Title: "B", Tags: []string{"Inbox"}},
+		{ID: "3", Title: "C", Tags: []string{"go"}},
+		{ID: "4", Title: "D", Tags: []string{"inbox"}},
+	}})
+
+	changed := m.removeTagFromItems([]int{0, 1, 2}, "inbox")
+	if changed != 2 {
+		t.Errorf("expected 2 items changed, got %d", changed)
+	}
+	want := [][]string{{"go"}, {}, {"go"}, {"inbox"}}
+	for i, tags := range want {
+		if !equalStrings(m.items[i].Tags, tags) {
+			t.Errorf("item %d tags = %v, want %v", i, m.items[i].Tags, tags)
+		}
+	}
+	if entry, ok := m.triageStore.GetItem("1"); !ok || !equalStrings(entry.Tags, []string{"go"}) {
+		t.Errorf("expected removal to be persisted, got %+v", entry)
+	}
+
+	// Absent tags are a no-op
+	if changed := m.removeTagFromItems([]int{0, 2}, "missing"); changed != 0 {
+		t.Errorf("expected no changes for an absent tag, got %d", changed)
+	}
+	if !equalStrings(m.items[0].Tags, []string{"go"}) {
+		t.Errorf("absent tag removal changed tags to %v", m.items[0].Tags)
+	}
+}
+
+func TestBatchTagRemovalInput(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "A", Tags: []string{"inbox", "go", "draft"}},
+		{ID: "2", Title: "B", Tags: []string{"inbox"}},
+		{ID: "3", Title: "C", Tags: []string{"inbox"}},
+	}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	for _, r := range "-inbox, -draft" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if !equalStrings(m.items[0].Tags, []string{"go"}) {
+		t.Errorf("item 1 tags = %v, want [go]", m.items[0].Tags)
+	}
+	if len(m.items[1].Tags) != 0 {
+		t.Errorf("item 2 tags = %v, want none", m.items[1].Tags)
+	}
+	if !equalStrings(m.items[2].Tags, []string{"inbox"}) {
+		t.Errorf("unselected item tags changed to %v", m.items[2].Tags)
+	}
+	if !strings.Contains(m.statusMessage, "Removed inbox, draft") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+}
+
+func TestCompareSecondaryLLM(t *testing.T) {
+	newServer := func(action string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			content, _ := json.Marshal([]triage.Result{
+				{ID: "1", Title: "First", TriageDecision: triage.TriageDecision{Action: action, Priority: "high"}},
+				{ID: "2", Title: "Second", TriageDecision: triage.TriageDecision{Action: "archive", Priority: "low"}},
+			})
+			json.NewEncoder(w).Encode(map[string]any{
+				"choices": []map[string]any{{"message": map[string]string{"role": "assistant", "content": string(content)}}},
+			})
+		}))
+	}
+	primary := newServer("read_now")
+	defer primary.Close()
+	secondary := newServer("later")
+	defer secondary.Close()
+
+	m := newTestModel()
+	m.cfg = &config.Config{
+		LLM:          config.LLMConfig{Provider: "openai", APIKey: "sk-test", BaseURL: primary.URL},
+		SecondaryLLM: config.LLMConfig{Provider: "openai", APIKey: "sk-alt", BaseURL: secondary.URL},
+	}
+	m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "First"}, {ID: "2", Title: "Second"}}})
+
+	cmd := m.startTriaging()
+	if cmd == nil {
+		t.Fatal("expected a triage command")
+	}
+	m.Update(cmd())
+
+	if m.items[0].Action != "read_now" || m.items[0].AltAction != "later" || m.items[0].AltPriority != "high" {
+		t.Errorf("expected primary action with secondary alt, got %+v", m.items[0])
+	}
+	if m.items[1].Action != "archive" || m.items[1].AltAction != "archive" {
+		t.Errorf("expected matching decisions, got %+v", m.items[1])
+	}
+	if !strings.Contains(m.statusMessage, "secondary LLM decided 2 (1 disagree)") {
+		t.Errorf("expected comparison summary, got %q", m.statusMessage)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	if !strings.Contains(m.View(), "alt:later/high") {
+		t.Error("expected detail pane to show the secondary decision")
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		from, to string
+		want     []string
+		changed  bool
+	}{
+		{"rename in place", []string{"ai", "golang", "tools"}, "golang", "go", []string{"ai", "go", "tools"}, true},
+		{"case-insensitive match", []string{"GoLang"}, "golang", "go", []string{"go"}, true},
+		{"dedupe on collision", []string{"golang", "go"}, "golang", "go", []string{"go"}, true},
+		{"dedupe keeps target position", []string{"go", "ai", "golang"}, "golang", "go", []string{"go", "ai"}, true},
+		{"case-only rename", []string{"Go", "ai"}, "Go", "go", []string{"go", "ai"}, true},
+		{"absent tag is a no-op", []string{"ai"}, "golang", "go", []string{"ai"}, false},
+		{"no tags", nil, "golang", "go", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "A", Tags: tt.tags}}})
+
+			changed := m.renameTag(tt.from, tt.to)
+			if (changed == 1) != tt.changed {
+				t.Errorf("renameTag() = %d, want changed %v", changed, tt.changed)
+			}
+			if !equalStrings(m.items[0].Tags, tt.want) {
+				t.Errorf("tags = %v, want %v", m.items[0].Tags, tt.want)
+			}
+			entry, ok := m.triageStore.GetItem("1")
+			if tt.changed && (!ok || !equalStrings(entry.Tags, tt.want)) {
+				t.Errorf("expected rename to be persisted, got %+v", entry)
+			}
+			if !tt.changed && ok {
+				t.Errorf("expected no store write for a no-op, got %+v", entry)
+			}
+		})
+	}
+}
+
+func TestRenameTagInput(t *testing.T) {
+	m := newTestModel()
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "A", Tags: []string{"golang"}},
+		{ID: "2", Title: "B", Tags: []string{"golang", "go"}},
+		{ID: "3", Title: "C", Tags: []string{"rust"}},
+	}})
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if !m.renamingTag || !strings.Contains(m.View(), "Rename Tag") {
+		t.Fatal("expected ctrl+r to open the rename popup")
+	}
+	for _, r := range "golang, go" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.renamingTag {
+		t.Error("expected popup to close after enter")
+	}
+	if !strings.Contains(m.statusMessage, "Renamed golang to go on 2 items") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+	if !equalStrings(m.items[0].Tags, []string{"go"}) || !equalStrings(m.items[1].Tags, []string{"go"}) {
+		t.Errorf("unexpected tags %v, %v", m.items[0].Tags, m.items[1].Tags)
+	}
+
+	// Anything but two tags is rejected without changes
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(m.statusMessage, "exactly two tags") {
+		t.Errorf("expected usage message, got %q", m.statusMessage)
+	}
+}
+
+func TestSplitPriorityTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		priority string
+		rest     []string
+	}{
+		{"no priority", []string{"ai", "go"}, "", []string{"ai", "go"}},
+		{"priority stripped", []string{"ai", "priority:medium", "go"}, "medium", []string{"ai", "go"}},
+		{"case-insensitive", []string{"Priority:HIGH"}, "high", []string{}},
+		{"unknown value kept", []string{"priority:urgent"}, "", []string{"priority:urgent"}},
+		{"nil tags", nil, "", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priority, rest := splitPriorityTag(tt.tags)
+			if priority != tt.priority {
+				t.Errorf("priority = %q, want %q", priority, tt.priority)
+			}
+			if !equalStrings(rest, tt.rest) {
+				t.Errorf("rest = %v, want %v", rest, tt.rest)
+			}
+		})
+	}
+}
+
+func TestFetchRestoresPriorityTag(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":1,"nextPageCursor":null,"results":[{"id":"p-1","title":"Tagged","tags":["ai","priority:medium"]}]}`)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.Update(m.startFetching()())
+
+	if len(m.items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(m.items))
+	}
+	item := m.items[0]
+	if item.Priority != "medium" {
+		t.Errorf("Priority = %q, want medium", item.Priority)
+	}
+	if !equalStrings(item.OriginalTags, []string{"ai"}) {
+		t.Errorf("OriginalTags = %v, want priority tag stripped", item.OriginalTags)
+	}
+
+	// Pushing again sends the priority tag once and flags no tag changes
+	item.Action = "later"
+	update, _ := m.updateRequestFor(item)
+	if !equalStrings(update.Tags, []string{"ai", "priority:medium"}) {
+		t.Errorf("update tags = %v", update.Tags)
+	}
+	m.items[0].Action = "later"
+	if changes := m.tagChanges(); len(changes) != 0 {
+		t.Errorf("expected no tag changes, got %+v", changes)
+	}
+
+	// A locally chosen priority replaces the fetched one
+	item.Priority = "high"
+	update, _ = m.updateRequestFor(item)
+	if !equalStrings(update.Tags, []string{"ai", "priority:high"}) {
+		t.Errorf("update tags = %v", update.Tags)
+	}
+}
+
+func TestFetchDefaults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":3,"nextPageCursor":null,"results":[
+			{"id":"new-1","title":"Fresh"},
+			{"id":"stored-1","title":"Stored"},
+			{"id":"tagged-1","title":"Tagged","tags":["priority:high"]}]}`)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	tests := []struct {
+		name         string
+		action       string
+		priority     string
+		wantAction   string
+		wantPriority string
+	}{
+		{"no defaults", "", "", "", ""},
+		{"archive", "archive", "", "archive", ""},
+		{"action and priority", "later", "low", "later", "low"},
+		{"invalid values ignored", "someday", "urgent", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.cfg = &config.Config{ReadwiseToken: "test-token", DefaultAction: tt.action, DefaultPriority: tt.priority}
+			m.triageStore.SetItem("stored-1", "read_now", "", "manual", nil, nil)
+			m.Update(m.startFetching()())
+
+			if len(m.items) != 3 {
+				t.Fatalf("expected 3 items, got %d", len(m.items))
+			}
+			if got := m.items[0]; got.Action != tt.wantAction || got.Priority != tt.wantPriority {
+				t.Errorf("untriaged item = %q/%q, want %q/%q", got.Action, got.Priority, tt.wantAction, tt.wantPriority)
+			}
+			// Stored decisions keep their own action and (empty) priority
+			if got := m.items[1]; got.Action != "read_now" || got.Priority != "" {
+				t.Errorf("stored item = %q/%q, want read_now with no priority", got.Action, got.Priority)
+			}
+			// A priority tag from Readwise wins over the default
+			if got := m.items[2]; got.Priority != "high" {
+				t.Errorf("tagged item priority = %q, want high", got.Priority)
+			}
+			// Pre-filled items still count as untriaged for export and auto-triage
+			if m.triageStore.HasTriaged("new-1") {
+				t.Error("expected defaults not to be saved as decisions")
+			}
+			itemsJSON, err := m.buildTriageItemsJSON()
+			if err != nil {
+				t.Fatalf("expected pre-filled items sent to auto-triage, got %v", err)
+			}
+			if !strings.Contains(itemsJSON, `"new-1"`) || strings.Contains(itemsJSON, `"stored-1"`) {
+				t.Errorf("expected only undecided items sent, got %s", itemsJSON)
+			}
+
+			// Deciding replaces the default, so the item is no longer sent
+			m.setItemAction(&m.items[0], "later")
+			itemsJSON, _ = m.buildTriageItemsJSON()
+			if strings.Contains(itemsJSON, `"new-1"`) {
+				t.Errorf("expected a decided item to be skipped, got %s", itemsJSON)
+			}
+		})
+	}
+}
+
+func TestFetchDefaultsKeepStarredItems(t *testing.T) {
+	m := newTestModel()
+	m.cfg = &config.Config{DefaultAction: "later", DefaultPriority: "low"}
+	m.triageStore.SetStarred("1", true)
+
+	items := m.fetchedItems([]readwise.Item{{ID: "1", Title: "Starred"}})
+	m.applySavedTriagesTo(items)
+	got := items[0]
+	if !got.Starred {
+		t.Error("expected the star restored")
+	}
+	// A star isn't a decision, so the pre-fill stays and nothing looks triaged
+	if got.Action != "later" || got.Priority != "low" || !got.Defaulted || got.TriagedAt != "" {
+		t.Errorf("expected the default pre-fill kept, got %q/%q defaulted %v triaged %q", got.Action, got.Priority, got.Defaulted, got.TriagedAt)
+	}
+}
+
+func TestArchiveReadOnFetch(t *testing.T) {
+	m := newTestModel()
+	m.triageStore.SetItem("read-decided", "read_now", "", "manual", nil, nil)
+
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "read-new", Title: "Finished", Progress: 0.98},
+		{ID: "half", Title: "Partway", Progress: 0.3},
+		{ID: "read-decided", Title: "Finished but decided", Progress: 0.99},
+	}})
+
+	if got := m.items[0].Action; got != "archive" {
+		t.Errorf("0.98-progress item action = %q, want archive", got)
+	}
+	if entry, ok := m.triageStore.GetItem("read-new"); !ok || entry.Source != "auto-progress" {
+		t.Errorf("expected stored auto-progress decision, got %+v", entry)
+	}
+	if got := m.items[1].Action; got != "" {
+		t.Errorf("0.3-progress item action = %q, want untriaged", got)
+	}
+	if m.triageStore.HasTriaged("half") {
+		t.Error("expected the partly read item to stay untriaged")
+	}
+	if got := m.items[2].Action; got != "read_now" {
+		t.Errorf("stored decision action = %q, want read_now", got)
+	}
+	if !strings.Contains(m.statusMessage, "1 already read, marked archive") {
+		t.Errorf("expected auto-archive note, got %q", m.statusMessage)
+	}
+
+	// The user can override the suggestion
+	m.saveTriage("read-new", "later", "", nil)
+	if entry, _ := m.triageStore.GetItem("read-new"); entry.Source != "manual" || entry.Action != "later" {
+		t.Errorf("expected manual override, got %+v", entry)
+	}
+}
+
+func TestArchiveShortOnFetch(t *testing.T) {
+	m := newTestModel()
+	m.cfg = &config.Config{AutoArchiveUnderWords: 200}
+	m.triageStore.SetItem("short-decided", "read_now", "", "manual", nil, nil)
+
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "short", Title: "Blurb", WordCount: 100},
+		{ID: "long", Title: "Essay", WordCount: 500},
+		{ID: "unknown", Title: "Video"},
+		{ID: "short-decided", Title: "Short but decided", WordCount: 50},
+	}})
+
+	if got := m.items[0].Action; got != "archive" {
+		t.Errorf("100-word item action = %q, want archive", got)
+	}
+	if entry, ok := m.triageStore.GetItem("short"); !ok || entry.Source != "auto-short" {
+		t.Errorf("expected stored auto-short decision, got %+v", entry)
+	}
+	if got := m.items[1].Action; got != "" || m.triageStore.HasTriaged("long") {
+		t.Errorf("500-word item action = %q, want untriaged", got)
+	}
+	if got := m.items[2].Action; got != "" {
+		t.Errorf("item without a word count action = %q, want untriaged", got)
+	}
+	if got := m.items[3].Action; got != "read_now" {
+		t.Errorf("stored decision action = %q, want read_now", got)
+	}
+	if !strings.Contains(m.statusMessage, "1 under 200 words, marked archive") {
+		t.Errorf("expected auto-archive note, got %q", m.statusMessage)
+	}
+
+	// The user can override the suggestion
+	m.saveTriage("short", "later", "", nil)
+	if entry, _ := m.triageStore.GetItem("short"); entry.Source != "manual" || entry.Action != "later" {
+		t.Errorf("expected manual override, got %+v", entry)
+	}
+
+	// Off by default
+	m = newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "short", Title: "Blurb", WordCount: 100}}})
+	if got := m.items[0].Action; got != "" {
+		t.Errorf("expected no auto-archive without a threshold, got %q", got)
+	}
+}
+
+func TestFetchPresets(t *testing.T) {
+	m := newTestModel()
+	m.state = StateConfig
+	m.cfg.Presets = map[string]config.FetchPreset{
+		"videos":  {Location: "feed", Days: 3, Category: "video"},
+		"to-read": {Tag: "to-read"},
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if !m.choosingPreset {
+		t.Fatal("expected p to open the preset picker")
+	}
+	view := m.configView()
+	for _, want := range []string{"to-read", "videos", "feed · 3 days · video"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected picker to show %q", want)
+		}
+	}
+
+	// Presets are numbered by name, so 2 is "videos"
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	if cmd == nil || m.state != StateFetching {
+		t.Fatalf("expected the preset to start fetching, got state %v", m.state)
+	}
+	if m.choosingPreset || m.editingDays {
+		t.Error("expected the picker closed without starting days input")
+	}
+	opts := m.fetchOptions(time.Time{}, time.Time{})
+	if opts.Location != "feed" || opts.DaysAgo != 3 || opts.Category != "video" || opts.Tag != "" {
+		t.Errorf("unexpected fetch options %+v", opts)
+	}
+
+	// Changing the location on the config screen drops the preset; the
+	// category stays selected like one chosen with c
+	m.state = StateConfig
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	if opts := m.fetchOptions(time.Time{}, time.Time{}); opts.Tag != "" || m.presetName != "" || opts.Category != "video" {
+		t.Errorf("expected preset cleared, got %+v", opts)
+	}
+
+	// A tag-only preset keeps the current location, category, and lookback
+	m.fetchLocation = "new"
+	m.category = ""
+	m.inboxLookback = 14
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	opts = m.fetchOptions(time.Time{}, time.Time{})
+	if opts.Location != "new" || opts.DaysAgo != 14 || opts.Tag != "to-read" || opts.Category != "" {
+		t.Errorf("unexpected fetch options %+v", opts)
+	}
+
+	// Any other key closes the picker
+	m.state = StateConfig
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.choosingPreset || m.state != StateConfig {
+		t.Errorf("expected esc to close the picker, got choosing=%v state=%v", m.choosingPreset, m.state)
+	}
+}
+
+func TestLookbackPerCategory(t *testing.T) {
+	m := newTestModel()
+	m.state = StateConfig
+	m.fetchLocation = "new"
+	m.inboxLookback = 3
+	m.feedLookback = 7
+	press := func(keys string) {
+		for _, r := range keys {
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	// inbox/article: 3 days, typed in
+	press("c") // article
+	if m.category != "article" || m.activeLookback() != 3 {
+		t.Fatalf("expected article starting from the inbox lookback, got %q %d", m.category, m.activeLookback())
+	}
+
+	// feed/video: 30 days
+	press("l")
+	for m.category != "video" {
+		press("c")
+	}
+	press("30")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.activeLookback() != 30 {
+		t.Fatalf("expected feed/video lookback 30, got %d", m.activeLookback())
+	}
+	if m.feedLookback != 7 {
+		t.Errorf("expected the plain feed lookback untouched, got %d", m.feedLookback)
+	}
+
+	// Back to inbox, then to article: article's own window is restored
+	press("h")
+	press("c") // video -> all
+	press("c") // all -> article
+	if m.fetchLocation != "new" || m.category != "article" {
+		t.Fatalf("unexpected location/category %q/%q", m.fetchLocation, m.category)
+	}
+	press("k")
+	if m.activeLookback() != 10 {
+		t.Errorf("expected inbox/article lookback 10, got %d", m.activeLookback())
+	}
+	if m.inboxLookback != 3 {
+		t.Errorf("expected the plain inbox lookback untouched, got %d", m.inboxLookback)
+	}
+
+	// Switching location keeps the category and restores feed/video
+	press("l")
+	for m.category != "video" {
+		press("c")
+	}
+	if m.activeLookback() != 30 {
+		t.Errorf("expected feed/video lookback 30 restored, got %d", m.activeLookback())
+	}
+	if got := m.fetchOptions(time.Time{}, time.Time{}); got.DaysAgo != 30 || got.Category != "video" || got.Location != "feed" {
+		t.Errorf("unexpected fetch options %+v", got)
+	}
+
+	// The remembered windows and category survive a restart
+	reloaded := NewModel()
+	reloaded.fetchLocation = "new"
+	if reloaded.category != "video" {
+		t.Errorf("expected category restored, got %q", reloaded.category)
+	}
+	reloaded.category = "article"
+	if reloaded.activeLookback() != 10 {
+		t.Errorf("expected inbox/article lookback 10 after reload, got %d", reloaded.activeLookback())
+	}
+	reloaded.fetchLocation = "feed"
+	reloaded.category = "video"
+	if reloaded.activeLookback() != 30 {
+		t.Errorf("expected feed/video lookback 30 after reload, got %d", reloaded.activeLookback())
+	}
+}
+
+func TestFetchPresetsNoneConfigured(t *testing.T) {
+	m := newTestModel()
+	m.state = StateConfig
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if m.choosingPreset {
+		t.Error("expected no picker without presets")
+	}
+	if !strings.Contains(m.statusMessage, "presets") {
+		t.Errorf("expected a hint about configuring presets, got %q", m.statusMessage)
+	}
+}
+
+func TestWeekWindowPaging(t *testing.T) {
+	var mu sync.Mutex
+	var queries []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.Query())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":0,"nextPageCursor":null,"results":[]}`)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.state = StateReviewing
+
+	press := func(r rune) {
+		t.Helper()
+		_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		if cmd == nil {
+			t.Fatalf("expected %q to fetch", r)
+		}
+		m.Update(cmd())
+	}
+	bounds := func() (time.Time, time.Time) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		q := queries[len(queries)-1]
+		after, err := time.Parse(time.RFC3339, q.Get("updatedAfter"))
+		if err != nil {
+			t.Fatalf("bad updatedAfter %q: %v", q.Get("updatedAfter"), err)
+		}
+		before, err := time.Parse(time.RFC3339, q.Get("updatedBefore"))
+		if err != nil {
+			t.Fatalf("bad updatedBefore %q: %v", q.Get("updatedBefore"), err)
+		}
+		return after, before
+	}
+
+	press('[')
+	press('[')
+	after, before := bounds()
+	if got := before.Sub(after); got != 7*24*time.Hour {
+		t.Errorf("window spans %v, want 7 days", got)
+	}
+	if ago := time.Since(before); ago < 6*24*time.Hour || ago > 8*24*time.Hour {
+		t.Errorf("second week should end about 7 days ago, ends %v ago", ago)
+	}
+	if !strings.Contains(m.statusMessage, "[ earlier") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+	if m.cfg.LastFetchAt != nil {
+		t.Error("week windows should not move the since-last-fetch baseline")
+	}
+
+	press(']')
+	after, before = bounds()
+	if ago := time.Since(before); ago > time.Hour {
+		t.Errorf("latest week should end now, ends %v ago", ago)
+	}
+	if got := before.Sub(after); got != 7*24*time.Hour {
+		t.Errorf("window spans %v, want 7 days", got)
+	}
+
+	// No later week than the latest
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}}); cmd != nil {
+		t.Error("expected ] at the latest week to do nothing")
+	}
+
+	// f goes back to the growing lookback without an upper bound
+	press('f')
+	mu.Lock()
+	if q := queries[len(queries)-1]; q.Has("updatedBefore") {
+		t.Errorf("expected no updatedBefore after f, got %q", q.Get("updatedBefore"))
+	}
+	mu.Unlock()
+}
+
+func TestTriagedAtRestoredAndUpdated(t *testing.T) {
+	m := newTestModel()
+	m.triageStore.SetItem("1", "later", "low", "manual", nil, nil)
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Saved"}, {ID: "2", Title: "New"}}})
+
+	if m.items[0].TriagedAt == "" {
+		t.Error("expected TriagedAt restored from the store")
+	}
+	if m.items[1].TriagedAt != "" {
+		t.Errorf("expected no TriagedAt for an untriaged item, got %q", m.items[1].TriagedAt)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if humanizeTime(m.items[1].TriagedAt) != "just now" {
+		t.Errorf("expected a new decision to be stamped now, got %q", m.items[1].TriagedAt)
+	}
+}
+
+func TestEmptyReviewSuggestions(t *testing.T) {
+	m := newTestModel()
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m.Update(ItemsLoadedMsg{Items: nil})
+
+	view := m.View()
+	for _, want := range []string{"No Inbox items from the last 7 days", "fetch 7 more days", "switch to Feed", "refresh"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected empty view to contain %q", want)
+		}
+	}
+
+	m.fetchLocation = "feed"
+	if view := m.View(); !strings.Contains(view, "No Feed items") || !strings.Contains(view, "switch to Inbox") {
+		t.Error("expected the feed empty state to suggest switching to Inbox")
+	}
+
+	// Items hidden by a filter get filter-specific hints instead
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "Go tips"}}})
+	m.setFilter("rust")
+	if view := m.View(); !strings.Contains(view, "1 hidden") || !strings.Contains(view, "clear it") {
+		t.Error("expected the filtered empty state to explain how to clear the filter")
+	}
+}
+
+func TestFetchLimit(t *testing.T) {
+	var mu sync.Mutex
+	pages := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		pages++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pageCursor") == "" {
+			fmt.Fprint(w, `{"count":3,"nextPageCursor":"p2","results":[{"id":"1","title":"One"},{"id":"2","title":"Two"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"count":3,"nextPageCursor":null,"results":[{"id":"3","title":"Three"}]}`)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	if !strings.Contains(m.View(), "Items: all") {
+		t.Error("expected no limit by default")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if m.cfg.FetchLimit != 25 || !strings.Contains(m.View(), "Items: first 25") {
+		t.Fatalf("expected L to set a 25 item limit, got %d", m.cfg.FetchLimit)
+	}
+
+	// A custom limit moves to the next larger step, and the last step wraps to all
+	m.cfg.FetchLimit = 150
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if m.cfg.FetchLimit != 200 {
+		t.Errorf("expected 150 to step to 200, got %d", m.cfg.FetchLimit)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	if m.cfg.FetchLimit != 0 {
+		t.Errorf("expected 200 to wrap to all, got %d", m.cfg.FetchLimit)
+	}
+
+	m.cfg.FetchLimit = 2
+	m.Update(m.startFetching()())
+	if len(m.items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(m.items))
+	}
+	mu.Lock()
+	if pages != 1 {
+		t.Errorf("expected the limit to stop after 1 page, fetched %d", pages)
+	}
+	mu.Unlock()
+	if !strings.Contains(m.statusMessage, "limit 2 reached") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+}
+
+func TestReviewUncertainFirst(t *testing.T) {
+	results := []triage.Result{
+		{ID: "1", Title: "A", TriageDecision: triage.TriageDecision{Action: "archive", Priority: "low"}},
+		{ID: "2", Title: "B", TriageDecision: triage.TriageDecision{Action: "needs_review", Priority: "medium"}},
+		{ID: "3", Title: "C", TriageDecision: triage.TriageDecision{Action: "read_now", Priority: "high"}},
+		{ID: "5", Title: "E", TriageDecision: triage.TriageDecision{Action: "needs_review", Priority: "low"}},
+	}
+	load := func(uncertainFirst bool) *Model {
+		m := newTestModel()
+		m.cfg = &config.Config{ReviewUncertainFirst: uncertainFirst}
+		m.Update(ItemsLoadedMsg{Items: []Item{
+			{ID: "1", Title: "A"}, {ID: "2", Title: "B"}, {ID: "3", Title: "C"}, {ID: "4", Title: "D"}, {ID: "5", Title: "E"},
+		}})
+		return m
+	}
+	ids := func(m *Model) []string {
+		var out []string
+		for _, item := range m.items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+
+	m := load(true)
+	// Focus and select item 3 so we can check they follow it
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m.applyTriageResults(results)
+
+	// needs_review first (in original order), then by priority, untriaged last
+	if got, want := ids(m), []string{"2", "5", "3", "1", "4"}; !equalStrings(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+	if item := m.listView.CurrentItem(); item == nil || item.ID != "3" {
+		t.Errorf("expected focus to stay on item 3, got %+v", item)
+	}
+	if sel := m.listView.GetSelected(); len(sel) != 1 || m.items[sel[0]].ID != "3" {
+		t.Errorf("expected selection to follow item 3, got %v", sel)
+	}
+
+	// Saved decisions are ordered the same way on the next load
+	m2 := newTestModel()
+	m2.triageStore = m.triageStore
+	m2.cfg = &config.Config{ReviewUncertainFirst: true}
+	m2.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "A"}, {ID: "2", Title: "B"}, {ID: "4", Title: "D"}}})
+	if got, want := ids(m2), []string{"2", "1", "4"}; !equalStrings(got, want) {
+		t.Errorf("restored order = %v, want %v", got, want)
+	}
+
+	// Off by default
+	m = load(false)
+	m.applyTriageResults(results)
+	if got, want := ids(m), []string{"1", "2", "3", "4", "5"}; !equalStrings(got, want) {
+		t.Errorf("order without the flag = %v, want %v", got, want)
+	}
+}
+
+func TestFallbackConfigNotSaved(t *testing.T) {
+	configPath := os.Getenv("READWISE_TRIAGE_CONFIG")
+	original := "llm:\n  provider: anthropic\n  model: claude-3-5-haiku-latest\ntheme: nord\nfeed_days_ago: 3\nrefresh_mode: Ask\n"
+	if err := os.WriteFile(configPath, []byte(original), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(configPath)
+	config.ClearSession()
+
+	m := NewModel()
+	if !strings.Contains(m.statusMessage, "refresh_mode") {
+		t.Errorf("expected the config error surfaced, got %q", m.statusMessage)
+	}
+	m.Update(tea.WindowSizeMsg{Width: 150, Height: 45})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	m.EndSession()
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != original {
+		t.Errorf("expected the unreadable config left alone, got:\n%s", data)
+	}
+}
+
+func TestSavedWindowSize(t *testing.T) {
+	m := newTestModel()
+	if m.width != 0 || m.listView.width != 80 {
+		t.Fatalf("expected the 80x24 fallback without a saved size, got %d / %d", m.width, m.listView.width)
+	}
+
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m.Update(tea.WindowSizeMsg{Width: 150, Height: 45})
+	if _, err := os.Stat(os.Getenv("READWISE_TRIAGE_CONFIG")); !os.IsNotExist(err) {
+		t.Errorf("expected resizes not to write the config, got %v", err)
+	}
+	m.EndSession()
+	if m.cfg.WindowWidth != 150 || m.cfg.WindowHeight != 45 {
+		t.Errorf("expected the size to be saved on exit, got %dx%d", m.cfg.WindowWidth, m.cfg.WindowHeight)
+	}
+
+	// A new session lays out for the saved size before any resize message
+	m2 := NewModel()
+	if m2.width != 150 || m2.height != 45 {
+		t.Errorf("expected m.width/height 150x45, got %dx%d", m2.width, m2.height)
+	}
+	if m2.listView.width != 150 || m2.listView.height != 45 {
+		t.Errorf("expected list view 150x45, got %dx%d", m2.listView.width, m2.listView.height)
+	}
+	if got, want := m2.listView.columns, listColumns(150, false); len(got) != len(want) || got[1].Width != want[1].Width {
+		t.Errorf("expected columns laid out for 150 wide, got %+v", got)
+	}
+}
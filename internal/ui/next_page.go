@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// PageLoadedMsg carries the page fetched after one that fetch_limit stopped
+// at, to be appended to the loaded items.
+type PageLoadedMsg struct {
+	Items []Item
+	Next  *readwise.Cursor // nil once every matching item was fetched
+}
+
+// fetchNextPage fetches the page after the last fetch without refetching
+// what is already loaded.
+func (m *Model) fetchNextPage() tea.Cmd {
+	cursor := m.nextPage
+	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+		m.statusMessage = "READWISE_TOKEN not configured"
+		return nil
+	}
+	m.state = StateFetching
+	m.statusMessage = "Loading the next page from Readwise..."
+
+	token := m.cfg.ReadwiseToken
+	return func() tea.Msg {
+		client, err := newReadwiseClient(token)
+		if err != nil {
+			return ErrorMsg{Error: err}
+		}
+		docs, next, err := client.GetNextPage(cursor)
+		if err != nil {
+			return ErrorMsg{Error: err}
+		}
+		items := m.fetchedItems(docs)
+		if m.cfg.Deduplicate {
+			items = dedupeItems(items)
+		}
+		return PageLoadedMsg{Items: items, Next: next}
+	}
+}
+
+// handlePageLoaded appends the items of a fetched page that aren't loaded
+// yet. With deduplicate on, a page item with the URL of a loaded item is
+// folded into that item's duplicates instead.
+func (m *Model) handlePageLoaded(msg PageLoadedMsg) {
+	m.nextPage = msg.Next
+	m.state = StateReviewing
+
+	loaded := make(map[string]bool, len(m.items))
+	byURL := make(map[string]int)
+	for i, item := range m.items {
+		loaded[item.ID] = true
+		for _, id := range item.DuplicateIDs {
+			loaded[id] = true
+		}
+		if key := normalizeURL(item.URL); key != "" && m.cfg != nil && m.cfg.Deduplicate {
+			byURL[key] = i
+		}
+	}
+
+	start := len(m.items)
+	for _, item := range msg.Items {
+		if loaded[item.ID] {
+			continue
+		}
+		if idx, ok := byURL[normalizeURL(item.URL)]; ok {
+			survivor := &m.items[idx]
+			survivor.OriginalTags = mergeTags(survivor.OriginalTags, item.OriginalTags)
+			survivor.DuplicateIDs = append(append(survivor.DuplicateIDs, item.ID), item.DuplicateIDs...)
+			continue
+		}
+		m.items = append(m.items, item)
+	}
+	added := len(m.items) - start
+
+	if m.triageStore != nil {
+		m.applySavedTriagesTo(m.items[start:])
+	}
+	autoArchived := m.archiveRead()
+	shortArchived := m.archiveShort()
+	m.listView.SetItems(m.items)
+	m.sortUncertainFirst()
+
+	m.statusMessage = fmt.Sprintf("Loaded %s more items (%s in total)", formatCount(added), formatCount(len(m.items)))
+	if m.nextPage == nil {
+		m.statusMessage += ", no more pages"
+	}
+	if autoArchived > 0 {
+		m.statusMessage += fmt.Sprintf("; %d already read, marked archive", autoArchived)
+	}
+	if shortArchived > 0 {
+		m.statusMessage += fmt.Sprintf("; %d under %d words, marked archive", shortArchived, m.cfg.AutoArchiveUnderWords)
+	}
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func TestFetchNextPage(t *testing.T) {
+	var mu sync.Mutex
+	var cursors []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		cursors = append(cursors, r.URL.Query().Get("pageCursor"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("pageCursor") {
+		case "":
+			fmt.Fprint(w, `{"count":5,"nextPageCursor":"p2","results":[{"id":"1","title":"One","source_url":"https://a.com/x"},{"id":"2","title":"Two"}]}`)
+		case "p2":
+			// "1" again, and "3" has the URL of "1"
+			fmt.Fprint(w, `{"count":5,"nextPageCursor":"p3","results":[{"id":"1","title":"One"},{"id":"3","title":"Three","source_url":"https://www.a.com/x/"},{"id":"4","title":"Four"}]}`)
+		default:
+			fmt.Fprint(w, `{"count":5,"nextPageCursor":null,"results":[{"id":"5","title":"Five"}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token", FetchLimit: 2, Deduplicate: true}
+	m.triageStore.SetItem("4", "later", "low", "manual", nil, nil)
+	lookback := m.activeLookback()
+
+	m.Update(m.startFetching()())
+	if len(m.items) != 2 || !strings.Contains(m.statusMessage, "f for the next page") {
+		t.Fatalf("expected 2 items and a next page hint, got %d / %q", len(m.items), m.statusMessage)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if cmd == nil {
+		t.Fatal("expected f to fetch the next page")
+	}
+	m.Update(cmd())
+
+	var ids []string
+	for _, item := range m.items {
+		ids = append(ids, item.ID)
+	}
+	if !slices.Equal(ids, []string{"1", "2", "4"}) {
+		t.Fatalf("expected only new items appended, got %v", ids)
+	}
+	if !slices.Equal(m.items[0].DuplicateIDs, []string{"3"}) {
+		t.Errorf("expected 3 folded into 1 as a duplicate, got %v", m.items[0].DuplicateIDs)
+	}
+	if m.items[2].Action != "later" {
+		t.Errorf("expected the saved decision restored on the new item, got %q", m.items[2].Action)
+	}
+	if item := m.listView.CurrentItem(); item == nil || item.ID != "2" {
+		t.Errorf("expected the cursor to stay on 2, got %v", item)
+	}
+	if m.activeLookback() != lookback {
+		t.Errorf("expected the lookback unchanged, got %d", m.activeLookback())
+	}
+	if !strings.Contains(m.statusMessage, "Loaded 1 more items") {
+		t.Errorf("unexpected status %q", m.statusMessage)
+	}
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	m.Update(cmd())
+	if len(m.items) != 4 || m.nextPage != nil || !strings.Contains(m.statusMessage, "no more pages") {
+		t.Errorf("expected the last page appended, got %d items / %q", len(m.items), m.statusMessage)
+	}
+	mu.Lock()
+	if !slices.Equal(cursors, []string{"", "p2", "p3"}) {
+		t.Errorf("expected each page fetched once, got %v", cursors)
+	}
+	mu.Unlock()
+
+	// With nothing left, f widens the lookback as before
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if m.activeLookback() != lookback+7 {
+		t.Errorf("expected f to add 7 days without a next page, got %d", m.activeLookback())
+	}
+}
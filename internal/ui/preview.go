@@ -0,0 +1,229 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ContentLoadedMsg carries the full text of an item fetched for the preview.
+type ContentLoadedMsg struct {
+	ID   string
+	Text string
+	Err  error
+}
+
+// startPreview opens the content preview for the focused item, fetching its
+// full text from Readwise unless it was already fetched this session.
+func (m *Model) startPreview() tea.Cmd {
+	item := m.listView.CurrentItem()
+	if item == nil {
+		return nil
+	}
+	m.previewID = item.ID
+	m.previewOffset = 0
+	m.state = StatePreview
+	if _, ok := m.contentCache[item.ID]; ok {
+		return nil
+	}
+
+	if m.demo {
+		// Demo items have no stored content; preview the summary instead
+		return func() tea.Msg {
+			return ContentLoadedMsg{ID: item.ID, Text: item.Summary}
+		}
+	}
+	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+		return func() tea.Msg {
+			return ContentLoadedMsg{ID: item.ID, Err: fmt.Errorf("READWISE_TOKEN not configured")}
+		}
+	}
+	token, id := m.cfg.ReadwiseToken, item.ID
+	return func() tea.Msg {
+		client, err := newReadwiseClient(token)
+		if err != nil {
+			return ContentLoadedMsg{ID: id, Err: err}
+		}
+		content, err := client.GetDocumentHTML(id)
+		if err != nil {
+			return ContentLoadedMsg{ID: id, Err: err}
+		}
+		return ContentLoadedMsg{ID: id, Text: htmlToText(content)}
+	}
+}
+
+// handleContentLoaded caches fetched content and reports failures for the
+// item still being previewed.
+func (m *Model) handleContentLoaded(msg ContentLoadedMsg) {
+	if msg.Err != nil {
+		if m.state == StatePreview && msg.ID == m.previewID {
+			m.statusMessage = fmt.Sprintf("Preview failed: %v", msg.Err)
+			m.messageType = "error"
+			m.state = StateMessage
+		}
+		return
+	}
+	if m.contentCache == nil {
+		m.contentCache = make(map[string]string)
+	}
+	m.contentCache[msg.ID] = msg.Text
+}
+
+// handlePreviewKeys scrolls the content preview; esc or v closes it.
+func (m *Model) handlePreviewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	page := m.previewHeight()
+	switch {
+	case keyMatches(msg, m.keys.Back), keyMatches(msg, m.keys.Preview):
+		m.state = StateReviewing
+		return m, nil
+	case keyMatches(msg, m.keys.Down):
+		m.previewOffset++
+	case keyMatches(msg, m.keys.Up):
+		m.previewOffset--
+	case msg.String() == "pgdown", msg.String() == " ":
+		m.previewOffset += page
+	case msg.String() == "pgup":
+		m.previewOffset -= page
+	case msg.String() == "g":
+		m.previewOffset = 0
+	case msg.String() == "G":
+		m.previewOffset = len(m.previewLines())
+	}
+	m.previewOffset = max(0, min(m.previewOffset, len(m.previewLines())-page))
+	return m, nil
+}
+
+// previewHeight is the number of content lines shown at once.
+func (m *Model) previewHeight() int {
+	if m.height <= 0 {
+		return 20
+	}
+	return max(m.height-6, 3)
+}
+
+// previewLines wraps the cached content of the previewed item to the
+// terminal width.
+func (m *Model) previewLines() []string {
+	text, ok := m.contentCache[m.previewID]
+	if !ok {
+		return nil
+	}
+	if strings.TrimSpace(text) == "" {
+		return []string{"(no stored content for this item)"}
+	}
+	width := 100
+	if m.width > 0 {
+		width = max(m.width-4, 20)
+	}
+	return strings.Split(lipgloss.NewStyle().Width(width).Render(text), "\n")
+}
+
+func (m *Model) previewView() string {
+	title := m.previewID
+	for _, item := range m.items {
+		if item.ID == m.previewID {
+			title = item.Title
+			break
+		}
+	}
+	width := 100
+	if m.width > 0 {
+		width = max(m.width-2, 20)
+	}
+
+	lines := []string{" " + m.styles.Title.Render(Truncate(title, width-4)), ""}
+	all := m.previewLines()
+	position := ""
+	if all == nil {
+		lines = append(lines, "  "+m.styles.Help.Render(m.spinner.View()+" Loading content from Readwise..."))
+	} else {
+		end := min(m.previewOffset+m.previewHeight(), len(all))
+		for _, line := range all[m.previewOffset:end] {
+			lines = append(lines, "  "+m.styles.Normal.Render(line))
+		}
+		position = fmt.Sprintf("lines %d-%d of %d · ", m.previewOffset+1, end, len(all))
+	}
+
+	help := m.styles.Help.Render(" "+position) + m.renderHelpLine([]helpEntry{
+		{"j/k", "scroll"},
+		{"space/pgup", "page"},
+		{"g/G", "top/bottom"},
+		{"esc", "close"},
+	})
+	return lipgloss.JoinVertical(lipgloss.Left, append(lines, "", help)...)
+}
+
+// htmlBlockTags start a new paragraph when converting HTML to plain text.
+var htmlBlockTags = map[string]bool{
+	"p": true, "div": true, "br": true, "tr": true, "hr": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "pre": true, "section": true, "article": true,
+	"header": true, "footer": true, "ul": true, "ol": true, "table": true, "figure": true,
+}
+
+// htmlToText renders document HTML as plain text for the preview: tags are
+// dropped, block elements become paragraph breaks, list items get bullets,
+// and script and style contents are skipped.
+func htmlToText(s string) string {
+	var b strings.Builder
+	skip := ""
+	for s != "" {
+		start := strings.IndexByte(s, '<')
+		if start < 0 {
+			if skip == "" {
+				b.WriteString(strings.ReplaceAll(s, "\n", " "))
+			}
+			break
+		}
+		if skip == "" {
+			// Source line breaks are just whitespace; only tags break lines
+			b.WriteString(strings.ReplaceAll(s[:start], "\n", " "))
+		}
+		end := strings.IndexByte(s[start:], '>')
+		if end < 0 {
+			break
+		}
+		tag := s[start+1 : start+end]
+		s = s[start+end+1:]
+
+		closing := strings.HasPrefix(tag, "/")
+		name := strings.ToLower(strings.TrimPrefix(tag, "/"))
+		if i := strings.IndexAny(name, " \t\n/"); i >= 0 {
+			name = name[:i]
+		}
+		switch {
+		case skip != "":
+			if closing && name == skip {
+				skip = ""
+			}
+		case !closing && (name == "script" || name == "style"):
+			skip = name
+		case name == "li":
+			if !closing {
+				b.WriteString("\n• ")
+			}
+		case htmlBlockTags[name]:
+			b.WriteString("\n\n")
+		}
+	}
+
+	// Collapse whitespace within lines and runs of blank lines
+	var lines []string
+	blank := true
+	for _, line := range strings.Split(html.UnescapeString(b.String()), "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			if !blank {
+				lines = append(lines, "")
+			}
+			blank = true
+			continue
+		}
+		lines = append(lines, line)
+		blank = false
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func TestHTMLToText(t *testing.T) {
+	tests := []struct {
+		name string
+		html string
+		want string
+	}{
+		{"inline", "<p>Hello <b>world</b> &amp; friends</p>", "Hello world & friends"},
+		{"paragraphs", "<h1>Title</h1><p>One\n   two</p><p>Three</p>", "Title\n\nOne two\n\nThree"},
+		{"list", "<ul><li>a</li><li>b</li></ul>", "• a\n• b"},
+		{"script", "<p>x</p><script>var y = '<p>';</script><style>p{}</style><p>z</p>", "x\n\nz"},
+		{"plain", "just text", "just text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := htmlToText(tt.html); got != tt.want {
+				t.Errorf("htmlToText(%q) = %q, want %q", tt.html, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviewContent(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Query().Get("withHtmlContent") != "true" {
+			t.Errorf("expected withHtmlContent=true, got %v", r.URL.Query())
+		}
+		var paras strings.Builder
+		for i := 1; i <= 50; i++ {
+			fmt.Fprintf(&paras, "<p>Paragraph %d</p>", i)
+		}
+		fmt.Fprintf(w, `{"results":[{"id":%q,"html_content":%q}]}`, r.URL.Query().Get("id"), paras.String())
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.height = 20
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "doc1", Title: "Long read"}}})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if m.state != StatePreview || cmd == nil {
+		t.Fatalf("expected v to open the preview and fetch, got state %v", m.state)
+	}
+	if !strings.Contains(m.View(), "Loading content") {
+		t.Error("expected a loading indicator before the content arrives")
+	}
+	m.Update(cmd())
+
+	view := m.View()
+	if !strings.Contains(view, "Long read") || !strings.Contains(view, "Paragraph 1") || strings.Contains(view, "Paragraph 50") {
+		t.Errorf("expected the first page of content, got:\n%s", view)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("G")})
+	if view := m.View(); !strings.Contains(view, "Paragraph 50") || strings.Contains(view, "Paragraph 1\n") {
+		t.Errorf("expected G to scroll to the end, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.state != StateReviewing {
+		t.Fatalf("expected esc to close the preview, got %v", m.state)
+	}
+
+	// Reopening uses the cached content
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")}); cmd != nil {
+		t.Error("expected no refetch for cached content")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+	if m.previewOffset != 0 {
+		t.Errorf("expected the preview to reopen at the top, got offset %d", m.previewOffset)
+	}
+}
+
+func TestPreviewContentError(t *testing.T) {
+	m := newTestModel()
+	m.cfg = &config.Config{}
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "doc1", Title: "Item"}}})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	m.Update(cmd())
+	if m.state != StateMessage || !strings.Contains(m.statusMessage, "Preview failed") {
+		t.Errorf("expected a preview failure message, got state %v and %q", m.state, m.statusMessage)
+	}
+	if _, ok := m.contentCache["doc1"]; ok {
+		t.Error("expected failures not to be cached")
+	}
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// requestQuit shows the session summary before quitting, asking first when
+// decisions made this session haven't been pushed to Readwise. ctrl+c
+// always quits at once; q again at the prompt goes on to the summary.
+func (m *Model) requestQuit(msg tea.KeyMsg) tea.Cmd {
+	if msg.String() == "ctrl+c" {
+		return tea.Quit
+	}
+	n := m.unpushedCount()
+	if n == 0 || m.pendingQuit {
+		if !m.pendingQuit {
+			m.quitFrom = m.state
+		}
+		return m.showSummary()
+	}
+	noun := "decisions"
+	if n == 1 {
+		noun = "decision"
+	}
+	m.pendingQuit = true
+	m.quitFrom = m.state
+	m.statusMessage = fmt.Sprintf("You have %s unpushed %s — quit anyway? (y/n)", formatCount(n), noun)
+	m.state = StateConfirming
+	return nil
+}
+
+// handleQuitKeys answers the unpushed-decisions prompt: y goes on to the
+// summary, n or esc goes back to where q was pressed.
+func (m *Model) handleQuitKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "ctrl+c":
+		return tea.Quit
+	case "y", "Y":
+		return m.showSummary()
+	case "n", "N", "esc":
+		m.pendingQuit = false
+		m.statusMessage = ""
+		m.state = m.quitFrom
+	}
+	return nil
+}
+
+// unpushedCount returns how many loaded items were decided this session but
+// not pushed yet. Snoozes and cleared decisions have nothing to push.
+func (m *Model) unpushedCount() int {
+	n := 0
+	for _, item := range m.items {
+		if !m.unpushed[item.ID] {
+			continue
+		}
+		if _, ok := m.updateRequestFor(item); ok {
+			n++
+		}
+	}
+	return n
+}
+
+// markPushed clears the unpushed flag and local edits for every document in
+// the finished update except the failures, which still need pushing.
+func (m *Model) markPushed(failures []UpdateFailure) {
+	failed := make(map[string]bool, len(failures))
+	for _, f := range failures {
+		failed[f.ID] = true
+	}
+	for _, id := range m.pushing {
+		if !failed[id] {
+			m.clearUnpushed(id)
+		}
+	}
+	m.pushing = nil
+}
+
+// clearUnpushed marks a document as pushed, dropping its local edits.
+func (m *Model) clearUnpushed(id string) {
+	delete(m.unpushed, id)
+	if m.pushed == nil {
+		m.pushed = make(map[string]bool)
+	}
+	m.pushed[id] = true
+	for i := range m.items {
+		if m.items[i].ID == id {
+			m.items[i].NotesEdited = false
+			m.items[i].StartFresh = false
+		}
+	}
+}
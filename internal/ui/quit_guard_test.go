@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func isQuit(cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	_, ok := cmd().(tea.QuitMsg)
+	return ok
+}
+
+func newQuitTestModel() *Model {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One"}, {ID: "2", Title: "Two"}, {ID: "3", Title: "Three"}}})
+	return m
+}
+
+func TestQuitWithoutDecisions(t *testing.T) {
+	m := newQuitTestModel()
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); !isQuit(cmd) {
+		t.Errorf("expected q to quit with nothing unpushed, got state %v", m.state)
+	}
+}
+
+func TestQuitGuard(t *testing.T) {
+	m := newQuitTestModel()
+	typeKeys(m, "ajljz")
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if isQuit(cmd) || m.state != StateConfirming || !m.pendingQuit {
+		t.Fatalf("expected q to ask first, got state %v", m.state)
+	}
+	// The snooze has nothing to push
+	if want := "You have 2 unpushed decisions — quit anyway? (y/n)"; m.statusMessage != want {
+		t.Errorf("statusMessage = %q, want %q", m.statusMessage, want)
+	}
+	if view := m.View(); !strings.Contains(view, "2 unpushed decisions") {
+		t.Errorf("expected the prompt in the view, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if m.pendingQuit || m.state != StateReviewing {
+		t.Fatalf("expected n to return to review, got state %v", m.state)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")}); isQuit(cmd) || m.state != StateSummary {
+		t.Fatalf("expected y to show the summary, got state %v", m.state)
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); !isQuit(cmd) {
+		t.Error("expected a key on the summary to quit")
+	}
+}
+
+func TestQuitGuardCtrlC(t *testing.T) {
+	m := newQuitTestModel()
+	typeKeys(m, "a")
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC}); !isQuit(cmd) {
+		t.Errorf("expected ctrl+c to quit immediately, got state %v", m.state)
+	}
+}
+
+func TestQuitGuardAfterPush(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newQuitTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	typeKeys(m, "ajl")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("U")})
+	m.Update(UpdateFinishedMsg{Success: 1, Failed: 1, Failures: []UpdateFailure{{ID: "2", Error: "boom"}}})
+	if got := m.unpushedCount(); got != 1 {
+		t.Errorf("unpushedCount() after a partial push = %d, want 1", got)
+	}
+
+	m.state = StateReviewing
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("U")})
+	m.Update(UpdateFinishedMsg{Success: 2})
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); isQuit(cmd) || m.state != StateSummary {
+		t.Fatalf("expected q to show the summary once everything is pushed, got state %v", m.state)
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); !isQuit(cmd) {
+		t.Error("expected a key on the summary to quit")
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func TestManualReason(t *testing.T) {
+	store := config.NewMemTriageStore()
+	items := []Item{{ID: "1", Title: "One"}, {ID: "2", Title: "Two"}}
+
+	m := newTestModel()
+	m.triageStore = store
+	m.Update(ItemsLoadedMsg{Items: items})
+	typeKeys(m, "a")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if !m.editingReason {
+		t.Fatal("expected y to open the reason editor")
+	}
+	typeKeys(m, "seen  it")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.editingText() {
+		t.Fatal("expected enter to close the reason editor")
+	}
+	if got := m.items[0].Reason; got != "seen it" {
+		t.Errorf("Reason = %q, want %q", got, "seen it")
+	}
+	if entry, _ := store.GetItem("1"); entry.Reason != "seen it" || entry.Action != "archive" {
+		t.Errorf("expected the reason stored with the decision, got %+v", entry)
+	}
+	if detail := m.listView.DetailView(120, m.styles); !strings.Contains(detail, "why:seen it") {
+		t.Errorf("expected the reason in the detail pane, got:\n%s", detail)
+	}
+
+	// A later decision keeps the reason, and a reload restores it
+	typeKeys(m, "l")
+	reloaded := newTestModel()
+	reloaded.triageStore = store
+	reloaded.Update(ItemsLoadedMsg{Items: items})
+	if got := reloaded.items[0]; got.Reason != "seen it" || got.Action != "later" {
+		t.Errorf("reloaded item = %q / %q, want later with reason %q", got.Action, got.Reason, "seen it")
+	}
+
+	// The editor starts from the saved reason; clearing it removes it
+	reloaded.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if reloaded.tagsInput != "seen it" {
+		t.Errorf("expected the editor to start from the saved reason, got %q", reloaded.tagsInput)
+	}
+	reloaded.tagsInput, reloaded.tagsCursor = "", 0
+	reloaded.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if entry, _ := store.GetItem("1"); entry.Reason != "" {
+		t.Errorf("expected the reason cleared, got %q", entry.Reason)
+	}
+}
+
+func TestReasonUntriagedStillExported(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One"}, {ID: "2", Title: "Two"}}})
+
+	// A reason without a decision doesn't mark the item triaged
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	typeKeys(m, "check later")
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.triageStore.HasTriaged("1") {
+		t.Error("expected a reason alone not to count as a decision")
+	}
+	export, err := m.ExportItemsToJSON()
+	if err != nil {
+		t.Fatalf("ExportItemsToJSON() unexpected error: %v", err)
+	}
+	var items []map[string]interface{}
+	if err := json.Unmarshal([]byte(extractJSONArray(export)), &items); err != nil {
+		t.Fatalf("failed to parse exported JSON: %v", err)
+	}
+	if len(items) != 2 || items[0]["id"] != "1" {
+		t.Errorf("expected both items exported, got %v", items)
+	}
+}
+
+func TestReasonKeepsFetchDefaults(t *testing.T) {
+	m := newTestModel()
+	m.cfg = &config.Config{DefaultAction: "archive", DefaultPriority: "medium"}
+	m.triageStore.SetReason("1", "check later")
+
+	items := m.fetchedItems([]readwise.Item{{ID: "1", Title: "One"}})
+	m.applySavedTriagesTo(items)
+	got := items[0]
+	if got.Reason != "check later" {
+		t.Errorf("expected the reason restored, got %q", got.Reason)
+	}
+	// A reason alone isn't a decision, so the pre-fill stays and nothing looks triaged
+	if got.Action != "archive" || got.Priority != "medium" || !got.Defaulted || got.TriagedAt != "" {
+		t.Errorf("expected the default pre-fill kept, got %q/%q defaulted %v triaged %q", got.Action, got.Priority, got.Defaulted, got.TriagedAt)
+	}
+}
+
+func TestManualReasonInExportAndExplain(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One"}}})
+	typeKeys(m, "d")
+	m.applyReason("paywalled")
+
+	export, err := m.ExportAllWithDecisions()
+	if err != nil {
+		t.Fatalf("ExportAllWithDecisions() unexpected error: %v", err)
+	}
+	if !strings.Contains(export, `"reason": "paywalled"`) {
+		t.Errorf("expected the manual reason in the export, got:\n%s", export)
+	}
+
+	m.startExplain()
+	if view := m.View(); !strings.Contains(view, "Your reason:") || !strings.Contains(view, "paywalled") {
+		t.Errorf("expected the manual reason in the explain view, got:\n%s", view)
+	}
+}
@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// hasLocalEdits reports whether the item has changes that live only in
+// memory until pushed: stored decisions survive a refresh, these don't.
+func (i Item) hasLocalEdits() bool {
+	return i.NotesEdited || i.StartFresh
+}
+
+// refreshMode returns the configured refresh_mode, defaulting to "ask".
+func (m *Model) refreshMode() string {
+	if m.cfg == nil || m.cfg.RefreshMode == "" {
+		return "ask"
+	}
+	return m.cfg.RefreshMode
+}
+
+// requestRefresh re-fetches the current view. Items with unpushed local
+// edits are merged into the result or dropped per refresh_mode, asking
+// first by default.
+func (m *Model) requestRefresh() tea.Cmd {
+	n := 0
+	for _, item := range m.items {
+		if item.hasLocalEdits() {
+			n++
+		}
+	}
+	if n == 0 {
+		return m.startFetching()
+	}
+	switch m.refreshMode() {
+	case "merge":
+		return m.refresh(true)
+	case "replace":
+		return m.refresh(false)
+	}
+	noun := "items have"
+	if n == 1 {
+		noun = "item has"
+	}
+	m.pendingRefresh = true
+	m.statusMessage = fmt.Sprintf("%s %s notes or start-fresh edits that aren't pushed yet", formatCount(n), noun)
+	m.state = StateConfirming
+	return nil
+}
+
+// refresh re-fetches, keeping local edits for re-fetched items when merge
+// is set.
+func (m *Model) refresh(merge bool) tea.Cmd {
+	m.refreshEdits = nil
+	if merge {
+		m.refreshEdits = make(map[string]Item)
+		for _, item := range m.items {
+			if item.hasLocalEdits() {
+				m.refreshEdits[item.ID] = item
+			}
+		}
+	}
+	return m.startFetching()
+}
+
+// handleRefreshKeys answers the refresh prompt: m merges local edits into
+// the re-fetched items, r replaces them, and esc or n cancels.
+func (m *Model) handleRefreshKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "m", "M", "y", "Y":
+		m.pendingRefresh = false
+		return m.refresh(true)
+	case "r":
+		m.pendingRefresh = false
+		return m.refresh(false)
+	case "n", "N", "esc":
+		m.pendingRefresh = false
+		m.statusMessage = ""
+		m.state = StateReviewing
+	}
+	return nil
+}
+
+// reapplyLocalEdits restores the notes and start-fresh marks saved by a
+// merging refresh onto the re-fetched items with the same ID. Edited notes
+// win over the notes fetched from Readwise.
+func (m *Model) reapplyLocalEdits() {
+	if m.refreshEdits == nil {
+		return
+	}
+	for i := range m.items {
+		edited, ok := m.refreshEdits[m.items[i].ID]
+		if !ok {
+			continue
+		}
+		if edited.NotesEdited {
+			m.items[i].Notes = edited.Notes
+			m.items[i].NotesEdited = true
+		}
+		m.items[i].StartFresh = m.items[i].StartFresh || edited.StartFresh
+	}
+	m.refreshEdits = nil
+}
+
+func (m *Model) refreshConfirmView() string {
+	content := m.styles.Border.Render(
+		lipgloss.JoinVertical(lipgloss.Center,
+			m.styles.Title.Render("Refresh"),
+			"",
+			m.styles.Normal.Render(m.statusMessage),
+			m.styles.Help.Render("Set refresh_mode to merge or replace to skip this question"),
+		),
+	)
+
+	help := m.renderHelpLine([]helpEntry{
+		{"m", "keep edits"},
+		{"r", "discard edits"},
+		{"esc", "cancel"},
+	})
+
+	return lipgloss.JoinVertical(lipgloss.Center, "", content, "", help)
+}
+
+// ItemRefreshedMsg carries the current Readwise metadata for one item.
+type ItemRefreshedMsg struct {
+	ID  string
+	Doc readwise.Item
+	Err error
+}
+
+// refreshItem re-fetches the focused item's metadata from Readwise.
+func (m *Model) refreshItem() tea.Cmd {
+	item := m.listView.CurrentItem()
+	if item == nil {
+		return nil
+	}
+	if m.demo {
+		m.statusMessage = "Demo items can't be re-fetched"
+		return nil
+	}
+	if m.cfg == nil || m.cfg.ReadwiseToken == "" {
+		m.statusMessage = "READWISE_TOKEN not configured"
+		m.messageType = "error"
+		m.state = StateMessage
+		return nil
+	}
+	m.statusMessage = fmt.Sprintf("Re-fetching %q...", Truncate(item.Title, 40))
+	token, id := m.cfg.ReadwiseToken, item.ID
+	return func() tea.Msg {
+		client, err := newReadwiseClient(token)
+		if err != nil {
+			return ItemRefreshedMsg{ID: id, Err: err}
+		}
+		doc, err := client.GetDocument(id)
+		return ItemRefreshedMsg{ID: id, Doc: doc, Err: err}
+	}
+}
+
+// handleItemRefreshed replaces an item's Readwise metadata in place.
+func (m *Model) handleItemRefreshed(msg ItemRefreshedMsg) {
+	if msg.Err != nil {
+		m.statusMessage = fmt.Sprintf("Re-fetch failed: %v", msg.Err)
+		m.messageType = "error"
+		m.state = StateMessage
+		return
+	}
+	for i := range m.items {
+		if m.items[i].ID == msg.ID {
+			m.items[i] = refreshedItem(m.items[i], msg.Doc)
+			m.listView.SetItems(m.items)
+			m.statusMessage = fmt.Sprintf("Re-fetched %q from Readwise", Truncate(m.items[i].Title, 40))
+			return
+		}
+	}
+}
+
+// refreshedItem takes an item's metadata from a re-fetched document while
+// keeping the pending decision and other edits that haven't been pushed.
+func refreshedItem(old Item, doc readwise.Item) Item {
+	item := newItem(doc)
+	item.Action = old.Action
+	item.Defaulted = old.Defaulted
+	item.Priority = old.Priority
+	item.Tags = old.Tags
+	item.StartFresh = old.StartFresh
+	item.Starred = old.Starred
+	item.Reason = old.Reason
+	item.TriagedAt = old.TriagedAt
+	item.DuplicateIDs = old.DuplicateIDs
+	item.AltAction = old.AltAction
+	item.AltPriority = old.AltPriority
+	if old.NotesEdited {
+		item.Notes = old.Notes
+		item.NotesEdited = true
+	}
+	return item
+}
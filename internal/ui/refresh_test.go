@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+// refetched returns the items a refresh brings back from Readwise, with the
+// server's notes.
+func refetched() ItemsLoadedMsg {
+	return ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "One", Notes: "server note"},
+		{ID: "2", Title: "Two"},
+	}}
+}
+
+// newRefreshTestModel loads two items and edits the first one's notes.
+func newRefreshTestModel(mode string) *Model {
+	m := newTestModel()
+	m.cfg.RefreshMode = mode
+	m.Update(refetched())
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	m.tagsInput = "my local note"
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return m
+}
+
+func TestRefreshAsksWithLocalEdits(t *testing.T) {
+	m := newRefreshTestModel("")
+	if !m.items[0].NotesEdited {
+		t.Fatal("expected the notes edit to be tracked")
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if cmd != nil || m.state != StateConfirming || !m.pendingRefresh {
+		t.Fatalf("expected R to ask first, got state %v", m.state)
+	}
+	if view := m.View(); !strings.Contains(view, "1 item has notes or start-fresh edits") {
+		t.Errorf("expected the refresh prompt, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.pendingRefresh || m.state != StateReviewing {
+		t.Fatalf("expected esc to cancel, got state %v", m.state)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")}); cmd == nil || m.state != StateFetching {
+		t.Fatalf("expected m to start the refresh, got state %v", m.state)
+	}
+	m.Update(refetched())
+	if got := m.items[0]; got.Notes != "my local note" || !got.NotesEdited {
+		t.Errorf("expected the local notes kept after a merging refresh, got %q", got.Notes)
+	}
+	if got := m.items[1]; got.Notes != "" || got.NotesEdited {
+		t.Errorf("expected the unedited item untouched, got %+v", got)
+	}
+}
+
+func TestRefreshReplaceDropsLocalEdits(t *testing.T) {
+	m := newRefreshTestModel("")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	m.Update(refetched())
+	if got := m.items[0]; got.Notes != "server note" || got.NotesEdited {
+		t.Errorf("expected the server notes after replacing, got %q", got.Notes)
+	}
+}
+
+func TestRefreshMode(t *testing.T) {
+	tests := []struct {
+		mode      string
+		wantNotes string
+	}{
+		{"merge", "my local note"},
+		{"replace", "server note"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			m := newRefreshTestModel(tt.mode)
+			if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")}); cmd == nil || m.pendingRefresh {
+				t.Fatalf("expected refresh_mode %s to refresh without asking, got state %v", tt.mode, m.state)
+			}
+			m.Update(refetched())
+			if got := m.items[0].Notes; got != tt.wantNotes {
+				t.Errorf("notes after refresh = %q, want %q", got, tt.wantNotes)
+			}
+		})
+	}
+}
+
+func TestRefreshWithoutLocalEdits(t *testing.T) {
+	m := newTestModel()
+	m.Update(refetched())
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")}); cmd == nil || m.state != StateFetching {
+		t.Errorf("expected R to refresh right away, got state %v", m.state)
+	}
+}
+
+func TestRefreshItemInPlace(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"results":[{"id":"2","title":"Two (updated)","site_name":"example.com","notes":"server note",` +
+			`"tags":{"go":{"name":"go"},"priority:high":{"name":"priority:high"}},"reading_progress":0.5,"word_count":1200}]}`))
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "token"}
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "One"},
+		{ID: "2", Title: "Two", OriginalTags: []string{"old"}, Progress: 0.1},
+	}})
+	typeKeys(m, "j")
+	typeKeys(m, "a")
+	m.items[1].StartFresh = true
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	if cmd == nil {
+		t.Fatal("expected F to re-fetch the focused item")
+	}
+	m.Update(cmd())
+	if !strings.Contains(query, "id=2") {
+		t.Errorf("expected the request to filter by id, got %q", query)
+	}
+
+	got := m.items[1]
+	if got.Title != "Two (updated)" || got.Progress != 0.5 || got.WordCount != 1200 || got.SiteName != "example.com" || got.Notes != "server note" {
+		t.Errorf("expected the metadata refreshed, got %+v", got)
+	}
+	if strings.Join(got.OriginalTags, ",") != "go" || got.RemotePriority != "high" {
+		t.Errorf("expected tags go and remote priority high, got %v and %q", got.OriginalTags, got.RemotePriority)
+	}
+	if got.Action != "archive" || !got.StartFresh {
+		t.Errorf("expected the pending action and start fresh kept, got %q and %v", got.Action, got.StartFresh)
+	}
+	if m.items[0].Title != "One" || m.listView.CurrentItem().ID != "2" {
+		t.Error("expected other items and the cursor untouched")
+	}
+	if m.state != StateReviewing || !strings.Contains(m.statusMessage, "Re-fetched") {
+		t.Errorf("expected a status note in review, got state %v and %q", m.state, m.statusMessage)
+	}
+}
+
+func TestRefreshItemKeepsEditedNotes(t *testing.T) {
+	old := Item{ID: "1", Notes: "mine", NotesEdited: true, Action: "later", Priority: "low", Tags: []string{"t"}}
+	got := refreshedItem(old, readwise.Item{ID: "1", Title: "New", Notes: "theirs"})
+	if got.Notes != "mine" || !got.NotesEdited || got.Title != "New" || got.Priority != "low" || len(got.Tags) != 1 {
+		t.Errorf("refreshedItem() = %+v", got)
+	}
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// actionKeys maps the single-item action keys in review mode to actions.
+var actionKeys = map[string]string{
+	"r": "read_now",
+	"l": "later",
+	"a": "archive",
+	"d": "delete",
+	"n": "needs_review",
+}
+
+// maxRepeatCount caps a count prefix so a stray run of digits can't spin.
+const maxRepeatCount = 999
+
+// handleCountKey buffers a vim-style count prefix in review mode and reports
+// whether it consumed the key. 1, 2, and 3 set priority and 0 marks start
+// fresh, so a bare count must start with 4-9; # starts a count explicitly,
+// so #3a archives three items. Once a count is pending, every digit extends
+// it. Esc drops a pending count.
+func (m *Model) handleCountKey(msg tea.KeyMsg) bool {
+	pending := m.repeatCount > 0 || m.countLead
+	if pending && keyMatches(msg, m.keys.Back) {
+		m.repeatCount = 0
+		m.countLead = false
+		m.statusMessage = ""
+		return true
+	}
+	s := msg.String()
+	if s == "#" && !pending {
+		m.countLead = true
+		m.statusMessage = "#… (type a count, then j/k or an action, esc to cancel)"
+		return true
+	}
+	if len(s) != 1 || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+	if !pending && s[0] < '4' {
+		return false
+	}
+	m.repeatCount = min(m.repeatCount*10+int(s[0]-'0'), maxRepeatCount)
+	if m.repeatCount == 0 {
+		// #0 is still waiting for a non-zero digit
+		return true
+	}
+	m.statusMessage = fmt.Sprintf("%d× (j/k to move, an action to apply to %d items, esc to cancel)", m.repeatCount, m.repeatCount)
+	return true
+}
+
+// takeRepeatCount returns the pending count, or 1 without one, and clears it.
+func (m *Model) takeRepeatCount() int {
+	if m.countLead {
+		m.countLead = false
+		m.statusMessage = ""
+	}
+	if m.repeatCount == 0 {
+		return 1
+	}
+	count := m.repeatCount
+	m.repeatCount = 0
+	m.statusMessage = ""
+	return count
+}
+
+// repeatOnItems applies fn to count items starting at the cursor, moving down
+// after each one the way a single action would leave the cursor in place.
+// It stops at the end of the list.
+func (m *Model) repeatOnItems(count int, fn func(item *Item)) {
+	for i := 0; i < count; i++ {
+		item := m.listView.CurrentItem()
+		if item == nil {
+			break
+		}
+		id, before := item.ID, m.listView.Cursor()
+		fn(item)
+		if count == 1 {
+			break
+		}
+		// Snoozed items leave the list, bringing the next one under the
+		// cursor; when the last row goes, the cursor moves up instead
+		if current := m.listView.CurrentItem(); current != nil && current.ID != id {
+			if m.listView.Cursor() < before {
+				break
+			}
+			continue
+		}
+		m.listView.MoveCursor(1)
+		if m.listView.Cursor() == before {
+			break
+		}
+	}
+	m.cursor = m.listView.Cursor()
+}
@@ -0,0 +1,171 @@
+package ui
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newRepeatTestModel(n int) *Model {
+	m := newTestModel()
+	for i := 0; i < n; i++ {
+		m.items = append(m.items, Item{ID: fmt.Sprintf("%d", i), Title: fmt.Sprintf("Item %d", i)})
+	}
+	m.state = StateReviewing
+	m.listView.SetItems(m.items)
+	return m
+}
+
+func typeKeys(m *Model, keys string) {
+	for _, r := range keys {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestRepeatCountMotion(t *testing.T) {
+	m := newRepeatTestModel(20)
+
+	typeKeys(m, "5j")
+	if m.listView.Cursor() != 5 {
+		t.Errorf("expected 5j to move to row 5, got %d", m.listView.Cursor())
+	}
+	typeKeys(m, "4k")
+	if m.listView.Cursor() != 1 {
+		t.Errorf("expected 4k to move to row 1, got %d", m.listView.Cursor())
+	}
+
+	// Once a count is pending, 1-3 extend it instead of setting priority
+	typeKeys(m, "41j")
+	if m.listView.Cursor() != 19 {
+		t.Errorf("expected 41j to stop at the last row, got %d", m.listView.Cursor())
+	}
+	if m.items[1].Priority != "" {
+		t.Errorf("expected no priority set while counting, got %q", m.items[1].Priority)
+	}
+	if m.repeatCount != 0 {
+		t.Errorf("expected the count consumed, got %d", m.repeatCount)
+	}
+}
+
+func TestRepeatCountLead(t *testing.T) {
+	m := newRepeatTestModel(10)
+
+	// # lets a count start with 1-3 instead of setting priority
+	typeKeys(m, "#3a")
+	for i, item := range m.items {
+		want := ""
+		if i < 3 {
+			want = "archive"
+		}
+		if item.Action != want {
+			t.Errorf("item %d action = %q, want %q", i, item.Action, want)
+		}
+		if item.Priority != "" {
+			t.Errorf("item %d priority = %q, want none", i, item.Priority)
+		}
+	}
+	if m.listView.Cursor() != 3 {
+		t.Errorf("expected the cursor after the last archived item, got %d", m.listView.Cursor())
+	}
+
+	typeKeys(m, "#12j")
+	if m.listView.Cursor() != 9 {
+		t.Errorf("expected #12j to stop at the last row, got %d", m.listView.Cursor())
+	}
+
+	// Esc cancels a lone #, and 1 then sets priority as usual
+	typeKeys(m, "#")
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.countLead || m.repeatCount != 0 {
+		t.Errorf("expected esc to drop the count, got lead %v count %d", m.countLead, m.repeatCount)
+	}
+	typeKeys(m, "1")
+	if m.items[9].Priority != "high" {
+		t.Errorf("expected 1 to set priority after esc, got %q", m.items[9].Priority)
+	}
+}
+
+func TestRepeatCountAction(t *testing.T) {
+	m := newRepeatTestModel(10)
+	typeKeys(m, "j4a")
+
+	for i, item := range m.items {
+		want := ""
+		if i >= 1 && i <= 4 {
+			want = "archive"
+		}
+		if item.Action != want {
+			t.Errorf("item %d action = %q, want %q", i, item.Action, want)
+		}
+	}
+	if m.listView.Cursor() != 5 {
+		t.Errorf("expected the cursor after the last archived item, got %d", m.listView.Cursor())
+	}
+
+	// A count past the end stops at the last item
+	typeKeys(m, "9l")
+	for i := 5; i < 10; i++ {
+		if m.items[i].Action != "later" {
+			t.Errorf("item %d action = %q, want later", i, m.items[i].Action)
+		}
+	}
+
+	// Snoozed items leave the list, so the count still covers the next ones
+	m = newRepeatTestModel(6)
+	typeKeys(m, "4z")
+	for i, item := range m.items {
+		if snoozed := item.Action == "snooze"; snoozed != (i < 4) {
+			t.Errorf("item %d action = %q", i, item.Action)
+		}
+	}
+}
+
+func TestRepeatCountSnoozeAtEnd(t *testing.T) {
+	tests := []struct {
+		name    string
+		keys    string
+		snoozed []int
+	}{
+		{"past the end", "8j#5z", []int{8, 9}},
+		{"last row", "9j5z", []int{9}},
+		{"exactly to the end", "7j#3z", []int{7, 8, 9}},
+		{"middle", "#2j4z", []int{2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newRepeatTestModel(10)
+			typeKeys(m, tt.keys)
+			var got []int
+			for i, item := range m.items {
+				if item.Action == "snooze" {
+					got = append(got, i)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.snoozed) {
+				t.Errorf("snoozed %v, want %v", got, tt.snoozed)
+			}
+		})
+	}
+}
+
+func TestRepeatCountPrecedence(t *testing.T) {
+	m := newRepeatTestModel(5)
+
+	// Without a pending count, 1-3 set priority and a single action stays put
+	typeKeys(m, "2a")
+	if m.items[0].Priority != "medium" || m.items[0].Action != "archive" || m.listView.Cursor() != 0 {
+		t.Errorf("expected 2 then a to set medium and archive row 0, got %+v at row %d", m.items[0], m.listView.Cursor())
+	}
+
+	// Esc drops a pending count without leaving review mode
+	typeKeys(m, "7")
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.state != StateReviewing || m.repeatCount != 0 {
+		t.Fatalf("expected esc to cancel the count, got state %v count %d", m.state, m.repeatCount)
+	}
+	typeKeys(m, "j")
+	if m.listView.Cursor() != 1 {
+		t.Errorf("expected a plain j after cancelling, got row %d", m.listView.Cursor())
+	}
+}
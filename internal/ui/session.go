@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+)
+
+// sessionSaveMsg fires when the review session may be snapshotted again.
+type sessionSaveMsg struct{}
+
+// sessionSaveTick schedules the next session snapshot, or returns nil when
+// snapshots are turned off.
+func (m *Model) sessionSaveTick() tea.Cmd {
+	if m.demo || m.cfg == nil {
+		return nil
+	}
+	interval := m.cfg.SessionSaveInterval()
+	if interval <= 0 {
+		return nil
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg { return sessionSaveMsg{} })
+}
+
+// sessionSnapshot describes the review in progress.
+func (m *Model) sessionSnapshot() config.Session {
+	s := config.Session{
+		Location: m.fetchLocation,
+		Category: m.category,
+		Lookback: m.activeLookback(),
+		Week:     m.weekWindow,
+		Filter:   m.filterQuery,
+	}
+	if item := m.listView.CurrentItem(); item != nil {
+		s.CursorID = item.ID
+	}
+	return s
+}
+
+// saveSession writes the session snapshot once items are loaded, if it
+// changed since the last write. Failures are ignored like config saves.
+func (m *Model) saveSession() {
+	if m.demo || len(m.items) == 0 {
+		return
+	}
+	s := m.sessionSnapshot()
+	if s == m.sessionSaved {
+		return
+	}
+	m.sessionSaved = s
+	s.SavedAt = time.Now()
+	_ = config.SaveSession(s)
+}
+
+// EndSession removes the session snapshot after a clean exit, so the next
+// launch doesn't offer to resume it, and remembers the terminal size for the
+// next launch's first frame.
+func (m *Model) EndSession() {
+	if m.demo {
+		return
+	}
+	_ = config.ClearSession()
+	if m.cfg != nil && m.width > 0 && m.height > 0 && (m.cfg.WindowWidth != m.width || m.cfg.WindowHeight != m.height) {
+		m.cfg.WindowWidth, m.cfg.WindowHeight = m.width, m.height
+		m.saveConfig()
+	}
+}
+
+// handleResumeKeys answers the resume prompt on the config screen: y fetches
+// the saved session's items again, any other key starts fresh.
+func (m *Model) handleResumeKeys(msg tea.KeyMsg) tea.Cmd {
+	s := m.resumeSession
+	m.resumeSession = nil
+	if msg.String() != "y" && msg.String() != "Y" {
+		_ = config.ClearSession()
+		return nil
+	}
+
+	m.fetchLocation = "new"
+	if s.Location == "feed" {
+		m.fetchLocation = "feed"
+	}
+	m.category = s.Category
+	if s.Lookback > 0 {
+		*m.activeLookbackPtr() = min(s.Lookback, maxLookbackDays)
+	}
+	m.weekWindow = max(s.Week, 0)
+	m.sinceLast = false
+	m.presetName = ""
+	m.setFilter(s.Filter)
+	m.resumeCursorID = s.CursorID
+	return m.startFetching()
+}
+
+// restoreSessionCursor focuses the item that was focused when the resumed
+// session was saved, if it was fetched again.
+func (m *Model) restoreSessionCursor() {
+	id := m.resumeCursorID
+	m.resumeCursorID = ""
+	if id == "" {
+		return
+	}
+	for row := 0; row < m.listView.VisibleCount(); row++ {
+		if idx := m.listView.ItemIndex(row); idx >= 0 && m.items[idx].ID == id {
+			m.listView.SetCursor(row)
+			m.cursor = m.listView.Cursor()
+			return
+		}
+	}
+}
+
+// resumeLine is the config screen prompt for a session left by a crash.
+func (m *Model) resumeLine() string {
+	s := m.resumeSession
+	location := "Inbox"
+	if s.Location == "feed" {
+		location = "Feed"
+	}
+	desc := fmt.Sprintf("%s, %d days", location, s.Lookback)
+	if s.Week > 0 {
+		start, end := weekBounds(time.Now(), s.Week)
+		desc = fmt.Sprintf("%s, week %s", location, formatWindow(start, end))
+	}
+	if s.Category != "" {
+		desc += ", " + s.Category
+	}
+	if s.Filter != "" {
+		desc += fmt.Sprintf(", filter %q", s.Filter)
+	}
+	if !s.SavedAt.IsZero() {
+		desc += ", saved " + s.SavedAt.Local().Format("Jan 2 15:04")
+	}
+	return fmt.Sprintf("  ↺  %s %s", m.styles.Normal.Render("Resume previous session? (y/n)"), m.styles.Help.Render(desc))
+}
@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func TestSessionResume(t *testing.T) {
+	var mu sync.Mutex
+	var locations []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		locations = append(locations, r.URL.Query().Get("location"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":3,"nextPageCursor":null,"results":[{"id":"1","title":"Go one"},{"id":"2","title":"Rust two"},{"id":"3","title":"Rust three"}]}`)
+	}))
+	defer srv.Close()
+
+	origClient := newReadwiseClient
+	newReadwiseClient = func(token string) (*readwise.Client, error) {
+		return readwise.NewClient(token, readwise.WithBaseURL(srv.URL))
+	}
+	defer func() { newReadwiseClient = origClient }()
+	t.Cleanup(func() { config.ClearSession() })
+
+	m := newTestModel()
+	m.cfg = &config.Config{ReadwiseToken: "test-token"}
+	m.fetchLocation = "feed"
+	m.feedLookback = 21
+	m.Update(m.startFetching()())
+	m.setFilter("rust")
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if item := m.listView.CurrentItem(); item == nil || item.ID != "3" {
+		t.Fatalf("expected item 3 focused, got %v", item)
+	}
+	m.saveSession()
+
+	// The next launch offers the session and restores it with y
+	m = NewModel()
+	m.cfg.ReadwiseToken = "test-token"
+	if m.resumeSession == nil {
+		t.Fatal("expected the saved session to be offered")
+	}
+	if view := m.View(); !strings.Contains(view, "Resume previous session?") || !strings.Contains(view, `filter "rust"`) {
+		t.Error("expected the config screen to show the resume prompt")
+	}
+	if m.fetchLocation != "new" {
+		t.Fatalf("expected the location to wait for the answer, got %q", m.fetchLocation)
+	}
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cmd == nil {
+		t.Fatal("expected y to fetch the session's items")
+	}
+	m.Update(cmd())
+
+	if m.state != StateReviewing || m.fetchLocation != "feed" || m.activeLookback() != 21 || m.filterQuery != "rust" {
+		t.Errorf("expected feed, 21 days and the filter restored, got %q, %d, %q in %v", m.fetchLocation, m.activeLookback(), m.filterQuery, m.state)
+	}
+	if item := m.listView.CurrentItem(); item == nil || item.ID != "3" {
+		t.Errorf("expected the cursor restored to item 3, got %v", item)
+	}
+	mu.Lock()
+	if locations[len(locations)-1] != "feed" {
+		t.Errorf("expected the resumed fetch to use the feed, got %v", locations)
+	}
+	mu.Unlock()
+
+	// Answering anything else starts fresh and drops the session
+	m.saveSession()
+	m = NewModel()
+	if m.resumeSession == nil {
+		t.Fatal("expected the session to be offered again")
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}}); cmd != nil || m.resumeSession != nil {
+		t.Error("expected n to dismiss the prompt without fetching")
+	}
+	if s, _ := config.LoadSession(); s != nil {
+		t.Errorf("expected n to remove the session, got %+v", s)
+	}
+}
+
+func TestSessionSaveOnlyOnChange(t *testing.T) {
+	t.Cleanup(func() { config.ClearSession() })
+	m := newTestModel()
+	m.saveSession()
+	if s, _ := config.LoadSession(); s != nil {
+		t.Fatal("expected no session before items are loaded")
+	}
+
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One"}, {ID: "2", Title: "Two"}}})
+	m.saveSession()
+	first, _ := config.LoadSession()
+	if first == nil || first.CursorID != "1" {
+		t.Fatalf("expected a session focused on 1, got %+v", first)
+	}
+	m.saveSession()
+	if again, _ := config.LoadSession(); !again.SavedAt.Equal(first.SavedAt) {
+		t.Error("expected an unchanged session not to be rewritten")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.saveSession()
+	if moved, _ := config.LoadSession(); moved.CursorID != "2" {
+		t.Errorf("expected the moved cursor saved, got %q", moved.CursorID)
+	}
+
+	m.EndSession()
+	if s, _ := config.LoadSession(); s != nil {
+		t.Errorf("expected a clean exit to remove the session, got %+v", s)
+	}
+}
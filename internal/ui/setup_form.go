@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// SetupForm is the first-run wizard that asks for a Readwise token and an
+// optional LLM provider before the TUI starts.
+type SetupForm struct {
+	form   *huh.Form
+	result *SetupResult
+}
+
+// SetupResult contains the wizard's answers
+type SetupResult struct {
+	Token    string
+	Provider string // "" to skip auto-triage
+	APIKey   string
+}
+
+// NewSetupForm creates the setup wizard. verify checks the entered token
+// before the wizard moves on.
+func NewSetupForm(verify func(token string) error) *SetupForm {
+	result := &SetupResult{}
+
+	providers := []huh.Option[string]{huh.NewOption("Skip, triage manually or by export", "")}
+	for _, name := range triage.Providers() {
+		providers = append(providers, huh.NewOption(fmt.Sprintf("%s (%s)", name, triage.DefaultModel(name)), name))
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Readwise access token").
+				Description("Get one at https://readwise.io/access_token").
+				EchoMode(huh.EchoModePassword).
+				Validate(func(token string) error {
+					token = strings.TrimSpace(token)
+					if token == "" {
+						return errors.New("a token is required")
+					}
+					return verify(token)
+				}).
+				Value(&result.Token),
+		),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("LLM provider for auto-triage").
+				Options(providers...).
+				Value(&result.Provider),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("LLM API key").
+				Description("Leave empty to use LLM_API_KEY from the environment").
+				EchoMode(huh.EchoModePassword).
+				Value(&result.APIKey),
+		).WithHideFunc(func() bool {
+			// Ollama runs locally without a key
+			return result.Provider == "" || result.Provider == "ollama"
+		}),
+	)
+
+	return &SetupForm{
+		form:   form,
+		result: result,
+	}
+}
+
+// Run executes the form and returns the result
+func (sf *SetupForm) Run() (*SetupResult, error) {
+	err := sf.form.Run()
+	if err != nil {
+		return nil, err
+	}
+	return sf.result, nil
+}
+
+// GetForm returns the underlying Huh form for Bubble Tea integration
+func (sf *SetupForm) GetForm() *huh.Form {
+	return sf.form
+}
+
+// Save writes a new config file with the wizard's answers, including the
+// token and API key.
+func (r *SetupResult) Save() error {
+	cfg := &config.Config{
+		ReadwiseToken: strings.TrimSpace(r.Token),
+		InboxDaysAgo:  7,
+		FeedDaysAgo:   7,
+		Theme:         "default",
+		UseLLMTriage:  r.Provider != "",
+		WriteSecrets:  true,
+		WriteLLM:      true,
+	}
+	if r.Provider != "" {
+		cfg.LLM.Provider = r.Provider
+		cfg.LLM.Model = triage.DefaultModel(r.Provider)
+		cfg.LLM.APIKey = strings.TrimSpace(r.APIKey)
+	}
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("save config: %w", err)
+	}
+	return nil
+}
+
+// verifySetupToken checks a token entered in the setup wizard against Readwise.
+func verifySetupToken(token string) error {
+	client, err := newReadwiseClient(token)
+	if err != nil {
+		return err
+	}
+	valid, err := client.VerifyToken()
+	if err != nil {
+		return fmt.Errorf("could not reach Readwise: %w", err)
+	}
+	if !valid {
+		return errors.New("Readwise rejected this token")
+	}
+	return nil
+}
+
+// RunSetup runs the first-run wizard and saves its answers. Cancelling is
+// not an error; the TUI then starts without a token as before.
+func RunSetup(w io.Writer) error {
+	result, err := NewSetupForm(verifySetupToken).Run()
+	if errors.Is(err, huh.ErrUserAborted) {
+		fmt.Fprintln(w, "Setup cancelled; run readwise-triage init for a starter config.")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("setup: %w", err)
+	}
+	if err := result.Save(); err != nil {
+		return err
+	}
+	if path, err := config.ConfigFilePath(); err == nil {
+		fmt.Fprintf(w, "Saved config to %s\n", path)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mcao2/readwise-triage/internal/config"
+	"github.com/mcao2/readwise-triage/internal/readwise"
+)
+
+func TestSetupResultSave(t *testing.T) {
+	tests := []struct {
+		name   string
+		result SetupResult
+		want   config.LLMConfig
+		useLLM bool
+	}{
+		{"with provider", SetupResult{Token: " rw-token ", Provider: "anthropic", APIKey: "sk-test"}, config.LLMConfig{Provider: "anthropic", Model: "claude-sonnet-4-5-20250929", APIKey: "sk-test"}, true},
+		{"ollama", SetupResult{Token: "rw-token", Provider: "ollama"}, config.LLMConfig{Provider: "ollama", Model: "llama3"}, true},
+		{"skip provider", SetupResult{Token: "rw-token", APIKey: "ignored"}, config.LLMConfig{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearLLMEnv(t)
+			t.Setenv("READWISE_TOKEN", "")
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			t.Setenv("READWISE_TRIAGE_CONFIG", path)
+
+			if err := tt.result.Save(); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			cfg, err := config.Load()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.ReadwiseToken != "rw-token" {
+				t.Errorf("ReadwiseToken = %q, want rw-token", cfg.ReadwiseToken)
+			}
+			if got := cfg.LLM; got.Provider != tt.want.Provider || got.Model != tt.want.Model || got.APIKey != tt.want.APIKey {
+				t.Errorf("LLM = %+v, want %+v", got, tt.want)
+			}
+			if cfg.UseLLMTriage != tt.useLLM {
+				t.Errorf("UseLLMTriage = %v, want %v", cfg.UseLLMTriage, tt.useLLM)
+			}
+			if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+				t.Errorf("expected a private config file, got %v, %v", info, err)
+			}
+		})
+	}
+}
+
+func TestSaveKeepsSecretsOutByDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv("READWISE_TRIAGE_CONFIG", path)
+
+	cfg := &config.Config{ReadwiseToken: "from-env", Theme: "nord"}
+	cfg.LLM.APIKey = "sk-env"
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "from-env") || strings.Contains(string(data), "sk-env") {
+		t.Errorf("expected secrets not to be written without WriteSecrets, got:\n%s", data)
+	}
+}
+
+func TestVerifySetupToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  statusHTTPClient
+		wantErr string
+	}{
+		{"valid", statusHTTPClient{status: http.StatusNoContent}, ""},
+		{"invalid", statusHTTPClient{status: http.StatusUnauthorized}, "rejected"},
+		{"network error", statusHTTPClient{err: fmt.Errorf("dial tcp: no route to host")}, "could not reach Readwise"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origClient := newReadwiseClient
+			newReadwiseClient = func(token string) (*readwise.Client, error) {
+				return readwise.NewClient(token, readwise.WithHTTPClient(tt.client))
+			}
+			defer func() { newReadwiseClient = origClient }()
+
+			err := verifySetupToken("token")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("verifySetupToken() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("verifySetupToken() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
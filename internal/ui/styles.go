@@ -82,6 +82,20 @@ var Themes = map[string]Theme{
 		Background: "#282828",
 		Subtle:     "#3C3836",
 	},
+	// Light theme for light-background terminals. Text and Help use darker
+	// Solarized tones than usual so dim text stays readable.
+	"solarized-light": {
+		Name:       "Solarized Light",
+		Primary:    "#268BD2",
+		Secondary:  "#073642",
+		Text:       "#073642",
+		Help:       "#586E75",
+		Highlight:  "#859900",
+		Success:    "#859900",
+		Error:      "#DC322F",
+		Background: "#FDF6E3",
+		Subtle:     "#93A1A1",
+	},
 }
 
 // Styles holds all the UI styles
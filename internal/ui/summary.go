@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// summaryActions is the order actions are listed in the session summary.
+var summaryActions = []string{"read_now", "archive", "later", "delete", "needs_review", "snooze"}
+
+// actionCounts counts items by action; untriaged items are not counted.
+func actionCounts(items []Item) map[string]int {
+	counts := make(map[string]int)
+	for _, item := range items {
+		if item.Action != "" {
+			counts[item.Action]++
+		}
+	}
+	return counts
+}
+
+// sessionSummary recaps the loaded items' dispositions and how many were
+// pushed this session, e.g. "Reviewed 40 items: 5 read_now, 20 archive;
+// pushed 35, 5 pending".
+func (m *Model) sessionSummary() string {
+	counts := actionCounts(m.items)
+	reviewed := 0
+	var parts []string
+	for _, action := range summaryActions {
+		if n := counts[action]; n > 0 {
+			reviewed += n
+			parts = append(parts, fmt.Sprintf("%s %s", formatCount(n), action))
+		}
+	}
+	pushed := 0
+	for _, item := range m.items {
+		if m.pushed[item.ID] {
+			pushed++
+		}
+	}
+
+	noun := "items"
+	if reviewed == 1 {
+		noun = "item"
+	}
+	summary := fmt.Sprintf("Reviewed %s %s", formatCount(reviewed), noun)
+	if len(parts) > 0 {
+		summary += ": " + strings.Join(parts, ", ")
+	}
+	return summary + fmt.Sprintf("; pushed %s, %s pending", formatCount(pushed), formatCount(m.unpushedCount()))
+}
+
+// showSummary shows the session summary before quitting, or quits right
+// away when nothing was reviewed.
+func (m *Model) showSummary() tea.Cmd {
+	m.pendingQuit = false
+	if len(actionCounts(m.items)) == 0 && len(m.pushed) == 0 {
+		return tea.Quit
+	}
+	m.statusMessage = ""
+	m.state = StateSummary
+	return nil
+}
+
+// handleSummaryKeys quits on any key except esc, which goes back to where
+// q was pressed.
+func (m *Model) handleSummaryKeys(msg tea.KeyMsg) tea.Cmd {
+	if msg.Type == tea.KeyEsc {
+		m.state = m.quitFrom
+		return nil
+	}
+	return tea.Quit
+}
+
+func (m *Model) summaryView() string {
+	content := m.styles.Border.Render(
+		lipgloss.JoinVertical(lipgloss.Center,
+			m.styles.Title.Render("Session Summary"),
+			"",
+			m.styles.Normal.Render(m.sessionSummary()),
+		),
+	)
+	help := m.renderHelpLine([]helpEntry{{"any key", "quit"}, {"esc", "back"}})
+	return lipgloss.JoinVertical(lipgloss.Center, "", content, "", help)
+}
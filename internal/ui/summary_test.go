@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSessionSummary(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{
+		{ID: "1", Title: "One"},
+		{ID: "2", Title: "Two"},
+		{ID: "3", Title: "Three"},
+		{ID: "4", Title: "Four"},
+		{ID: "5", Title: "Five"},
+		{ID: "6", Title: "Six"},
+	}})
+	// read_now, archive, archive, delete, later; the last item is left alone
+	typeKeys(m, "rjajajdjl")
+	m.clearUnpushed("1")
+	m.clearUnpushed("2")
+
+	want := "Reviewed 5 items: 1 read_now, 2 archive, 1 later, 1 delete; pushed 2, 3 pending"
+	if got := m.sessionSummary(); got != want {
+		t.Errorf("sessionSummary() = %q, want %q", got, want)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if m.state != StateSummary {
+		t.Fatalf("expected the summary before quitting, got state %v", m.state)
+	}
+	if view := m.View(); !strings.Contains(view, "pushed 2, 3 pending") {
+		t.Errorf("expected the summary in the view, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.state != StateReviewing {
+		t.Errorf("expected esc to return to review, got state %v", m.state)
+	}
+}
+
+func TestSessionSummaryNeedsReview(t *testing.T) {
+	m := newTestModel()
+	m.Update(ItemsLoadedMsg{Items: []Item{{ID: "1", Title: "One", Action: "needs_review"}}})
+	if got, want := m.sessionSummary(), "Reviewed 1 item: 1 needs_review; pushed 0, 0 pending"; got != want {
+		t.Errorf("sessionSummary() = %q, want %q", got, want)
+	}
+}
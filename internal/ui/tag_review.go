@@ -0,0 +1,188 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+// tagSuggestion holds the LLM-suggested tags for one item awaiting review
+// with review_suggested_tags. Accepted parallels Tags.
+type tagSuggestion struct {
+	ID       string
+	Title    string
+	Tags     []string
+	Accepted []bool
+	Result   triage.Result // saved with the accepted tags on confirm
+}
+
+// tagReviewRow points at one suggested tag in the flattened review list.
+type tagReviewRow struct {
+	suggestion int
+	tag        int
+}
+
+// tagReviewRows flattens the pending suggestions into one row per tag.
+func (m *Model) tagReviewRows() []tagReviewRow {
+	var rows []tagReviewRow
+	for i, s := range m.tagReview {
+		for j := range s.Tags {
+			rows = append(rows, tagReviewRow{suggestion: i, tag: j})
+		}
+	}
+	return rows
+}
+
+// queueTagReview records suggested tags for review instead of applying them.
+// All suggestions start accepted, so confirming right away matches applying
+// them directly.
+func (m *Model) queueTagReview(item *Item, tags []string, result triage.Result) {
+	accepted := make([]bool, len(tags))
+	for i := range accepted {
+		accepted[i] = true
+	}
+	m.tagReview = append(m.tagReview, tagSuggestion{
+		ID:       item.ID,
+		Title:    item.Title,
+		Tags:     tags,
+		Accepted: accepted,
+		Result:   result,
+	})
+}
+
+// setAllSuggestedTags accepts or rejects every pending suggestion.
+func (m *Model) setAllSuggestedTags(accept bool) {
+	for i := range m.tagReview {
+		for j := range m.tagReview[i].Accepted {
+			m.tagReview[i].Accepted[j] = accept
+		}
+	}
+}
+
+// finishTagReview applies the accepted tags to their items and the triage
+// store, then shows the triage summary. Items whose suggestions were all
+// rejected keep their current tags.
+func (m *Model) finishTagReview() {
+	kept, total := 0, 0
+	for _, s := range m.tagReview {
+		var accepted []string
+		for j, tag := range s.Tags {
+			total++
+			if s.Accepted[j] {
+				accepted = append(accepted, tag)
+				kept++
+			}
+		}
+		for i := range m.items {
+			item := &m.items[i]
+			if item.ID != s.ID {
+				continue
+			}
+			if len(accepted) > 0 {
+				item.Tags = accepted
+			}
+			result := s.Result
+			m.saveLLMTriage(item.ID, item.Action, item.Priority, item.Tags, &result)
+		}
+	}
+
+	m.tagReview = nil
+	m.tagReviewCursor = 0
+	m.listView.SetItems(m.items)
+	m.statusMessage += fmt.Sprintf("; kept %d of %d suggested tags", kept, total)
+	m.messageType = "success"
+	m.state = StateMessage
+}
+
+// handleTagReviewKeys moves through suggested tags, toggles them with space,
+// and applies the accepted ones with enter.
+func (m *Model) handleTagReviewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	rows := m.tagReviewRows()
+	switch {
+	case keyMatches(msg, m.keys.Down):
+		if m.tagReviewCursor < len(rows)-1 {
+			m.tagReviewCursor++
+		}
+	case keyMatches(msg, m.keys.Up):
+		if m.tagReviewCursor > 0 {
+			m.tagReviewCursor--
+		}
+	case keyMatches(msg, m.keys.Select):
+		if m.tagReviewCursor < len(rows) {
+			row := rows[m.tagReviewCursor]
+			accepted := m.tagReview[row.suggestion].Accepted
+			accepted[row.tag] = !accepted[row.tag]
+		}
+	case msg.String() == "a":
+		m.setAllSuggestedTags(true)
+	case msg.String() == "n":
+		m.setAllSuggestedTags(false)
+	case keyMatches(msg, m.keys.Enter):
+		m.finishTagReview()
+	}
+	return m, nil
+}
+
+// maxTagReviewRows is the number of suggested tags shown at once.
+const maxTagReviewRows = 12
+
+func (m *Model) tagReviewView() string {
+	rows := m.tagReviewRows()
+
+	// Keep the cursor in view
+	start := 0
+	if m.tagReviewCursor >= maxTagReviewRows {
+		start = m.tagReviewCursor - maxTagReviewRows + 1
+	}
+	end := min(start+maxTagReviewRows, len(rows))
+
+	lines := []string{m.styles.Title.Render("Review Suggested Tags"), ""}
+	lastSuggestion := -1
+	for i := start; i < end; i++ {
+		row := rows[i]
+		s := m.tagReview[row.suggestion]
+		if row.suggestion != lastSuggestion {
+			lines = append(lines, m.styles.Normal.Render(Truncate(s.Title, 50)))
+			lastSuggestion = row.suggestion
+		}
+		box := "[ ]"
+		if s.Accepted[row.tag] {
+			box = "[x]"
+		}
+		line := fmt.Sprintf("  %s %s", box, s.Tags[row.tag])
+		if i == m.tagReviewCursor {
+			line = m.styles.Selected.Render(line)
+		} else {
+			line = m.styles.HelpDesc.Render(line)
+		}
+		lines = append(lines, line)
+	}
+	if len(rows) > maxTagReviewRows {
+		lines = append(lines, "", m.styles.Help.Render(fmt.Sprintf("%d-%d of %d tags", start+1, end, len(rows))))
+	}
+
+	content := m.styles.Border.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	help := m.renderHelpLine([]helpEntry{
+		{"j/k", "move"},
+		{"space", "toggle"},
+		{"a", "accept all"},
+		{"n", "reject all"},
+		{"enter", "apply"},
+	})
+	return lipgloss.JoinVertical(lipgloss.Center, "", content, "", help)
+}
+
+// suggestedTags drops suggestions that merely repeat an action name.
+func suggestedTags(result triage.Result) []string {
+	var filtered []string
+	for _, tag := range result.MetadataEnhancement.SuggestedTags {
+		lower := strings.ToLower(strings.TrimSpace(tag))
+		if !validActions[lower] {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mcao2/readwise-triage/internal/triage"
+)
+
+func tagReviewResults() []triage.Result {
+	return []triage.Result{
+		{
+			ID:             "1",
+			Title:          "Article 1",
+			TriageDecision: triage.TriageDecision{Action: "read_now", Priority: "high"},
+			MetadataEnhancement: triage.MetadataEnhancement{
+				SuggestedTags: []string{"go", "later", "tools"},
+			},
+		},
+		{
+			ID:             "2",
+			Title:          "Article 2",
+			TriageDecision: triage.TriageDecision{Action: "archive"},
+			MetadataEnhancement: triage.MetadataEnhancement{
+				SuggestedTags: []string{"news"},
+			},
+		},
+		{
+			ID:             "3",
+			Title:          "Article 3",
+			TriageDecision: triage.TriageDecision{Action: "later"},
+		},
+	}
+}
+
+func newTagReviewModel() *Model {
+	m := newTestModel()
+	m.cfg.ReviewSuggestedTags = true
+	m.state = StateTriaging
+	m.items = []Item{
+		{ID: "1", Title: "Article 1"},
+		{ID: "2", Title: "Article 2", Tags: []string{"mine"}},
+		{ID: "3", Title: "Article 3"},
+	}
+	m.listView.SetItems(m.items)
+	m.Update(TriageFinishedMsg{Results: tagReviewResults()})
+	return m
+}
+
+func TestTagReviewRejectsTags(t *testing.T) {
+	m := newTagReviewModel()
+	if m.state != StateTagReview {
+		t.Fatalf("expected StateTagReview, got %v", m.state)
+	}
+	// Decisions apply right away; tags wait for review
+	if m.items[0].Action != "read_now" || len(m.items[0].Tags) != 0 {
+		t.Errorf("expected action applied and tags pending, got %+v", m.items[0])
+	}
+	view := m.tagReviewView()
+	for _, want := range []string{"Article 1", "[x] go", "[x] tools", "news"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("expected %q in tag review view", want)
+		}
+	}
+	if strings.Contains(view, "later") {
+		t.Error("expected action-name tags filtered out")
+	}
+
+	// Reject "go" on item 1 and "news" on item 2
+	m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if !strings.Contains(m.tagReviewView(), "[ ] go") {
+		t.Error("expected go shown as rejected")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.state != StateMessage || !strings.Contains(m.statusMessage, "kept 1 of 3 suggested tags") {
+		t.Errorf("expected summary message, got %v %q", m.state, m.statusMessage)
+	}
+	if !equalStrings(m.items[0].Tags, []string{"tools"}) {
+		t.Errorf("item 1 tags = %v, want [tools]", m.items[0].Tags)
+	}
+	if !equalStrings(m.items[1].Tags, []string{"mine"}) {
+		t.Errorf("item 2 tags = %v, want its own tags kept", m.items[1].Tags)
+	}
+
+	entry, ok := m.triageStore.GetItem("1")
+	if !ok || !equalStrings(entry.Tags, []string{"tools"}) {
+		t.Errorf("expected store to exclude rejected tags, got %+v", entry)
+	}
+	if entry.Source != "llm" || entry.Report == nil {
+		t.Errorf("expected LLM report kept, got %+v", entry)
+	}
+	if entry, _ := m.triageStore.GetItem("2"); !equalStrings(entry.Tags, []string{"mine"}) {
+		t.Errorf("expected item 2 stored with its own tags, got %v", entry.Tags)
+	}
+}
+
+func TestTagReviewBulk(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		wantTags []string
+	}{
+		{"reject all", "n", nil},
+		{"accept all", "a", []string{"go", "tools"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTagReviewModel()
+			m.Update(tea.KeyMsg{Type: tea.KeySpace}) // toggled, then overridden in bulk
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(tt.key)})
+			m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+			if !equalStrings(m.items[0].Tags, tt.wantTags) {
+				t.Errorf("item 1 tags = %v, want %v", m.items[0].Tags, tt.wantTags)
+			}
+			entry, _ := m.triageStore.GetItem("1")
+			if !equalStrings(entry.Tags, tt.wantTags) {
+				t.Errorf("stored tags = %v, want %v", entry.Tags, tt.wantTags)
+			}
+		})
+	}
+}
+
+func TestTagReviewDisabled(t *testing.T) {
+	m := newTestModel()
+	m.state = StateTriaging
+	m.items = []Item{{ID: "1", Title: "Article 1"}, {ID: "2", Title: "Article 2"}, {ID: "3", Title: "Article 3"}}
+	m.listView.SetItems(m.items)
+	m.Update(TriageFinishedMsg{Results: tagReviewResults()})
+
+	if m.state != StateMessage {
+		t.Errorf("expected tags applied directly, got state %v", m.state)
+	}
+	if !equalStrings(m.items[0].Tags, []string{"go", "tools"}) {
+		t.Errorf("item 1 tags = %v", m.items[0].Tags)
+	}
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeAheadIdle is how long type-ahead waits for the next letter before
+// keys go back to being actions.
+var typeAheadIdle = time.Second
+
+// typeAheadIdleMsg ends type-ahead unless a newer key restarted the timer.
+type typeAheadIdleMsg struct {
+	seq int
+}
+
+// startTypeAhead begins a title jump: the letters typed after ' move the
+// cursor to the next item whose title starts with them.
+func (m *Model) startTypeAhead() tea.Cmd {
+	m.typeAheadActive = true
+	m.typeAhead = ""
+	m.statusMessage = "' (type a title prefix to jump, esc to cancel)"
+	return m.typeAheadTimer()
+}
+
+// typeAheadTimer restarts the idle timer.
+func (m *Model) typeAheadTimer() tea.Cmd {
+	m.typeAheadSeq++
+	seq := m.typeAheadSeq
+	return tea.Tick(typeAheadIdle, func(time.Time) tea.Msg { return typeAheadIdleMsg{seq: seq} })
+}
+
+// endTypeAhead drops the type-ahead buffer.
+func (m *Model) endTypeAhead() {
+	m.typeAheadActive = false
+	m.typeAhead = ""
+	m.statusMessage = ""
+}
+
+// handleTypeAheadIdle ends type-ahead once no key has arrived for
+// typeAheadIdle.
+func (m *Model) handleTypeAheadIdle(msg typeAheadIdleMsg) {
+	if m.typeAheadActive && msg.seq == m.typeAheadSeq {
+		m.endTypeAhead()
+	}
+}
+
+// handleTypeAheadKey adds a typed letter to the type-ahead buffer and jumps,
+// and reports whether it consumed the key. Enter or esc ends type-ahead;
+// any other key ends it and is handled as usual.
+func (m *Model) handleTypeAheadKey(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if !m.typeAheadActive {
+		return false, nil
+	}
+	switch {
+	case msg.Type == tea.KeyEnter || msg.Type == tea.KeyEsc:
+		m.endTypeAhead()
+		return true, nil
+	case msg.Type == tea.KeyBackspace:
+		if runes := []rune(m.typeAhead); len(runes) > 0 {
+			m.typeAhead = string(runes[:len(runes)-1])
+			m.jumpToTitle(false)
+		}
+		return true, m.typeAheadTimer()
+	case (msg.Type == tea.KeyRunes && !msg.Alt) || msg.Type == tea.KeySpace:
+		if msg.Type == tea.KeySpace {
+			m.typeAhead += " "
+		} else {
+			m.typeAhead += string(msg.Runes)
+		}
+		// The first letter looks past the current item, later ones keep it
+		// while it still matches
+		m.jumpToTitle(len([]rune(m.typeAhead)) == 1)
+		return true, m.typeAheadTimer()
+	}
+	m.endTypeAhead()
+	return false, nil
+}
+
+// jumpToTitle moves the cursor to the next item whose title starts with the
+// type-ahead buffer, starting at the cursor or, with next, just after it.
+func (m *Model) jumpToTitle(next bool) {
+	if m.typeAhead == "" {
+		m.statusMessage = "'"
+		return
+	}
+	from := m.listView.Cursor()
+	if next {
+		from++
+	}
+	row := m.listView.FindTitlePrefix(m.typeAhead, from)
+	if row < 0 {
+		m.statusMessage = fmt.Sprintf("'%s (no match)", m.typeAhead)
+		return
+	}
+	m.listView.SetCursor(row)
+	m.cursor = m.listView.Cursor()
+	m.statusMessage = "'" + m.typeAhead
+}
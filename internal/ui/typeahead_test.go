@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newTypeAheadTestModel() *Model {
+	m := newTestModel()
+	m.items = []Item{
+		{ID: "1", Title: "Go generics"},
+		{ID: "2", Title: "Rust ownership"},
+		{ID: "3", Title: "Go modules"},
+		{ID: "4", Title: "Ruby blocks"},
+	}
+	m.state = StateReviewing
+	m.listView.SetItems(m.items)
+	return m
+}
+
+func TestTypeAheadJump(t *testing.T) {
+	m := newTypeAheadTestModel()
+
+	// Letters after ' jump instead of triaging; "ru" stays on Rust
+	typeKeys(m, "'ru")
+	if item := m.listView.CurrentItem(); item.ID != "2" {
+		t.Errorf("expected 'ru to jump to Rust ownership, got %q", item.Title)
+	}
+	typeKeys(m, "b")
+	if item := m.listView.CurrentItem(); item.ID != "4" {
+		t.Errorf("expected 'rub to jump to Ruby blocks, got %q", item.Title)
+	}
+	for _, item := range m.items {
+		if item.Action != "" {
+			t.Errorf("expected no actions while typing ahead, %s got %q", item.ID, item.Action)
+		}
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.typeAheadActive {
+		t.Fatal("expected esc to end type-ahead")
+	}
+	// A fresh prefix looks past the current item and wraps around
+	typeKeys(m, "'g")
+	if item := m.listView.CurrentItem(); item.ID != "1" {
+		t.Errorf("expected 'g to wrap to Go generics, got %q", item.Title)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	typeKeys(m, "'G")
+	if item := m.listView.CurrentItem(); item.ID != "3" {
+		t.Errorf("expected 'G to move on to Go modules, got %q", item.Title)
+	}
+
+	typeKeys(m, "x")
+	if item := m.listView.CurrentItem(); item.ID != "3" || m.statusMessage != "'Gx (no match)" {
+		t.Errorf("expected no jump for an unmatched prefix, got %q with status %q", item.Title, m.statusMessage)
+	}
+}
+
+func TestTypeAheadIdle(t *testing.T) {
+	m := newTypeAheadTestModel()
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("'")})
+	if cmd == nil || !m.typeAheadActive {
+		t.Fatal("expected ' to start type-ahead with an idle timer")
+	}
+	stale := typeAheadIdleMsg{seq: m.typeAheadSeq}
+	typeKeys(m, "r")
+
+	// The timer from ' was superseded by the r
+	m.Update(stale)
+	if !m.typeAheadActive {
+		t.Fatal("expected a stale idle tick to be ignored")
+	}
+	m.Update(typeAheadIdleMsg{seq: m.typeAheadSeq})
+	if m.typeAheadActive {
+		t.Fatal("expected the idle tick to end type-ahead")
+	}
+
+	// Letters are actions again
+	typeKeys(m, "a")
+	if item := m.listView.CurrentItem(); item.ID != "2" || item.Action != "archive" {
+		t.Errorf("expected a to archive Rust ownership after the timeout, got %q on %q", item.Action, item.Title)
+	}
+}